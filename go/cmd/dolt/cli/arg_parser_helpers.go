@@ -66,6 +66,8 @@ func CreateCommitArgParser() *argparser.ArgParser {
 	ap.SupportsString(DateParam, "", "date", "Specify the date used in the commit. If not specified the current system time is used.")
 	ap.SupportsFlag(ForceFlag, "f", "Ignores any foreign key warnings and proceeds with the commit.")
 	ap.SupportsString(AuthorParam, "", "author", "Specify an explicit author using the standard A U Thor {{.LessThan}}author@example.com{{.GreaterThan}} format.")
+	ap.SupportsString(CommitterParam, "", "committer", "Specify an explicit committer using the standard A U Thor {{.LessThan}}committer@example.com{{.GreaterThan}} format. Defaults to the current user.")
+	ap.SupportsRepeatableString(TrailerParam, "", "trailer", "Add a trailer such as {{.EmphasisLeft}}Reviewed-by: A U Thor {{.LessThan}}author@example.com{{.GreaterThan}}{{.EmphasisRight}} to the commit, given as {{.EmphasisLeft}}Key: value{{.EmphasisRight}} or {{.EmphasisLeft}}Key=value{{.EmphasisRight}}. Can be given multiple times to add multiple trailers.")
 	ap.SupportsFlag(AllFlag, "a", "Adds all existing, changed tables (but not new tables) in the working set to the staged set.")
 	ap.SupportsFlag(UpperCaseAllFlag, "A", "Adds all tables and databases (including new tables) in the working set to the staged set.")
 	ap.SupportsFlag(AmendFlag, "", "Amend previous commit")
@@ -172,14 +174,25 @@ func CreateCheckoutArgParser() *argparser.ArgParser {
 }
 
 func CreateCherryPickArgParser() *argparser.ArgParser {
-	ap := argparser.NewArgParserWithMaxArgs("cherrypick", 1)
-	ap.SupportsFlag(AbortParam, "", "Abort the current conflict resolution process, and revert all changes from the in-process cherry-pick operation.")
+	ap := argparser.NewArgParserWithVariableArgs("cherrypick")
+	ap.SupportsFlag(AbortParam, "", "Abort the current conflict resolution process, and revert all changes from the in-process cherry-pick operation. Commits already applied by the cherry-pick are kept.")
+	ap.SupportsFlag(ContinueFlag, "", "Commit the resolved changes from the in-process cherry-pick operation, and continue cherry-picking the remaining commits.")
+	ap.SupportsFlag(SkipFlag, "", "Discard the changes from the commit that the in-process cherry-pick operation stopped on, and continue cherry-picking the remaining commits.")
 	ap.SupportsFlag(AllowEmptyFlag, "", "Allow empty commits to be cherry-picked. "+
 		"Note that use of this option only keeps commits that were initially empty. "+
 		"Commits which become empty, due to a previous commit, will cause cherry-pick to fail.")
-	ap.TooManyArgsErrorFunc = func(receivedArgs []string) error {
-		return errors.New("cherry-picking multiple commits is not supported yet.")
-	}
+	ap.SupportsInt(MainlineParam, "m", "parent-number", "Cherry-pick a merge commit by replaying the changes it made relative to the specified parent. "+
+		"Parents are numbered starting from 1. Commits that aren't merge commits are cherry-picked as usual.")
+	return ap
+}
+
+func CreateStashArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs("stash", 2)
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"subcommand", "The stash operation to perform: push, pop, drop, clear or list."})
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"stash", "The stash entry to pop or drop, e.g. stash@{1}. Defaults to the latest entry."})
+	ap.SupportsFlag(IncludeUntrackedFlag, "u", "Untracked tables are also stashed.")
+	ap.SupportsFlag(AllFlag, "a", "All tables are stashed, including untracked and ignored tables.")
+	ap.SupportsString(MessageArg, "m", "msg", "Use the given {{.LessThan}}msg{{.GreaterThan}} to describe the stash entry.")
 	return ap
 }
 
@@ -194,6 +207,8 @@ func CreateFetchArgParser() *argparser.ArgParser {
 func CreateRevertArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithVariableArgs("revert")
 	ap.SupportsString(AuthorParam, "", "author", "Specify an explicit author using the standard A U Thor {{.LessThan}}author@example.com{{.GreaterThan}} format.")
+	ap.SupportsInt(MainlineParam, "m", "parent-number", "Revert a merge commit by removing the changes it made relative to the specified parent. "+
+		"Parents are numbered starting from 1. Without this option, merge commits are reverted relative to their first parent.")
 	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"revision",
 		"The commit revisions. If multiple revisions are given, they're applied in the order given."})
 
@@ -282,9 +297,14 @@ func CreateLogArgParser(isTableFunction bool) *argparser.ArgParser {
 	ap.SupportsString(DecorateFlag, "", "decorate_fmt", "Shows refs next to commits. Valid options are short, full, no, and auto")
 	ap.SupportsStringList(NotFlag, "", "revision", "Excludes commits from revision.")
 	ap.SupportsFlag(ShowSignatureFlag, "", "Shows the signature of each commit.")
-	if isTableFunction {
-		ap.SupportsStringList(TablesFlag, "t", "table", "Restricts the log to commits that modified the specified tables.")
-	} else {
+	ap.SupportsString(AuthorParam, "", "pattern", "Limits the log to commits whose author name or email matches the regular expression.")
+	ap.SupportsString(GrepParam, "", "pattern", "Limits the log to commits whose message matches the regular expression.")
+	ap.SupportsString(SinceParam, "", "date", "Limits the log to commits made on or after the date.")
+	ap.SupportsString(UntilParam, "", "date", "Limits the log to commits made on or before the date.")
+	ap.SupportsStringList(TablesFlag, "t", "table", "Restricts the log to commits that modified the specified tables.")
+	ap.SupportsFlag(FollowFlag, "", "Continues listing the history of a single table beyond renames.")
+	ap.SupportsString(WhereParam, "", "filter", "Restricts the log to commits that changed rows of a single table matching the filter.")
+	if !isTableFunction {
 		ap.SupportsFlag(OneLineFlag, "", "Shows logs in a compact format.")
 		ap.SupportsFlag(StatFlag, "", "Shows the diffstat for each commit.")
 		ap.SupportsFlag(GraphFlag, "", "Shows the commit graph.")
@@ -296,6 +316,7 @@ func CreateGCArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs("gc", 0)
 	ap.SupportsFlag(ShallowFlag, "s", "perform a fast, but incomplete garbage collection pass")
 	ap.SupportsFlag(FullFlag, "f", "perform a full garbage collection, including the old generation")
+	ap.SupportsString(PruneHistoryParam, "", "date|commit", "prune the history of the database before the given date or commit, then perform a full garbage collection")
 	return ap
 }
 
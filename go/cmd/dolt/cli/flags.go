@@ -27,6 +27,7 @@ const (
 	CheckoutCreateBranch = "b"
 	CreateResetBranch    = "B"
 	CommitFlag           = "commit"
+	CommitterParam       = "committer"
 	ContinueFlag         = "continue"
 	CopyFlag             = "copy"
 	DateParam            = "date"
@@ -36,13 +37,17 @@ const (
 	DepthFlag            = "depth"
 	DryRunFlag           = "dry-run"
 	EmptyParam           = "empty"
+	FollowFlag           = "follow"
 	ForceFlag            = "force"
 	FullFlag             = "full"
 	GraphFlag            = "graph"
+	GrepParam            = "grep"
 	HardResetParam       = "hard"
 	HostFlag             = "host"
+	IncludeUntrackedFlag = "include-untracked"
 	InteractiveFlag      = "interactive"
 	ListFlag             = "list"
+	MainlineParam        = "mainline"
 	MergesFlag           = "merges"
 	MessageArg           = "message"
 	MinParentsFlag       = "min-parents"
@@ -63,6 +68,7 @@ const (
 	PasswordFlag         = "password"
 	PortFlag             = "port"
 	PruneFlag            = "prune"
+	PruneHistoryParam    = "prune-history-before"
 	QuietFlag            = "quiet"
 	RemoteParam          = "remote"
 	SetUpstreamFlag      = "set-upstream"
@@ -71,8 +77,10 @@ const (
 	ShowSignatureFlag    = "show-signature"
 	SignFlag             = "gpg-sign"
 	SilentFlag           = "silent"
+	SinceParam           = "since"
 	SingleBranchFlag     = "single-branch"
 	SkipEmptyFlag        = "skip-empty"
+	SkipFlag             = "skip"
 	SoftResetParam       = "soft"
 	SquashParam          = "squash"
 	StagedFlag           = "staged"
@@ -81,6 +89,9 @@ const (
 	TablesFlag           = "tables"
 	TheirsFlag           = "theirs"
 	TrackFlag            = "track"
+	TrailerParam         = "trailer"
+	UntilParam           = "until"
 	UpperCaseAllFlag     = "ALL"
 	UserFlag             = "user"
+	WhereParam           = "where"
 )
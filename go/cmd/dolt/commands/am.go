@@ -0,0 +1,457 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+)
+
+const (
+	amSkipFlag = "skip"
+
+	// amBranch is the temporary branch each patch is committed on before it is cherry-picked onto the current branch.
+	amBranch    = "dolt_am"
+	amStateFile = "am_state.json"
+	amBaseMsg   = "dolt am: rows before patch"
+
+	// amStoppedApply means that the statements of the current patch failed to apply.
+	amStoppedApply = "apply"
+	// amStoppedConflicts means that cherry-picking the current patch stopped on conflicts.
+	amStoppedConflicts = "conflicts"
+)
+
+var errAmInProgress = errors.New("error: a patch application is already in progress, use 'dolt am --continue', 'dolt am --skip' or 'dolt am --abort'")
+var errNoAmInProgress = errors.New("error: no patch application in progress")
+
+var amDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Apply patch files as commits.",
+	LongDesc: `Applies the patch files written by {{.EmphasisLeft}}dolt format-patch{{.EmphasisRight}} to the current branch, in the order given, creating a commit for each with the author, date and message recorded in the patch.
+
+Each patch is applied as a three-way merge, in the same way as {{.EmphasisLeft}}dolt cherry-pick{{.EmphasisRight}}. If the commit the patch was made from has the same parent in this database, that parent is the merge base. Otherwise, the merge base is the current HEAD with the rows changed by the patch set back to the values they had before the patch was made, so that rows which have changed in this database since then are reported as conflicts instead of being silently overwritten.
+
+When a patch stops on conflicts, resolve them and stage the tables, then run {{.EmphasisLeft}}dolt am --continue{{.EmphasisRight}}. When a patch's statements can't be applied at all, make its changes by hand and run {{.EmphasisLeft}}dolt am --continue{{.EmphasisRight}} to commit them with the patch's metadata. {{.EmphasisLeft}}dolt am --skip{{.EmphasisRight}} skips the current patch, and {{.EmphasisLeft}}dolt am --abort{{.EmphasisRight}} restores the branch to where it was before the patches were applied.
+
+The working set must be clean to apply patches.
+`,
+	Synopsis: []string{
+		"{{.LessThan}}patch{{.GreaterThan}}...",
+		"--continue",
+		"--skip",
+		"--abort",
+	},
+}
+
+type AmCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd AmCmd) Name() string {
+	return "am"
+}
+
+// Description returns a description of the command
+func (cmd AmCmd) Description() string {
+	return amDocs.ShortDesc
+}
+
+func (cmd AmCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(amDocs, ap)
+}
+
+func (cmd AmCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs(cmd.Name())
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"patch", "A patch file written by dolt format-patch."})
+	ap.SupportsFlag(cli.ContinueFlag, "", "Continue applying patches after resolving conflicts or making the current patch's changes by hand.")
+	ap.SupportsFlag(amSkipFlag, "", "Skip the current patch and continue with the next one.")
+	ap.SupportsFlag(cli.AbortParam, "", "Stop applying patches and restore the branch to where it was before they were applied.")
+	return ap
+}
+
+// EventType returns the type of the event to log
+func (cmd AmCmd) EventType() eventsapi.ClientEventType {
+	return eventsapi.ClientEventType_TYPE_UNSPECIFIED
+}
+
+// Exec executes the command
+func (cmd AmCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	apr, usage, terminate, status := ParseArgsOrPrintHelp(ap, commandStr, args, amDocs)
+	if terminate {
+		return status
+	}
+
+	numActions := 0
+	for _, flag := range []string{cli.ContinueFlag, amSkipFlag, cli.AbortParam} {
+		if apr.Contains(flag) {
+			numActions++
+		}
+	}
+	if numActions > 1 || (numActions == 1 && apr.NArg() > 0) || (numActions == 0 && apr.NArg() == 0) {
+		usage()
+		return 1
+	}
+
+	queryist, sqlCtx, closeFunc, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if closeFunc != nil {
+		defer closeFunc()
+	}
+
+	switch {
+	case apr.Contains(cli.ContinueFlag):
+		err = continueAm(queryist, sqlCtx, dEnv.FS)
+	case apr.Contains(amSkipFlag):
+		err = skipAm(queryist, sqlCtx, dEnv.FS)
+	case apr.Contains(cli.AbortParam):
+		err = abortAm(queryist, sqlCtx, dEnv.FS)
+	default:
+		err = startAm(queryist, sqlCtx, dEnv.FS, apr.Args)
+	}
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	return 0
+}
+
+// amPatch is a patch being applied by dolt am.
+type amPatch struct {
+	File string `json:"file"`
+	*commitPatch
+}
+
+// amState is the state of a dolt am in progress, which is saved in the .dolt directory when it stops.
+type amState struct {
+	// OrigBranch is the branch the patches are applied to.
+	OrigBranch string `json:"orig_branch"`
+	// OrigHead is the head of OrigBranch before any patches were applied, which it is reset to on abort.
+	OrigHead string    `json:"orig_head"`
+	Patches  []amPatch `json:"patches"`
+	// Next is the index of the patch currently being applied.
+	Next int `json:"next"`
+	// Stopped is why the current patch stopped, either amStoppedApply or amStoppedConflicts.
+	Stopped string `json:"stopped"`
+}
+
+func getAmStateFile() string {
+	return filepath.Join(dbfactory.DoltDir, amStateFile)
+}
+
+func loadAmState(fs filesys.ReadableFS) (*amState, error) {
+	if exists, _ := fs.Exists(getAmStateFile()); !exists {
+		return nil, errNoAmInProgress
+	}
+	data, err := fs.ReadFile(getAmStateFile())
+	if err != nil {
+		return nil, err
+	}
+	var state amState
+	err = json.Unmarshal(data, &state)
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+func (s *amState) save(fs filesys.WritableFS) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(getAmStateFile(), data, 0644)
+}
+
+func removeAmState(fs filesys.WritableFS) error {
+	return fs.DeleteFile(getAmStateFile())
+}
+
+func startAm(queryist cli.Queryist, sqlCtx *sql.Context, fs filesys.Filesys, files []string) error {
+	if exists, _ := fs.Exists(getAmStateFile()); exists {
+		return errAmInProgress
+	}
+
+	state := &amState{}
+	for _, file := range files {
+		p, err := readPatchFile(file)
+		if err != nil {
+			return err
+		}
+		state.Patches = append(state.Patches, amPatch{File: filepath.Base(file), commitPatch: p})
+	}
+
+	staged, unstaged, err := GetDoltStatus(queryist, sqlCtx)
+	if err != nil {
+		return err
+	}
+	if len(staged) > 0 || len(unstaged) > 0 {
+		return errors.New("error: your local changes would be overwritten by the patches, commit or stash them before applying patches")
+	}
+
+	rows, err := InterpolateAndRunQuery(queryist, sqlCtx, "select name from dolt_branches where name = ?", amBranch)
+	if err != nil {
+		return err
+	}
+	if len(rows) > 0 {
+		return fmt.Errorf("error: branch '%s' is used to apply patches and must not exist", amBranch)
+	}
+
+	state.OrigBranch, err = getActiveBranchName(sqlCtx, queryist)
+	if err != nil {
+		return err
+	}
+	state.OrigHead, err = getHashOf(queryist, sqlCtx, "HEAD")
+	if err != nil {
+		return err
+	}
+
+	return applyAmPatches(queryist, sqlCtx, fs, state)
+}
+
+func continueAm(queryist cli.Queryist, sqlCtx *sql.Context, fs filesys.Filesys) error {
+	state, err := loadAmState(fs)
+	if err != nil {
+		return err
+	}
+	p := state.Patches[state.Next]
+
+	switch state.Stopped {
+	case amStoppedConflicts:
+		_, err = runProcedure(queryist, sqlCtx, "dolt_cherry_pick", "--continue")
+		if err != nil {
+			return err
+		}
+		err = amendAmCommit(queryist, sqlCtx, p)
+	default:
+		_, err = runProcedure(queryist, sqlCtx, "dolt_commit", "-A", "-m", p.Message, "--author", p.Author, "--date", p.Date.Format(patchDateFormat))
+		if err != nil && strings.Contains(err.Error(), "nothing to commit") {
+			return errors.New("error: no changes to commit, use 'dolt am --skip' to skip this patch")
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	printAppliedPatch(p)
+	state.Next++
+	state.Stopped = ""
+	return applyAmPatches(queryist, sqlCtx, fs, state)
+}
+
+func skipAm(queryist cli.Queryist, sqlCtx *sql.Context, fs filesys.Filesys) error {
+	state, err := loadAmState(fs)
+	if err != nil {
+		return err
+	}
+
+	if state.Stopped == amStoppedConflicts {
+		_, err = runProcedure(queryist, sqlCtx, "dolt_cherry_pick", "--abort")
+	} else {
+		_, err = runProcedure(queryist, sqlCtx, "dolt_reset", "--hard")
+	}
+	if err != nil {
+		return err
+	}
+
+	state.Next++
+	state.Stopped = ""
+	return applyAmPatches(queryist, sqlCtx, fs, state)
+}
+
+func abortAm(queryist cli.Queryist, sqlCtx *sql.Context, fs filesys.Filesys) error {
+	state, err := loadAmState(fs)
+	if err != nil {
+		return err
+	}
+
+	if state.Stopped == amStoppedConflicts {
+		_, err = runProcedure(queryist, sqlCtx, "dolt_cherry_pick", "--abort")
+		if err != nil {
+			return err
+		}
+	}
+	_, err = runProcedure(queryist, sqlCtx, "dolt_reset", "--hard", state.OrigHead)
+	if err != nil {
+		return err
+	}
+	return removeAmState(fs)
+}
+
+// applyAmPatches applies the patches of |state| from state.Next onwards. If a patch stops, the state is saved so that
+// the patches can be continued, skipped or aborted, otherwise it is removed once all the patches are applied.
+func applyAmPatches(queryist cli.Queryist, sqlCtx *sql.Context, fs filesys.Filesys, state *amState) error {
+	for state.Next < len(state.Patches) {
+		p := state.Patches[state.Next]
+
+		conflicts, err := applyAmPatch(queryist, sqlCtx, state.OrigBranch, p)
+		if err != nil {
+			state.Stopped = amStoppedApply
+			if serr := state.save(fs); serr != nil {
+				return serr
+			}
+			return fmt.Errorf("error: patch %s does not apply: %s\n"+
+				"Make the patch's changes by hand and run 'dolt am --continue' to commit them, "+
+				"run 'dolt am --skip' to skip this patch, or run 'dolt am --abort' to stop applying patches", p.File, err.Error())
+		}
+		if conflicts {
+			state.Stopped = amStoppedConflicts
+			if serr := state.save(fs); serr != nil {
+				return serr
+			}
+			return fmt.Errorf("error: patch %s has conflicts with this database\n"+
+				"Resolve the conflicts, stage the tables with 'dolt add' and run 'dolt am --continue', "+
+				"run 'dolt am --skip' to skip this patch, or run 'dolt am --abort' to stop applying patches", p.File)
+		}
+
+		printAppliedPatch(p)
+		state.Next++
+		state.Stopped = ""
+	}
+
+	if exists, _ := fs.Exists(getAmStateFile()); exists {
+		return removeAmState(fs)
+	}
+	return nil
+}
+
+// applyAmPatch applies |p| to |branch|, returning whether it stopped on conflicts. The patch is first committed on a
+// temporary branch whose head's parent is the merge base for the patch, and that commit is then cherry-picked onto
+// |branch| and amended with the patch's metadata.
+func applyAmPatch(queryist cli.Queryist, sqlCtx *sql.Context, branch string, p amPatch) (bool, error) {
+	// The patch's own parent is the best merge base if this database has it. Otherwise, the base is built from HEAD
+	// by reverting the rows changed by the patch to the values they had before it.
+	start := p.Parent
+	if _, err := getHashOf(queryist, sqlCtx, start); err != nil {
+		start = "HEAD"
+	}
+
+	commit, err := func() (commit string, err error) {
+		_, err = runProcedure(queryist, sqlCtx, "dolt_branch", "-f", amBranch, start)
+		if err != nil {
+			return "", err
+		}
+		_, err = runProcedure(queryist, sqlCtx, "dolt_checkout", amBranch)
+		if err != nil {
+			return "", err
+		}
+		defer func() {
+			_, rerr := runProcedure(queryist, sqlCtx, "dolt_reset", "--hard")
+			if rerr == nil {
+				_, rerr = runProcedure(queryist, sqlCtx, "dolt_checkout", branch)
+			}
+			if rerr == nil {
+				_, rerr = runProcedure(queryist, sqlCtx, "dolt_branch", "-D", amBranch)
+			}
+			if err == nil {
+				err = rerr
+			}
+		}()
+
+		if start == "HEAD" && strings.TrimSpace(p.RevertStatements) != "" {
+			err = runPatchStatements(queryist, sqlCtx, p.RevertStatements, true)
+			if err != nil {
+				return "", err
+			}
+			_, err = runProcedure(queryist, sqlCtx, "dolt_commit", "-A", "--allow-empty", "-m", amBaseMsg)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		err = runPatchStatements(queryist, sqlCtx, p.Statements, false)
+		if err != nil {
+			return "", err
+		}
+		_, err = runProcedure(queryist, sqlCtx, "dolt_commit", "-A", "--allow-empty", "-m", p.Message, "--author", p.Author, "--date", p.Date.Format(patchDateFormat))
+		if err != nil {
+			return "", err
+		}
+		return getHashOf(queryist, sqlCtx, "HEAD")
+	}()
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := runProcedure(queryist, sqlCtx, "dolt_cherry_pick", commit)
+	if err != nil {
+		return false, err
+	}
+	for _, col := range rows[0][1:] {
+		count, err := getInt64ColAsInt64(col)
+		if err != nil {
+			return false, err
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+
+	return false, amendAmCommit(queryist, sqlCtx, p)
+}
+
+// amendAmCommit sets the author, date and message of the HEAD commit to those of |p|, since cherry-picked commits are
+// authored by the current user.
+func amendAmCommit(queryist cli.Queryist, sqlCtx *sql.Context, p amPatch) error {
+	_, err := runProcedure(queryist, sqlCtx, "dolt_commit", "--amend", "-m", p.Message, "--author", p.Author, "--date", p.Date.Format(patchDateFormat))
+	return err
+}
+
+// runPatchStatements executes each of the SQL |statements| of a patch. When |revert| is true, inserts are replaced
+// with upserts, since the reverted rows may still exist in the database the patch is applied to.
+func runPatchStatements(queryist cli.Queryist, sqlCtx *sql.Context, statements string, revert bool) error {
+	scanner := NewStreamScanner(strings.NewReader(statements))
+	for scanner.Scan() {
+		q := strings.TrimSpace(scanner.Text())
+		if q == "" {
+			continue
+		}
+		if revert && strings.HasPrefix(q, "INSERT INTO ") {
+			q = "REPLACE INTO " + strings.TrimPrefix(q, "INSERT INTO ")
+		}
+		_, err := GetRowsForSql(queryist, sqlCtx, q)
+		if err != nil {
+			return fmt.Errorf("%s: %w", q, err)
+		}
+	}
+	return scanner.Err()
+}
+
+func runProcedure(queryist cli.Queryist, sqlCtx *sql.Context, name string, args ...string) ([]sql.Row, error) {
+	q, err := interpolateStoredProcedureCall(name, args)
+	if err != nil {
+		return nil, err
+	}
+	return GetRowsForSql(queryist, sqlCtx, q)
+}
+
+func printAppliedPatch(p amPatch) {
+	subject, _, _ := strings.Cut(p.Message, "\n")
+	cli.Printf("Applying: %s\n", subject)
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisectcmds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bisect"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+var BisectCommands = cli.NewSubCommandHandler("bisect", "Use binary search to find the commit that introduced a change.", []cli.Command{
+	BisectStartCmd{},
+	BisectBadCmd{},
+	BisectGoodCmd{},
+	BisectSkipCmd{},
+	BisectResetCmd{},
+	BisectRunCmd{},
+})
+
+// resolveCommit resolves |spec| to a commit in |dEnv|, or the current HEAD commit if |spec| is empty.
+func resolveCommit(ctx context.Context, dEnv *env.DoltEnv, spec string) (*doltdb.Commit, hash.Hash, error) {
+	if spec == "" {
+		spec = "HEAD"
+	}
+	cs, err := doltdb.NewCommitSpec(spec)
+	if err != nil {
+		return nil, hash.Hash{}, err
+	}
+	headRef, err := dEnv.RepoStateReader().CWBHeadRef()
+	if err != nil {
+		return nil, hash.Hash{}, err
+	}
+	optCmt, err := dEnv.DoltDB.Resolve(ctx, cs, headRef)
+	if err != nil {
+		return nil, hash.Hash{}, err
+	}
+	cmt, ok := optCmt.ToCommit()
+	if !ok {
+		return nil, hash.Hash{}, doltdb.ErrGhostCommitEncountered
+	}
+	h, err := cmt.HashOf()
+	if err != nil {
+		return nil, hash.Hash{}, err
+	}
+	return cmt, h, nil
+}
+
+// nextStep finds the next commit to test for |state| and checks it out on the bisect branch, printing the progress
+// of the bisect. When the bisect is finished, the first bad commit is printed instead. A nil step is returned while
+// the bisect is still waiting for a good or a bad commit.
+func nextStep(ctx context.Context, dEnv *env.DoltEnv, state *bisect.State) (*bisect.Step, error) {
+	if state.Bad == "" && len(state.Good) == 0 {
+		cli.Println("status: waiting for both good and bad commits")
+		return nil, nil
+	} else if state.Bad == "" {
+		cli.Printf("status: waiting for bad commit, %d good commit(s) known\n", len(state.Good))
+		return nil, nil
+	} else if len(state.Good) == 0 {
+		cli.Println("status: waiting for good commit(s), bad commit known")
+		return nil, nil
+	}
+
+	step, err := bisect.NextStep(ctx, dEnv.DoltDB, state)
+	if err != nil {
+		return nil, err
+	}
+
+	if step.Done() {
+		if step.FirstBad.IsEmpty() {
+			cli.Println("There are only 'skip'ped commits left to test.")
+			cli.Println("The first bad commit could be any of:")
+			for _, h := range step.Candidates {
+				cli.Println(h.String())
+			}
+			cli.Println("We cannot bisect more!")
+			return step, nil
+		}
+
+		cmt, _, err := resolveCommit(ctx, dEnv, step.FirstBad.String())
+		if err != nil {
+			return nil, err
+		}
+		meta, err := cmt.GetCommitMeta(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cli.Printf("%s is the first bad commit\n", step.FirstBad.String())
+		cli.Printf("commit %s\n", step.FirstBad.String())
+		cli.Printf("Author: %s <%s>\n", meta.Name, meta.Email)
+		cli.Printf("Date:  %s\n", meta.FormatTS())
+		cli.Printf("\n\t%s\n\n", strings.Replace(meta.Description, "\n", "\n\t", -1))
+		return step, nil
+	}
+
+	cmt, _, err := resolveCommit(ctx, dEnv, step.Next.String())
+	if err != nil {
+		return nil, err
+	}
+	err = checkoutBisectCommit(ctx, dEnv, cmt)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := cmt.GetCommitMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cli.Printf("Bisecting: %d revisions left to test after this (roughly %d steps)\n", step.Remaining, step.Steps)
+	cli.Printf("[%s] %s\n", step.Next.String(), strings.SplitN(meta.Description, "\n", 2)[0])
+	return step, nil
+}
+
+// checkoutBisectCommit moves the bisect branch to |cmt| and checks it out. Any changes made to the working set of the
+// bisect branch are discarded.
+func checkoutBisectCommit(ctx context.Context, dEnv *env.DoltEnv, cmt *doltdb.Commit) error {
+	bisectRef := ref.NewBranchRef(bisect.BranchName)
+	err := dEnv.DoltDB.NewBranchAtCommit(ctx, bisectRef, cmt, nil)
+	if err != nil {
+		return err
+	}
+
+	headRef, err := dEnv.RepoStateReader().CWBHeadRef()
+	if err != nil {
+		return err
+	}
+	if ref.Equals(headRef, bisectRef) {
+		return nil
+	}
+	return dEnv.RepoStateWriter().SetCWBHeadRef(ctx, ref.MarshalableRef{Ref: bisectRef})
+}
+
+// appendUnique appends |s| to |strs| unless it's already present.
+func appendUnique(strs []string, s string) []string {
+	for _, str := range strs {
+		if str == s {
+			return strs
+		}
+	}
+	return append(strs, s)
+}
+
+func bisectStateError(err error) error {
+	return fmt.Errorf("error: unable to update bisect state: %w", err)
+}
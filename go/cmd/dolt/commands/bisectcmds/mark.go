@@ -0,0 +1,185 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisectcmds
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bisect"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+const (
+	markBad  = "bad"
+	markGood = "good"
+	markSkip = "skip"
+)
+
+var bisectBadDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Mark a commit as bad.",
+	LongDesc: `Marks a commit as having the change that is being searched for, and checks out the next commit to test. The current HEAD commit is marked if no commit is given.
+`,
+	Synopsis: []string{
+		"[{{.LessThan}}commit{{.GreaterThan}}]",
+	},
+}
+
+var bisectGoodDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Mark commits as good.",
+	LongDesc: `Marks commits as not having the change that is being searched for, and checks out the next commit to test. The current HEAD commit is marked if no commit is given.
+`,
+	Synopsis: []string{
+		"[{{.LessThan}}commit{{.GreaterThan}}...]",
+	},
+}
+
+var bisectSkipDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Mark commits as untestable.",
+	LongDesc: `Marks commits that can't be tested, and checks out the next commit to test. Skipped commits are never checked out again, but if only skipped commits are left the first bad commit can't be found. The current HEAD commit is skipped if no commit is given.
+`,
+	Synopsis: []string{
+		"[{{.LessThan}}commit{{.GreaterThan}}...]",
+	},
+}
+
+type BisectBadCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd BisectBadCmd) Name() string {
+	return markBad
+}
+
+// Description returns a description of the command
+func (cmd BisectBadCmd) Description() string {
+	return "Mark a commit as bad."
+}
+
+func (cmd BisectBadCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(bisectBadDocs, ap)
+}
+
+func (cmd BisectBadCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	return ap
+}
+
+// Exec executes the command
+func (cmd BisectBadCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	return execMark(ctx, commandStr, args, dEnv, cmd.ArgParser(), bisectBadDocs, markBad)
+}
+
+type BisectGoodCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd BisectGoodCmd) Name() string {
+	return markGood
+}
+
+// Description returns a description of the command
+func (cmd BisectGoodCmd) Description() string {
+	return "Mark commits as good."
+}
+
+func (cmd BisectGoodCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(bisectGoodDocs, ap)
+}
+
+func (cmd BisectGoodCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs(cmd.Name())
+	return ap
+}
+
+// Exec executes the command
+func (cmd BisectGoodCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	return execMark(ctx, commandStr, args, dEnv, cmd.ArgParser(), bisectGoodDocs, markGood)
+}
+
+type BisectSkipCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd BisectSkipCmd) Name() string {
+	return markSkip
+}
+
+// Description returns a description of the command
+func (cmd BisectSkipCmd) Description() string {
+	return "Mark commits as untestable."
+}
+
+func (cmd BisectSkipCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(bisectSkipDocs, ap)
+}
+
+func (cmd BisectSkipCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs(cmd.Name())
+	return ap
+}
+
+// Exec executes the command
+func (cmd BisectSkipCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	return execMark(ctx, commandStr, args, dEnv, cmd.ArgParser(), bisectSkipDocs, markSkip)
+}
+
+func execMark(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, ap *argparser.ArgParser, docs cli.CommandDocumentationContent, mark string) int {
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, docs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	state, err := bisect.LoadState(dEnv.FS)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	specs := apr.Args
+	if len(specs) == 0 {
+		specs = []string{""}
+	}
+	for _, spec := range specs {
+		_, h, err := resolveCommit(ctx, dEnv, spec)
+		if err != nil {
+			return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+		markCommit(state, mark, h.String())
+	}
+
+	err = state.Save(dEnv.FS)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(bisectStateError(err)), usage)
+	}
+
+	_, err = nextStep(ctx, dEnv, state)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	return 0
+}
+
+// markCommit records |commit| in |state| as bad, good or skipped depending on |mark|.
+func markCommit(state *bisect.State, mark string, commit string) {
+	switch mark {
+	case markBad:
+		state.Bad = commit
+	case markGood:
+		state.Good = appendUnique(state.Good, commit)
+	case markSkip:
+		state.Skip = appendUnique(state.Skip, commit)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisectcmds
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bisect"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var bisectResetDocs = cli.CommandDocumentationContent{
+	ShortDesc: "End the bisect in progress.",
+	LongDesc: `Ends the bisect in progress, checks out the branch that was checked out when the bisect was started and deletes the temporary {{.EmphasisLeft}}dolt_bisect{{.EmphasisRight}} branch.
+`,
+	Synopsis: []string{
+		"",
+	},
+}
+
+type BisectResetCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd BisectResetCmd) Name() string {
+	return "reset"
+}
+
+// Description returns a description of the command
+func (cmd BisectResetCmd) Description() string {
+	return "End the bisect in progress."
+}
+
+func (cmd BisectResetCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(bisectResetDocs, ap)
+}
+
+func (cmd BisectResetCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 0)
+	return ap
+}
+
+// Exec executes the command
+func (cmd BisectResetCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, bisectResetDocs, ap))
+	cli.ParseArgsOrDie(ap, args, help)
+
+	err := resetBisect(ctx, dEnv)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	return 0
+}
+
+func resetBisect(ctx context.Context, dEnv *env.DoltEnv) error {
+	state, err := bisect.LoadState(dEnv.FS)
+	if err != nil {
+		return err
+	}
+
+	startRef := ref.NewBranchRef(state.StartBranch)
+	err = dEnv.RepoStateWriter().SetCWBHeadRef(ctx, ref.MarshalableRef{Ref: startRef})
+	if err != nil {
+		return err
+	}
+
+	bisectRef := ref.NewBranchRef(bisect.BranchName)
+	hasRef, err := dEnv.DoltDB.HasRef(ctx, bisectRef)
+	if err != nil {
+		return err
+	}
+	if hasRef {
+		err = actions.DeleteBranch(ctx, dEnv.DbData(), bisect.BranchName, actions.DeleteOptions{
+			Force:                      true,
+			AllowDeletingCurrentBranch: true,
+		}, dEnv, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = bisect.RemoveState(dEnv.FS)
+	if err != nil {
+		return bisectStateError(err)
+	}
+
+	cli.Printf("Switched to branch '%s'\n", state.StartBranch)
+	return nil
+}
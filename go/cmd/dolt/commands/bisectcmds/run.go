@@ -0,0 +1,136 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisectcmds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bisect"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var bisectRunDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Bisect automatically by running a query on each commit.",
+	LongDesc: `Runs the bisect in progress to completion. The given query is run against each commit that needs to be tested, and the commit is marked as bad if the query returns any rows and as good if it returns none. Once the first bad commit is found it is printed.
+
+A bad and a good commit must have been given to {{.EmphasisLeft}}dolt bisect start{{.EmphasisRight}}, {{.EmphasisLeft}}dolt bisect bad{{.EmphasisRight}} or {{.EmphasisLeft}}dolt bisect good{{.EmphasisRight}} before running. If the query fails on a commit the run is stopped, and the bisect can be continued by hand.
+`,
+	Synopsis: []string{
+		"--query {{.LessThan}}query{{.GreaterThan}}",
+	},
+}
+
+type BisectRunCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd BisectRunCmd) Name() string {
+	return "run"
+}
+
+// Description returns a description of the command
+func (cmd BisectRunCmd) Description() string {
+	return "Bisect automatically by running a query on each commit."
+}
+
+func (cmd BisectRunCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(bisectRunDocs, ap)
+}
+
+func (cmd BisectRunCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 0)
+	ap.SupportsString(commands.QueryFlag, "q", "query", "The query to run on each commit. Commits where it returns any rows are bad.")
+	return ap
+}
+
+// Exec executes the command
+func (cmd BisectRunCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, bisectRunDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	query, ok := apr.GetValue(commands.QueryFlag)
+	if !ok {
+		cli.PrintErrln(fmt.Sprintf("error: --%s is required", commands.QueryFlag))
+		usage()
+		return 1
+	}
+
+	found, err := runBisect(ctx, dEnv, cliCtx, query)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if !found {
+		return 1
+	}
+	return 0
+}
+
+// runBisect tests each commit of the bisect in progress with |query| until the first bad commit is found, and returns
+// whether it was found.
+func runBisect(ctx context.Context, dEnv *env.DoltEnv, cliCtx cli.CliContext, query string) (bool, error) {
+	state, err := bisect.LoadState(dEnv.FS)
+	if err != nil {
+		return false, err
+	}
+	if state.Bad == "" || len(state.Good) == 0 {
+		return false, errors.New("error: a bad and a good commit are needed to run a bisect")
+	}
+
+	// the first commit to test is checked out before the query engine is loaded, so that it uses the bisect branch
+	step, err := nextStep(ctx, dEnv, state)
+	if err != nil {
+		return false, err
+	}
+
+	queryist, sqlCtx, closer, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return false, err
+	}
+	if closer != nil {
+		defer closer()
+	}
+
+	for !step.Done() {
+		cli.Printf("running '%s'\n", query)
+		rows, err := commands.GetRowsForSql(queryist, sqlCtx, query)
+		if err != nil {
+			return false, fmt.Errorf("error: running the query on %s failed: %w", step.Next.String(), err)
+		}
+
+		mark := markGood
+		if len(rows) > 0 {
+			mark = markBad
+		}
+		markCommit(state, mark, step.Next.String())
+		err = state.Save(dEnv.FS)
+		if err != nil {
+			return false, bisectStateError(err)
+		}
+
+		step, err = nextStep(ctx, dEnv, state)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return !step.FirstBad.IsEmpty(), nil
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisectcmds
+
+import (
+	"context"
+	"errors"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bisect"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var bisectStartDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Start a bisect to find the commit that introduced a change.",
+	LongDesc: `Starts a binary search of the commit history to find the commit that introduced a change, such as a data regression. The first argument is a commit with the change (a bad commit), and any other arguments are commits without it (good commits). Bad and good commits can also be given afterwards with {{.EmphasisLeft}}dolt bisect bad{{.EmphasisRight}} and {{.EmphasisLeft}}dolt bisect good{{.EmphasisRight}}.
+
+Once both a bad and a good commit are known, the commit halfway between them is checked out on the temporary {{.EmphasisLeft}}dolt_bisect{{.EmphasisRight}} branch, to be tested and marked with {{.EmphasisLeft}}dolt bisect good{{.EmphasisRight}}, {{.EmphasisLeft}}dolt bisect bad{{.EmphasisRight}} or {{.EmphasisLeft}}dolt bisect skip{{.EmphasisRight}}. Changes made to the working set while bisecting are discarded when the next commit is checked out. The state of the bisect is saved in the repository until {{.EmphasisLeft}}dolt bisect reset{{.EmphasisRight}} is run.
+
+The working set must be clean to start a bisect.
+`,
+	Synopsis: []string{
+		"[{{.LessThan}}bad{{.GreaterThan}} [{{.LessThan}}good{{.GreaterThan}}...]]",
+	},
+}
+
+type BisectStartCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd BisectStartCmd) Name() string {
+	return "start"
+}
+
+// Description returns a description of the command
+func (cmd BisectStartCmd) Description() string {
+	return "Start a bisect to find the commit that introduced a change."
+}
+
+func (cmd BisectStartCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(bisectStartDocs, ap)
+}
+
+func (cmd BisectStartCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs(cmd.Name())
+	return ap
+}
+
+// Exec executes the command
+func (cmd BisectStartCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, bisectStartDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	err := startBisect(ctx, dEnv, apr.Args)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	return 0
+}
+
+func startBisect(ctx context.Context, dEnv *env.DoltEnv, args []string) error {
+	if bisect.InProgress(dEnv.FS) {
+		return bisect.ErrBisectInProgress
+	}
+
+	roots, err := dEnv.Roots(ctx)
+	if err != nil {
+		return err
+	}
+	hasChanges, _, _, err := actions.RootHasUncommittedChanges(roots)
+	if err != nil {
+		return err
+	}
+	if hasChanges {
+		return errors.New("error: your local changes would be overwritten by bisect, commit or stash them before you start a bisect")
+	}
+
+	headRef, err := dEnv.RepoStateReader().CWBHeadRef()
+	if err != nil {
+		return err
+	}
+
+	state := &bisect.State{StartBranch: headRef.GetPath()}
+	for i, arg := range args {
+		_, h, err := resolveCommit(ctx, dEnv, arg)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			state.Bad = h.String()
+		} else {
+			state.Good = appendUnique(state.Good, h.String())
+		}
+	}
+
+	err = state.Save(dEnv.FS)
+	if err != nil {
+		return bisectStateError(err)
+	}
+
+	_, err = nextStep(ctx, dEnv, state)
+	if err != nil {
+		// the given commits can't be bisected, so don't leave a bisect in progress
+		_ = bisect.RemoveState(dEnv.FS)
+		return err
+	}
+	return nil
+}
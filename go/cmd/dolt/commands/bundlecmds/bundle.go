@@ -0,0 +1,41 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundlecmds
+
+import (
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bundle"
+)
+
+var BundleCommands = cli.NewSubCommandHandler("bundle", "Move commits between databases using bundle files.", []cli.Command{
+	BundleCreateCmd{},
+	BundleVerifyCmd{},
+})
+
+// printHeader prints the refs and prerequisites of the bundle described by |header|.
+func printHeader(header *bundle.Header) {
+	cli.Printf("The bundle contains %d ref(s):\n", len(header.Refs))
+	for _, r := range header.Refs {
+		cli.Printf("%s %s\n", r.Hash, r.Ref)
+	}
+	if len(header.Prerequisites) > 0 {
+		cli.Printf("The bundle requires %d commit(s):\n", len(header.Prerequisites))
+		for _, p := range header.Prerequisites {
+			cli.Println(p)
+		}
+	} else {
+		cli.Println("The bundle records a complete history.")
+	}
+}
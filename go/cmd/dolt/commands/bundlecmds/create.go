@@ -0,0 +1,171 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundlecmds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bundle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const sinceParam = "since"
+
+var bundleCreateDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Create a bundle file of branches and tags.",
+	LongDesc: `Writes the given branches and tags, along with the commits and data they reference, to the bundle file {{.LessThan}}file{{.GreaterThan}}. The bundle can be copied to another machine and fetched from with {{.EmphasisLeft}}dolt fetch {{.LessThan}}file{{.GreaterThan}}{{.EmphasisRight}}, without the databases ever being connected to a common remote.
+
+With {{.EmphasisLeft}}--since{{.EmphasisRight}}, an incremental bundle is created which only includes the data that is not reachable from the given commit. The commit is recorded in the bundle as a prerequisite, and a database must already have it to fetch from the bundle.
+`,
+	Synopsis: []string{
+		"[--since {{.LessThan}}commit{{.GreaterThan}}] {{.LessThan}}file{{.GreaterThan}} {{.LessThan}}ref{{.GreaterThan}}...",
+	},
+}
+
+type BundleCreateCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd BundleCreateCmd) Name() string {
+	return "create"
+}
+
+// Description returns a description of the command
+func (cmd BundleCreateCmd) Description() string {
+	return "Create a bundle file of branches and tags."
+}
+
+func (cmd BundleCreateCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(bundleCreateDocs, ap)
+}
+
+func (cmd BundleCreateCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs(cmd.Name())
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"file", "The bundle file to create."})
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"ref", "A branch or tag to include in the bundle."})
+	ap.SupportsString(sinceParam, "", "commit", "Only include the data that is not reachable from the given commit.")
+	return ap
+}
+
+// Exec executes the command
+func (cmd BundleCreateCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, bundleCreateDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	if apr.NArg() < 2 {
+		usage()
+		return 1
+	}
+
+	err := createBundle(ctx, dEnv, apr.Arg(0), apr.Args[1:], apr)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	return 0
+}
+
+func createBundle(ctx context.Context, dEnv *env.DoltEnv, path string, refArgs []string, apr *argparser.ArgParseResults) error {
+	refs, err := resolveRefs(ctx, dEnv.DoltDB, refArgs)
+	if err != nil {
+		return err
+	}
+
+	var prerequisites []hash.Hash
+	if since, ok := apr.GetValue(sinceParam); ok {
+		cs, err := doltdb.NewCommitSpec(since)
+		if err != nil {
+			return err
+		}
+		headRef, err := dEnv.RepoStateReader().CWBHeadRef()
+		if err != nil {
+			return err
+		}
+		optCmt, err := dEnv.DoltDB.Resolve(ctx, cs, headRef)
+		if err != nil {
+			return err
+		}
+		cm, ok := optCmt.ToCommit()
+		if !ok {
+			return doltdb.ErrGhostCommitEncountered
+		}
+		h, err := cm.HashOf()
+		if err != nil {
+			return err
+		}
+		prerequisites = append(prerequisites, h)
+	}
+
+	tempDir, err := dEnv.TempTableFilesDir()
+	if err != nil {
+		return err
+	}
+	header, err := bundle.Create(ctx, dEnv.DoltDB, tempDir, path, refs, prerequisites)
+	if err != nil {
+		return err
+	}
+
+	cli.Printf("Created bundle %s\n", path)
+	printHeader(header)
+	return nil
+}
+
+// resolveRefs resolves each of |args| to a branch or a tag of |ddb|. Branches take precedence over tags of the same
+// name, and fully qualified refs such as refs/tags/v1 may be used to disambiguate.
+func resolveRefs(ctx context.Context, ddb *doltdb.DoltDB, args []string) ([]doltdb.RefWithHash, error) {
+	refTypes := map[ref.RefType]struct{}{ref.BranchRefType: {}, ref.TagRefType: {}}
+	var all []doltdb.RefWithHash
+	err := ddb.VisitRefsOfType(ctx, refTypes, func(r ref.DoltRef, addr hash.Hash) error {
+		all = append(all, doltdb.RefWithHash{Ref: r, Hash: addr})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	find := func(arg string) (doltdb.RefWithHash, bool) {
+		for _, refType := range []ref.RefType{ref.BranchRefType, ref.TagRefType} {
+			for _, r := range all {
+				if r.Ref.GetType() == refType && (r.Ref.GetPath() == arg || r.Ref.String() == arg) {
+					return r, true
+				}
+			}
+		}
+		return doltdb.RefWithHash{}, false
+	}
+
+	var refs []doltdb.RefWithHash
+	seen := make(map[string]struct{})
+	for _, arg := range args {
+		r, ok := find(arg)
+		if !ok {
+			return nil, fmt.Errorf("error: '%s' is not a branch or a tag", arg)
+		}
+		if _, ok := seen[r.Ref.String()]; ok {
+			continue
+		}
+		seen[r.Ref.String()] = struct{}{}
+		refs = append(refs, r)
+	}
+	return refs, nil
+}
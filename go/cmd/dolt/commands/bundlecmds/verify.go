@@ -0,0 +1,96 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundlecmds
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bundle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var bundleVerifyDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Check that a bundle file can be fetched from.",
+	LongDesc: `Checks that {{.LessThan}}file{{.GreaterThan}} is a valid bundle which can be fetched from by this database, and prints the refs it contains. An incremental bundle can only be fetched from if this database already has all of its prerequisite commits; the missing ones are listed otherwise.
+`,
+	Synopsis: []string{
+		"{{.LessThan}}file{{.GreaterThan}}",
+	},
+}
+
+type BundleVerifyCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd BundleVerifyCmd) Name() string {
+	return "verify"
+}
+
+// Description returns a description of the command
+func (cmd BundleVerifyCmd) Description() string {
+	return "Check that a bundle file can be fetched from."
+}
+
+func (cmd BundleVerifyCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(bundleVerifyDocs, ap)
+}
+
+func (cmd BundleVerifyCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"file", "The bundle file to check."})
+	return ap
+}
+
+// Exec executes the command
+func (cmd BundleVerifyCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, bundleVerifyDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	if apr.NArg() != 1 {
+		usage()
+		return 1
+	}
+
+	err := verifyBundle(ctx, dEnv, apr.Arg(0))
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	return 0
+}
+
+func verifyBundle(ctx context.Context, dEnv *env.DoltEnv, path string) error {
+	tempDir, err := dEnv.TempTableFilesDir()
+	if err != nil {
+		return err
+	}
+	b, err := bundle.Open(ctx, path, tempDir)
+	if err != nil {
+		return err
+	}
+	defer b.Close()
+
+	printHeader(b.Header)
+	err = b.Verify(ctx, dEnv.DoltDB)
+	if err != nil {
+		return err
+	}
+	cli.Printf("%s is okay\n", path)
+	return nil
+}
@@ -36,18 +36,24 @@ var cherryPickDocs = cli.CommandDocumentationContent{
 	LongDesc: `
 Applies the changes from an existing commit and creates a new commit from the current HEAD. This requires your working tree to be clean (no modifications from the HEAD commit).
 
-Cherry-picking merge commits or commits with table drops/renames is not currently supported. 
+Several commits may be specified, and they are applied in the order given. A range of commits can be specified as {{.EmphasisLeft}}A..B{{.EmphasisRight}}, which applies every commit reachable from {{.EmphasisLeft}}B{{.EmphasisRight}} that is not reachable from {{.EmphasisLeft}}A{{.EmphasisRight}}, oldest first.
 
-If any data conflicts, schema conflicts, or constraint violations are detected during cherry-picking, you can use Dolt's conflict resolution features to resolve them. For more information on resolving conflicts, see: https://docs.dolthub.com/concepts/dolt/git/conflicts.
+Merge commits can be cherry-picked by using {{.EmphasisLeft}}-m{{.EmphasisRight}} to specify the parent, numbered starting from 1, that the merge's changes are replayed relative to. For example, {{.EmphasisLeft}}-m 1{{.EmphasisRight}} applies everything a merge brought into the branch it was merged into. Cherry-picking commits with table drops/renames is not currently supported. 
+
+If any data conflicts, schema conflicts, or constraint violations are detected during cherry-picking, you can use Dolt's conflict resolution features to resolve them. For more information on resolving conflicts, see: https://docs.dolthub.com/concepts/dolt/git/conflicts. Once the conflicts are resolved and the tables are staged, {{.EmphasisLeft}}dolt cherry-pick --continue{{.EmphasisRight}} commits the changes and cherry-picks any remaining commits. {{.EmphasisLeft}}dolt cherry-pick --skip{{.EmphasisRight}} discards the changes from the conflicting commit and continues with the remaining commits. {{.EmphasisLeft}}dolt cherry-pick --abort{{.EmphasisRight}} discards the changes from the conflicting commit and the remaining commits, and resets HEAD to the commit it pointed to before the cherry-pick started, undoing any commits that were already applied.
 `,
 	Synopsis: []string{
-		`[--allow-empty] {{.LessThan}}commit{{.GreaterThan}}`,
+		`[--allow-empty] [-m {{.LessThan}}parent-number{{.GreaterThan}}] {{.LessThan}}commit{{.GreaterThan}}...`,
+		`--continue`,
+		`--skip`,
+		`--abort`,
 	},
 }
 
 var ErrCherryPickConflictsOrViolations = errors.NewKind("error: Unable to apply commit cleanly due to conflicts " +
 	"or constraint violations. Please resolve the conflicts and/or constraint violations, then use `dolt add` " +
-	"to add the tables to the staged set, and `dolt commit` to commit the changes and finish cherry-picking. \n" +
+	"to add the tables to the staged set, and `dolt cherry-pick --continue` to commit the changes and continue cherry-picking " +
+	"any remaining commits. \n" +
 	"To undo all changes from this cherry-pick operation, use `dolt cherry-pick --abort`.\n" +
 	"For more information on handling conflicts, see: https://docs.dolthub.com/concepts/dolt/git/conflicts")
 
@@ -112,12 +118,14 @@ func (cmd CherryPickCmd) Exec(ctx context.Context, commandStr string, args []str
 		}
 	}
 
-	// TODO : support single commit cherry-pick only for now
+	if apr.Contains(cli.ContinueFlag) || apr.Contains(cli.SkipFlag) {
+		err = executeCherryPick(queryist, sqlCtx, args)
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
 	if apr.NArg() == 0 {
 		usage()
 		return 1
-	} else if apr.NArg() > 1 {
-		return HandleVErrAndExitCode(errhand.BuildDError("cherry-picking multiple commits is not supported yet").SetPrintUsage().Build(), usage)
 	}
 
 	err = cherryPick(queryist, sqlCtx, apr, args)
@@ -125,9 +133,10 @@ func (cmd CherryPickCmd) Exec(ctx context.Context, commandStr string, args []str
 }
 
 func cherryPick(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgParseResults, args []string) error {
-	cherryStr := apr.Arg(0)
-	if len(cherryStr) == 0 {
-		return fmt.Errorf("error: cannot cherry-pick empty string")
+	for _, cherryStr := range apr.Args {
+		if len(cherryStr) == 0 {
+			return fmt.Errorf("error: cannot cherry-pick empty string")
+		}
 	}
 
 	hasStagedChanges, hasUnstagedChanges, err := hasStagedAndUnstagedChanged(queryist, sqlCtx)
@@ -142,7 +151,13 @@ func cherryPick(queryist cli.Queryist, sqlCtx *sql.Context, apr *argparser.ArgPa
 hint: commit your changes (dolt commit -am \"<message>\") or reset them (dolt reset --hard) to proceed.`)
 	}
 
-	_, err = GetRowsForSql(queryist, sqlCtx, "set @@dolt_allow_commit_conflicts = 1")
+	return executeCherryPick(queryist, sqlCtx, args)
+}
+
+// executeCherryPick calls dolt_cherry_pick with |args| and prints the last commit created, or returns an error
+// describing how to resolve any conflicts or constraint violations that stopped the cherry-pick.
+func executeCherryPick(queryist cli.Queryist, sqlCtx *sql.Context, args []string) error {
+	_, err := GetRowsForSql(queryist, sqlCtx, "set @@dolt_allow_commit_conflicts = 1")
 	if err != nil {
 		return fmt.Errorf("error: failed to set @@dolt_allow_commit_conflicts: %w", err)
 	}
@@ -173,6 +188,7 @@ hint: commit your changes (dolt commit -am \"<message>\") or reset them (dolt re
 	}
 
 	succeeded := false
+	noCommit := false
 	commitHash := ""
 	for _, row := range rows {
 		commitHash = row[0].(string)
@@ -190,12 +206,16 @@ hint: commit your changes (dolt commit -am \"<message>\") or reset them (dolt re
 		}
 
 		// if we have a hash and all 0s, then the cherry-pick succeeded
-		if len(commitHash) > 0 && dataConflicts == 0 && schemaConflicts == 0 && constraintViolations == 0 {
-			succeeded = true
+		if dataConflicts == 0 && schemaConflicts == 0 && constraintViolations == 0 {
+			succeeded = len(commitHash) > 0
+			// skipping the last commit of a cherry-pick leaves no commit to report
+			noCommit = len(commitHash) == 0
 		}
 	}
 
-	if succeeded {
+	if noCommit {
+		return nil
+	} else if succeeded {
 		// on success, print the commit info
 		commit, err := getCommitInfo(queryist, sqlCtx, commitHash)
 		if commit == nil || err != nil {
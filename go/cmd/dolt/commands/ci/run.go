@@ -0,0 +1,179 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ci
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fatih/color"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/dolt_ci"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+const runBranchFlag = "branch"
+
+var runDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Run a Dolt continuous integration workflow by name",
+	LongDesc: `Run a Dolt continuous integration workflow by name.
+
+Each saved query step of each job in the workflow is executed against the branch given by {{.EmphasisLeft}}--branch{{.EmphasisRight}}, or against the current branch if none is given, and the number of rows and columns it returns is compared with the step's expected results. A report of passing and failing steps is printed, and the command exits with a non-zero exit code if any step fails.`,
+	Synopsis: []string{
+		"[--branch {{.LessThan}}branch{{.GreaterThan}}] {{.LessThan}}workflow name{{.GreaterThan}}",
+	},
+}
+
+type RunCmd struct{}
+
+// Name implements cli.Command.
+func (cmd RunCmd) Name() string {
+	return "run"
+}
+
+// Description implements cli.Command.
+func (cmd RunCmd) Description() string {
+	return runDocs.ShortDesc
+}
+
+// RequiresRepo implements cli.Command.
+func (cmd RunCmd) RequiresRepo() bool {
+	return true
+}
+
+// Docs implements cli.Command.
+func (cmd RunCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(runDocs, ap)
+}
+
+// Hidden should return true if this command should be hidden from the help text
+func (cmd RunCmd) Hidden() bool {
+	return false
+}
+
+// ArgParser implements cli.Command.
+func (cmd RunCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	ap.SupportsString(runBranchFlag, "b", "branch", "The branch to run the workflow against. Defaults to the current branch.")
+	return ap
+}
+
+// Exec implements cli.Command.
+func (cmd RunCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, runDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+	if !cli.CheckEnvIsValid(dEnv) {
+		return 1
+	}
+
+	var verr errhand.VerboseError
+	verr = validateRunArgs(apr)
+	if verr != nil {
+		return commands.HandleVErrAndExitCode(verr, usage)
+	}
+
+	workflowName := apr.Arg(0)
+	branch := apr.GetValueOrDefault(runBranchFlag, "")
+
+	queryist, sqlCtx, closeFunc, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if closeFunc != nil {
+		defer closeFunc()
+	}
+
+	user, email, err := env.GetNameAndEmail(dEnv.Config)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	hasTables, err := dolt_ci.HasDoltCITables(sqlCtx)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	if !hasTables {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(fmt.Errorf("dolt ci has not been initialized, please initialize with: dolt ci init")), usage)
+	}
+
+	wm := dolt_ci.NewWorkflowManager(user, email, queryist.Query)
+
+	db, err := newDatabase(sqlCtx, sqlCtx.GetCurrentDatabase(), dEnv, false)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	result, err := wm.RunWorkflow(sqlCtx, db, workflowName, branch)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	printWorkflowRunResult(result)
+
+	if !result.Passed() {
+		return 1
+	}
+	return 0
+}
+
+func printWorkflowRunResult(result *dolt_ci.WorkflowRunResult) {
+	if result.Branch != "" {
+		cli.Println(color.CyanString(fmt.Sprintf("Running workflow '%s' on branch '%s'", result.WorkflowName, result.Branch)))
+	} else {
+		cli.Println(color.CyanString(fmt.Sprintf("Running workflow '%s'", result.WorkflowName)))
+	}
+
+	passed, failed := 0, 0
+	for _, job := range result.Jobs {
+		cli.Println(fmt.Sprintf("Job: %s", job.JobName))
+		for _, step := range job.Steps {
+			if step.Passed() {
+				passed++
+				cli.Println(color.GreenString(fmt.Sprintf("  PASS  %s", step.StepName)))
+				continue
+			}
+
+			failed++
+			cli.Println(color.RedString(fmt.Sprintf("  FAIL  %s", step.StepName)))
+			if step.Err != nil {
+				cli.Println(fmt.Sprintf("        error running saved query '%s': %s", step.SavedQueryName, step.Err.Error()))
+			}
+			for _, failure := range step.Failures {
+				cli.Println(fmt.Sprintf("        %s", failure))
+			}
+		}
+	}
+
+	summary := fmt.Sprintf("%d passed, %d failed", passed, failed)
+	if failed > 0 {
+		cli.Println(color.RedString(fmt.Sprintf("Workflow '%s' failed: %s", result.WorkflowName, summary)))
+	} else {
+		cli.Println(color.GreenString(fmt.Sprintf("Workflow '%s' passed: %s", result.WorkflowName, summary)))
+	}
+}
+
+func validateRunArgs(apr *argparser.ArgParseResults) errhand.VerboseError {
+	if apr.NArg() != 1 {
+		return errhand.BuildDError("expected 1 argument").SetPrintUsage().Build()
+	}
+	return nil
+}
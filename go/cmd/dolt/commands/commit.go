@@ -53,7 +53,9 @@ The content to be added can be specified by using dolt add to incrementally \"ad
 
 The log message can be added with the parameter {{.EmphasisLeft}}-m <msg>{{.EmphasisRight}}.  If the {{.LessThan}}-m{{.GreaterThan}} parameter is not provided an editor will be opened where you can review the commit and provide a log message.
 
-The commit timestamp can be modified using the --date parameter.  Dates can be specified in the formats {{.LessThan}}YYYY-MM-DD{{.GreaterThan}}, {{.LessThan}}YYYY-MM-DDTHH:MM:SS{{.GreaterThan}}, or {{.LessThan}}YYYY-MM-DDTHH:MM:SSZ07:00{{.GreaterThan}} (where {{.LessThan}}07:00{{.GreaterThan}} is the time zone offset)."`,
+The commit timestamp can be modified using the --date parameter.  Dates can be specified in the formats {{.LessThan}}YYYY-MM-DD{{.GreaterThan}}, {{.LessThan}}YYYY-MM-DDTHH:MM:SS{{.GreaterThan}}, or {{.LessThan}}YYYY-MM-DDTHH:MM:SSZ07:00{{.GreaterThan}} (where {{.LessThan}}07:00{{.GreaterThan}} is the time zone offset).
+
+The configured user is recorded as the committer of the commit. The author defaults to the committer, and can be set to someone else with the --author parameter. Trailers such as {{.EmphasisLeft}}Reviewed-by{{.EmphasisRight}} or {{.EmphasisLeft}}Ticket{{.EmphasisRight}} can be attached to the commit with the --trailer parameter."`,
 	Synopsis: []string{
 		"[options]",
 	},
@@ -236,21 +238,43 @@ func constructParametrizedDoltCommitQuery(msg string, apr *argparser.ArgParseRes
 		writeToBuffer("-f")
 	}
 
+	// The configured user is the committer, and also the author unless another author is given. An explicit author
+	// is enough to commit without a configured user, in which case the author is also the committer.
+	var committer string
+	if apr.Contains(cli.CommitterParam) {
+		committer, _ = apr.GetValue(cli.CommitterParam)
+	} else if name, email, err := env.GetNameAndEmail(cliCtx.Config()); err == nil {
+		committer = name + " <" + email + ">"
+	} else if !apr.Contains(cli.AuthorParam) {
+		return "", nil, err
+	}
+
 	writeToBuffer("--author")
 	param = true
 	writeToBuffer("?")
-	var author string
+	author := committer
 	if apr.Contains(cli.AuthorParam) {
 		author, _ = apr.GetValue(cli.AuthorParam)
-	} else {
-		name, email, err := env.GetNameAndEmail(cliCtx.Config())
-		if err != nil {
-			return "", nil, err
-		}
-		author = name + " <" + email + ">"
+	}
+	if committer == "" {
+		committer = author
 	}
 	params = append(params, author)
 
+	writeToBuffer("--committer")
+	param = true
+	writeToBuffer("?")
+	params = append(params, committer)
+
+	if trailers, ok := apr.GetValueList(cli.TrailerParam); ok {
+		for _, trailer := range trailers {
+			writeToBuffer("--trailer")
+			param = true
+			writeToBuffer("?")
+			params = append(params, trailer)
+		}
+	}
+
 	if apr.Contains(cli.AllFlag) {
 		writeToBuffer("-a")
 	}
@@ -57,9 +57,11 @@ const (
 
 	SchemaAndDataDiff = SchemaOnlyDiff | DataOnlyDiff
 
-	TabularDiffOutput diffOutput = 1
-	SQLDiffOutput     diffOutput = 2
-	JsonDiffOutput    diffOutput = 3
+	TabularDiffOutput  diffOutput = 1
+	SQLDiffOutput      diffOutput = 2
+	JsonDiffOutput     diffOutput = 3
+	MarkdownDiffOutput diffOutput = 4
+	HTMLDiffOutput     diffOutput = 5
 
 	DataFlag     = "data"
 	SchemaFlag   = "schema"
@@ -169,7 +171,7 @@ func (cmd DiffCmd) ArgParser() *argparser.ArgParser {
 	ap.SupportsFlag(SchemaFlag, "s", "Show only the schema changes, do not show the data changes (Both shown by default).")
 	ap.SupportsFlag(StatFlag, "", "Show stats of data changes")
 	ap.SupportsFlag(SummaryFlag, "", "Show summary of data and schema changes")
-	ap.SupportsString(FormatFlag, "r", "result output format", "How to format diff output. Valid values are tabular, sql, json. Defaults to tabular.")
+	ap.SupportsString(FormatFlag, "r", "result output format", "How to format diff output. Valid values are tabular, sql, json, markdown, html. Defaults to tabular.")
 	ap.SupportsString(whereParam, "", "column", "filters columns based on values in the diff.  See {{.EmphasisLeft}}dolt diff --help{{.EmphasisRight}} for details.")
 	ap.SupportsInt(limitParam, "", "record_count", "limits to the first N diffs.")
 	ap.SupportsFlag(cli.StagedFlag, "", "Show only the staged data changes.")
@@ -232,7 +234,7 @@ func (cmd DiffCmd) validateArgs(apr *argparser.ArgParseResults) errhand.VerboseE
 
 	f, _ := apr.GetValue(FormatFlag)
 	switch strings.ToLower(f) {
-	case "tabular", "sql", "json", "":
+	case "tabular", "sql", "json", "markdown", "html", "":
 	default:
 		return errhand.BuildDError("invalid output format: %s", f).Build()
 	}
@@ -276,6 +278,10 @@ func parseDiffDisplaySettings(apr *argparser.ArgParseResults) *diffDisplaySettin
 		displaySettings.diffOutput = SQLDiffOutput
 	case "json":
 		displaySettings.diffOutput = JsonDiffOutput
+	case "markdown":
+		displaySettings.diffOutput = MarkdownDiffOutput
+	case "html":
+		displaySettings.diffOutput = HTMLDiffOutput
 	}
 
 	displaySettings.limit, _ = apr.GetInt(limitParam)
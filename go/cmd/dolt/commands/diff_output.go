@@ -68,6 +68,10 @@ func newDiffWriter(diffOutput diffOutput) (diffWriter, error) {
 		return sqlDiffWriter{}, nil
 	case JsonDiffOutput:
 		return newJsonDiffWriter(iohelp.NopWrCloser(cli.CliOut))
+	case MarkdownDiffOutput:
+		return newMarkdownDiffWriter(cli.CliOut), nil
+	case HTMLDiffOutput:
+		return newHtmlDiffWriter(cli.CliOut)
 	default:
 		panic(fmt.Sprintf("unexpected diff output: %v", diffOutput))
 	}
@@ -165,21 +169,49 @@ func (t tabularDiffWriter) WriteTableDiffStats(diffStats []diffStatistics, oldCo
 
 	cli.DeleteAndPrint(pos, "")
 
-	if (acc.Adds+acc.Removes+acc.Changes) == 0 && (acc.OldCellSize-acc.NewCellSize) == 0 {
-		cli.Println("No data changes. See schema changes by using -s or --schema.")
+	if !hasDataChanges(acc) {
+		cli.Println(noDataChangesMsg)
 		return nil
 	}
 
+	var lines []string
 	if areTablesKeyless {
-		t.printKeylessStat(acc)
+		lines = keylessDiffStatLines(acc)
 	} else {
-		t.printStat(acc, oldColLen, newColLen)
+		lines = diffStatLines(acc, oldColLen, newColLen)
+		lines = append(lines, "")
+	}
+	for _, line := range lines {
+		cli.Println(line)
 	}
 
 	return nil
 }
 
-func (t tabularDiffWriter) printStat(acc diff.DiffStatProgress, oldColLen, newColLen int) {
+const noDataChangesMsg = "No data changes. See schema changes by using -s or --schema."
+
+// sumDiffStats returns the totals of |diffStats|.
+func sumDiffStats(diffStats []diffStatistics) diff.DiffStatProgress {
+	acc := diff.DiffStatProgress{}
+	for _, diffStat := range diffStats {
+		acc.Adds += diffStat.RowsAdded
+		acc.Removes += diffStat.RowsDeleted
+		acc.Changes += diffStat.RowsModified
+		acc.CellChanges += diffStat.CellsModified
+		acc.NewRowSize += diffStat.NewRowCount
+		acc.OldRowSize += diffStat.OldRowCount
+		acc.NewCellSize += diffStat.NewCellCount
+		acc.OldCellSize += diffStat.OldCellCount
+	}
+	return acc
+}
+
+func hasDataChanges(acc diff.DiffStatProgress) bool {
+	return (acc.Adds+acc.Removes+acc.Changes) != 0 || (acc.OldCellSize-acc.NewCellSize) != 0
+}
+
+// diffStatLines returns the lines describing the diff stats |acc| of a table with primary keys.
+func diffStatLines(acc diff.DiffStatProgress, oldColLen, newColLen int) []string {
 	numCellInserts, numCellDeletes := dtablefunctions.GetCellsAddedAndDeleted(acc, newColLen)
 	rowsUnmodified := uint64(acc.OldRowSize - acc.Changes - acc.Removes)
 	unmodified := pluralize("Row Unmodified", "Rows Unmodified", rowsUnmodified)
@@ -203,22 +235,24 @@ func (t tabularDiffWriter) printStat(acc diff.DiffStatProgress, oldColLen, newCo
 		return float64(100*num) / (float64(dom))
 	}
 
-	cli.Printf("%s (%.2f%%)\n", unmodified, safePercent(rowsUnmodified, acc.OldRowSize))
-	cli.Printf("%s (%.2f%%)\n", insertions, safePercent(acc.Adds, acc.OldRowSize))
-	cli.Printf("%s (%.2f%%)\n", deletions, safePercent(acc.Removes, acc.OldRowSize))
-	cli.Printf("%s (%.2f%%)\n", changes, safePercent(acc.Changes, acc.OldRowSize))
-	cli.Printf("%s (%.2f%%)\n", cellInsertions, safePercent(numCellInserts, acc.OldCellSize))
-	cli.Printf("%s (%.2f%%)\n", cellDeletions, safePercent(numCellDeletes, acc.OldCellSize))
-	cli.Printf("%s (%.2f%%)\n", cellChanges, percentCellsChanged)
-	cli.Printf("(%s vs %s)\n\n", oldValues, newValues)
+	return []string{
+		fmt.Sprintf("%s (%.2f%%)", unmodified, safePercent(rowsUnmodified, acc.OldRowSize)),
+		fmt.Sprintf("%s (%.2f%%)", insertions, safePercent(acc.Adds, acc.OldRowSize)),
+		fmt.Sprintf("%s (%.2f%%)", deletions, safePercent(acc.Removes, acc.OldRowSize)),
+		fmt.Sprintf("%s (%.2f%%)", changes, safePercent(acc.Changes, acc.OldRowSize)),
+		fmt.Sprintf("%s (%.2f%%)", cellInsertions, safePercent(numCellInserts, acc.OldCellSize)),
+		fmt.Sprintf("%s (%.2f%%)", cellDeletions, safePercent(numCellDeletes, acc.OldCellSize)),
+		fmt.Sprintf("%s (%.2f%%)", cellChanges, percentCellsChanged),
+		fmt.Sprintf("(%s vs %s)", oldValues, newValues),
+	}
 }
 
-func (t tabularDiffWriter) printKeylessStat(acc diff.DiffStatProgress) {
-	insertions := pluralize("Row Added", "Rows Added", acc.Adds)
-	deletions := pluralize("Row Deleted", "Rows Deleted", acc.Removes)
-
-	cli.Printf("%s\n", insertions)
-	cli.Printf("%s\n", deletions)
+// keylessDiffStatLines returns the lines describing the diff stats |acc| of a keyless table.
+func keylessDiffStatLines(acc diff.DiffStatProgress) []string {
+	return []string{
+		pluralize("Row Added", "Rows Added", acc.Adds),
+		pluralize("Row Deleted", "Rows Deleted", acc.Removes),
+	}
 }
 
 func (t tabularDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary, unionSch sql.Schema) (diff.SqlRowDiffWriter, error) {
@@ -0,0 +1,297 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	textdiff "github.com/andreyvit/diff"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+)
+
+const htmlDiffHeader = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>dolt diff</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; }
+h2 { font-size: 1.2em; border-bottom: 1px solid #d0d7de; padding-bottom: 0.3em; }
+.note { color: #57606a; font-style: italic; }
+pre { background: #f6f8fa; padding: 0.5em; overflow-x: auto; }
+pre .added { background: #e6ffec; }
+pre .removed { background: #ffebe9; }
+table { border-collapse: collapse; margin-bottom: 1.5em; font-family: monospace; }
+th, td { border: 1px solid #d0d7de; padding: 2px 8px; text-align: left; vertical-align: top; white-space: pre-wrap; }
+th { background: #f6f8fa; }
+tr.added td { background: #e6ffec; }
+tr.removed td { background: #ffebe9; }
+tr.modified-old td.changed { background: #ffc1c0; }
+tr.modified-new td.changed { background: #abf2bc; }
+td.marker { color: #57606a; }
+del { background: #ffc1c0; }
+ins { background: #abf2bc; text-decoration: none; }
+</style>
+</head>
+<body>
+`
+
+const htmlDiffFooter = `</body>
+</html>
+`
+
+// htmlDiffWriter writes diffs as a standalone HTML page. Data changes are written as tables in which added and removed
+// rows are highlighted, and the changed cells of modified rows are highlighted individually.
+type htmlDiffWriter struct {
+	wr io.Writer
+}
+
+var _ diffWriter = (*htmlDiffWriter)(nil)
+
+// newHtmlDiffWriter returns a new htmlDiffWriter, writing the beginning of the page to |wr|.
+func newHtmlDiffWriter(wr io.Writer) (*htmlDiffWriter, error) {
+	_, err := io.WriteString(wr, htmlDiffHeader)
+	if err != nil {
+		return nil, err
+	}
+	return &htmlDiffWriter{wr: wr}, nil
+}
+
+func (h *htmlDiffWriter) Close(ctx context.Context) error {
+	_, err := io.WriteString(h.wr, htmlDiffFooter)
+	return err
+}
+
+func (h *htmlDiffWriter) BeginTable(fromTableName, toTableName string, isAdd, isDrop bool) error {
+	var err error
+	if isDrop {
+		_, err = fmt.Fprintf(h.wr, "<h2>%s</h2>\n<p class=\"note\">deleted table</p>\n", html.EscapeString(fromTableName))
+	} else if isAdd {
+		_, err = fmt.Fprintf(h.wr, "<h2>%s</h2>\n<p class=\"note\">added table</p>\n", html.EscapeString(toTableName))
+	} else if fromTableName != toTableName {
+		_, err = fmt.Fprintf(h.wr, "<h2>%s &rarr; %s</h2>\n<p class=\"note\">renamed table</p>\n", html.EscapeString(fromTableName), html.EscapeString(toTableName))
+	} else {
+		_, err = fmt.Fprintf(h.wr, "<h2>%s</h2>\n", html.EscapeString(toTableName))
+	}
+	return err
+}
+
+func (h *htmlDiffWriter) WriteTableSchemaDiff(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary) error {
+	var fromCreateStmt = ""
+	if fromTableInfo != nil {
+		fromCreateStmt = fromTableInfo.CreateStmt
+	}
+
+	var toCreateStmt = ""
+	if toTableInfo != nil {
+		toCreateStmt = toTableInfo.CreateStmt
+	}
+
+	if fromCreateStmt == toCreateStmt {
+		return nil
+	}
+	return h.writeDiffBlock(fromCreateStmt, toCreateStmt)
+}
+
+func (h *htmlDiffWriter) WriteEventDiff(ctx context.Context, eventName, oldDefn, newDefn string) error {
+	return h.writeSchemaFragmentDiff("event", eventName, oldDefn, newDefn)
+}
+
+func (h *htmlDiffWriter) WriteTriggerDiff(ctx context.Context, triggerName, oldDefn, newDefn string) error {
+	return h.writeSchemaFragmentDiff("trigger", triggerName, oldDefn, newDefn)
+}
+
+func (h *htmlDiffWriter) WriteViewDiff(ctx context.Context, viewName, oldDefn, newDefn string) error {
+	return h.writeSchemaFragmentDiff("view", viewName, oldDefn, newDefn)
+}
+
+func (h *htmlDiffWriter) writeSchemaFragmentDiff(fragmentType, name, oldDefn, newDefn string) error {
+	_, err := fmt.Fprintf(h.wr, "<h2>%s %s</h2>\n", fragmentType, html.EscapeString(name))
+	if err != nil {
+		return err
+	}
+	return h.writeDiffBlock(oldDefn, newDefn)
+}
+
+// writeDiffBlock writes the line diff between |from| and |to| as a preformatted block with added and removed lines
+// highlighted.
+func (h *htmlDiffWriter) writeDiffBlock(from, to string) error {
+	var sb strings.Builder
+	sb.WriteString("<pre>")
+	for _, line := range textdiff.LineDiffAsLines(from, to) {
+		class := ""
+		if strings.HasPrefix(line, "+") {
+			class = "added"
+		} else if strings.HasPrefix(line, "-") {
+			class = "removed"
+		}
+
+		if class != "" {
+			sb.WriteString(fmt.Sprintf("<span class=\"%s\">%s</span>\n", class, html.EscapeString(line)))
+		} else {
+			sb.WriteString(html.EscapeString(line) + "\n")
+		}
+	}
+	sb.WriteString("</pre>\n")
+
+	_, err := io.WriteString(h.wr, sb.String())
+	return err
+}
+
+func (h *htmlDiffWriter) WriteTableDiffStats(diffStats []diffStatistics, oldColLen, newColLen int, areTablesKeyless bool) error {
+	acc := sumDiffStats(diffStats)
+	if !hasDataChanges(acc) {
+		_, err := fmt.Fprintf(h.wr, "<p class=\"note\">%s</p>\n", html.EscapeString(noDataChangesMsg))
+		return err
+	}
+
+	var lines []string
+	if areTablesKeyless {
+		lines = keylessDiffStatLines(acc)
+	} else {
+		lines = diffStatLines(acc, oldColLen, newColLen)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<ul>\n")
+	for _, line := range lines {
+		sb.WriteString("<li>" + html.EscapeString(line) + "</li>\n")
+	}
+	sb.WriteString("</ul>\n")
+
+	_, err := io.WriteString(h.wr, sb.String())
+	return err
+}
+
+func (h *htmlDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary, unionSch sql.Schema) (diff.SqlRowDiffWriter, error) {
+	return &htmlRowDiffWriter{wr: h.wr, sch: unionSch}, nil
+}
+
+// htmlRowDiffWriter writes the rows of a data diff as an HTML table.
+type htmlRowDiffWriter struct {
+	wr          io.Writer
+	sch         sql.Schema
+	rowsWritten int
+}
+
+var _ diff.SqlRowDiffWriter = (*htmlRowDiffWriter)(nil)
+
+func (w *htmlRowDiffWriter) writeHeaderIfNecessary() error {
+	if w.rowsWritten > 0 {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("<table>\n<thead><tr><th></th>")
+	for _, col := range w.sch {
+		sb.WriteString("<th>" + html.EscapeString(col.Name) + "</th>")
+	}
+	sb.WriteString("</tr></thead>\n<tbody>\n")
+
+	_, err := io.WriteString(w.wr, sb.String())
+	return err
+}
+
+func (w *htmlRowDiffWriter) WriteRow(ctx context.Context, row sql.Row, diffType diff.ChangeType, colDiffTypes []diff.ChangeType) error {
+	if len(row) != len(colDiffTypes) {
+		return fmt.Errorf("expected the same size for columns and diff types, got %d and %d", len(row), len(colDiffTypes))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("<tr class=\"%s\"><td class=\"marker\">%s</td>", htmlRowClass(diffType), html.EscapeString(diffMarker(diffType))))
+	for i := range row {
+		str, err := diffCellString(w.sch, i, row[i])
+		if err != nil {
+			return err
+		}
+		if isModifiedCell(diffType, colDiffTypes[i]) {
+			sb.WriteString("<td class=\"changed\">")
+		} else {
+			sb.WriteString("<td>")
+		}
+		sb.WriteString(html.EscapeString(str) + "</td>")
+	}
+	sb.WriteString("</tr>\n")
+
+	return w.writeRow(sb.String())
+}
+
+func (w *htmlRowDiffWriter) WriteCombinedRow(ctx context.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
+	var sb strings.Builder
+	sb.WriteString("<tr class=\"modified\"><td class=\"marker\">*</td>")
+	for i := range oldRow {
+		oldStr, err := diffCellString(w.sch, i, oldRow[i])
+		if err != nil {
+			return err
+		}
+		newStr, err := diffCellString(w.sch, i, newRow[i])
+		if err != nil {
+			return err
+		}
+
+		if oldStr == newStr {
+			sb.WriteString("<td>" + html.EscapeString(newStr) + "</td>")
+		} else {
+			sb.WriteString(fmt.Sprintf("<td class=\"changed\"><del>%s</del> <ins>%s</ins></td>", html.EscapeString(oldStr), html.EscapeString(newStr)))
+		}
+	}
+	sb.WriteString("</tr>\n")
+
+	return w.writeRow(sb.String())
+}
+
+func (w *htmlRowDiffWriter) writeRow(row string) error {
+	err := w.writeHeaderIfNecessary()
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w.wr, row)
+	if err != nil {
+		return err
+	}
+	w.rowsWritten++
+	return nil
+}
+
+func (w *htmlRowDiffWriter) Close(ctx context.Context) error {
+	if w.rowsWritten == 0 {
+		return nil
+	}
+	_, err := io.WriteString(w.wr, "</tbody>\n</table>\n")
+	return err
+}
+
+// htmlRowClass returns the CSS class of table rows of |diffType|.
+func htmlRowClass(diffType diff.ChangeType) string {
+	switch diffType {
+	case diff.Removed:
+		return "removed"
+	case diff.Added:
+		return "added"
+	case diff.ModifiedOld:
+		return "modified-old"
+	case diff.ModifiedNew:
+		return "modified-new"
+	default:
+		return ""
+	}
+}
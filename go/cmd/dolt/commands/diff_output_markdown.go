@@ -0,0 +1,292 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	textdiff "github.com/andreyvit/diff"
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+)
+
+// markdownDiffWriter writes diffs as GitHub-flavored markdown, suitable for pasting into code reviews and chat. Each
+// table gets a heading, schema changes are written as fenced diff blocks, and data changes as tables with a leading
+// column marking each row as added (+), removed (-), or the old (<) and new (>) versions of a modified row.
+type markdownDiffWriter struct {
+	wr io.Writer
+}
+
+var _ diffWriter = (*markdownDiffWriter)(nil)
+
+func newMarkdownDiffWriter(wr io.Writer) *markdownDiffWriter {
+	return &markdownDiffWriter{wr: wr}
+}
+
+func (m *markdownDiffWriter) Close(ctx context.Context) error {
+	return nil
+}
+
+func (m *markdownDiffWriter) BeginTable(fromTableName, toTableName string, isAdd, isDrop bool) error {
+	var err error
+	if isDrop {
+		_, err = fmt.Fprintf(m.wr, "## %s\n\n_deleted table_\n\n", markdownCodeSpan(fromTableName))
+	} else if isAdd {
+		_, err = fmt.Fprintf(m.wr, "## %s\n\n_added table_\n\n", markdownCodeSpan(toTableName))
+	} else if fromTableName != toTableName {
+		_, err = fmt.Fprintf(m.wr, "## %s → %s\n\n_renamed table_\n\n", markdownCodeSpan(fromTableName), markdownCodeSpan(toTableName))
+	} else {
+		_, err = fmt.Fprintf(m.wr, "## %s\n\n", markdownCodeSpan(toTableName))
+	}
+	return err
+}
+
+func (m *markdownDiffWriter) WriteTableSchemaDiff(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary) error {
+	var fromCreateStmt = ""
+	if fromTableInfo != nil {
+		fromCreateStmt = fromTableInfo.CreateStmt
+	}
+
+	var toCreateStmt = ""
+	if toTableInfo != nil {
+		toCreateStmt = toTableInfo.CreateStmt
+	}
+
+	if fromCreateStmt == toCreateStmt {
+		return nil
+	}
+	return m.writeDiffBlock(fromCreateStmt, toCreateStmt)
+}
+
+func (m *markdownDiffWriter) WriteEventDiff(ctx context.Context, eventName, oldDefn, newDefn string) error {
+	return m.writeSchemaFragmentDiff("event", eventName, oldDefn, newDefn)
+}
+
+func (m *markdownDiffWriter) WriteTriggerDiff(ctx context.Context, triggerName, oldDefn, newDefn string) error {
+	return m.writeSchemaFragmentDiff("trigger", triggerName, oldDefn, newDefn)
+}
+
+func (m *markdownDiffWriter) WriteViewDiff(ctx context.Context, viewName, oldDefn, newDefn string) error {
+	return m.writeSchemaFragmentDiff("view", viewName, oldDefn, newDefn)
+}
+
+func (m *markdownDiffWriter) writeSchemaFragmentDiff(fragmentType, name, oldDefn, newDefn string) error {
+	_, err := fmt.Fprintf(m.wr, "## %s %s\n\n", fragmentType, markdownCodeSpan(name))
+	if err != nil {
+		return err
+	}
+	return m.writeDiffBlock(oldDefn, newDefn)
+}
+
+// writeDiffBlock writes the line diff between |from| and |to| as a fenced code block with diff syntax highlighting. The
+// fence is longer than any run of backticks in the diff, so that the diff can't close it.
+func (m *markdownDiffWriter) writeDiffBlock(from, to string) error {
+	lineDiff := textdiff.LineDiff(from, to)
+	fence := strings.Repeat("`", max(3, longestBacktickRun(lineDiff)+1))
+	_, err := fmt.Fprintf(m.wr, "%sdiff\n%s\n%s\n\n", fence, lineDiff, fence)
+	return err
+}
+
+// markdownCodeSpan returns |s| as an inline code span, delimited by more backticks than any run of backticks in |s|.
+func markdownCodeSpan(s string) string {
+	delim := strings.Repeat("`", longestBacktickRun(s)+1)
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		// a space separates backticks at the start or end of the code from the delimiter, and isn't rendered
+		s = " " + s + " "
+	}
+	return delim + s + delim
+}
+
+// longestBacktickRun returns the length of the longest run of consecutive backticks in |s|.
+func longestBacktickRun(s string) int {
+	longest, run := 0, 0
+	for _, r := range s {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	return longest
+}
+
+func (m *markdownDiffWriter) WriteTableDiffStats(diffStats []diffStatistics, oldColLen, newColLen int, areTablesKeyless bool) error {
+	acc := sumDiffStats(diffStats)
+	if !hasDataChanges(acc) {
+		_, err := fmt.Fprintf(m.wr, "%s\n\n", noDataChangesMsg)
+		return err
+	}
+
+	var lines []string
+	if areTablesKeyless {
+		lines = keylessDiffStatLines(acc)
+	} else {
+		lines = diffStatLines(acc, oldColLen, newColLen)
+	}
+	for _, line := range lines {
+		_, err := fmt.Fprintf(m.wr, "- %s\n", line)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintln(m.wr)
+	return err
+}
+
+func (m *markdownDiffWriter) RowWriter(fromTableInfo, toTableInfo *diff.TableInfo, tds diff.TableDeltaSummary, unionSch sql.Schema) (diff.SqlRowDiffWriter, error) {
+	return &markdownRowDiffWriter{wr: m.wr, sch: unionSch}, nil
+}
+
+// markdownRowDiffWriter writes the rows of a data diff as a markdown table. Changed cells of modified rows are bold.
+type markdownRowDiffWriter struct {
+	wr          io.Writer
+	sch         sql.Schema
+	rowsWritten int
+}
+
+var _ diff.SqlRowDiffWriter = (*markdownRowDiffWriter)(nil)
+
+func (w *markdownRowDiffWriter) writeHeaderIfNecessary() error {
+	if w.rowsWritten > 0 {
+		return nil
+	}
+
+	var header, separator strings.Builder
+	header.WriteString("|   |")
+	separator.WriteString("|---|")
+	for _, col := range w.sch {
+		header.WriteString(" " + escapeMarkdownCell(col.Name) + " |")
+		separator.WriteString("---|")
+	}
+	_, err := fmt.Fprintf(w.wr, "%s\n%s\n", header.String(), separator.String())
+	return err
+}
+
+func (w *markdownRowDiffWriter) WriteRow(ctx context.Context, row sql.Row, diffType diff.ChangeType, colDiffTypes []diff.ChangeType) error {
+	if len(row) != len(colDiffTypes) {
+		return fmt.Errorf("expected the same size for columns and diff types, got %d and %d", len(row), len(colDiffTypes))
+	}
+
+	cells := make([]string, len(row))
+	for i := range row {
+		str, err := diffCellString(w.sch, i, row[i])
+		if err != nil {
+			return err
+		}
+		cells[i] = escapeMarkdownCell(str)
+		if isModifiedCell(diffType, colDiffTypes[i]) && len(cells[i]) > 0 {
+			cells[i] = "**" + cells[i] + "**"
+		}
+	}
+
+	return w.writeRow(diffMarker(diffType), cells)
+}
+
+func (w *markdownRowDiffWriter) WriteCombinedRow(ctx context.Context, oldRow, newRow sql.Row, mode diff.Mode) error {
+	cells := make([]string, len(oldRow))
+	for i := range oldRow {
+		oldStr, err := diffCellString(w.sch, i, oldRow[i])
+		if err != nil {
+			return err
+		}
+		newStr, err := diffCellString(w.sch, i, newRow[i])
+		if err != nil {
+			return err
+		}
+
+		if oldStr == newStr {
+			cells[i] = escapeMarkdownCell(newStr)
+		} else {
+			cells[i] = fmt.Sprintf("~~%s~~ **%s**", escapeMarkdownCell(oldStr), escapeMarkdownCell(newStr))
+		}
+	}
+
+	return w.writeRow("*", cells)
+}
+
+func (w *markdownRowDiffWriter) writeRow(marker string, cells []string) error {
+	err := w.writeHeaderIfNecessary()
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w.wr, "| %s | %s |\n", escapeMarkdownCell(marker), strings.Join(cells, " | "))
+	if err != nil {
+		return err
+	}
+	w.rowsWritten++
+	return nil
+}
+
+func (w *markdownRowDiffWriter) Close(ctx context.Context) error {
+	if w.rowsWritten == 0 {
+		return nil
+	}
+	_, err := fmt.Fprintln(w.wr)
+	return err
+}
+
+// markdownCellEscaper escapes the characters that would end a markdown table cell or be rendered as emphasis,
+// strikethrough or code.
+var markdownCellEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"|", `\|`,
+	"*", `\*`,
+	"_", `\_`,
+	"~", `\~`,
+	"`", "\\`",
+	"\r\n", "<br>",
+	"\n", "<br>",
+)
+
+// escapeMarkdownCell escapes |s| so that it can be written as a single cell of a markdown table.
+func escapeMarkdownCell(s string) string {
+	return markdownCellEscaper.Replace(s)
+}
+
+// diffMarker returns the marker used to label rows of |diffType|, matching the tabular diff output.
+func diffMarker(diffType diff.ChangeType) string {
+	switch diffType {
+	case diff.Removed:
+		return "-"
+	case diff.Added:
+		return "+"
+	case diff.ModifiedOld:
+		return "<"
+	case diff.ModifiedNew:
+		return ">"
+	default:
+		return ""
+	}
+}
+
+// isModifiedCell returns whether a cell with |colDiffType| is a changed value of a modified row.
+func isModifiedCell(rowDiffType, colDiffType diff.ChangeType) bool {
+	return (rowDiffType == diff.ModifiedOld || rowDiffType == diff.ModifiedNew) && colDiffType != diff.None
+}
+
+// diffCellString returns the string representation of |val|, the value of the column |idx| of |sch|.
+func diffCellString(sch sql.Schema, idx int, val interface{}) (string, error) {
+	if val == nil {
+		return "NULL", nil
+	}
+	return sqlutil.SqlColToStr(sch[idx].Type, val)
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeMarkdownCell(t *testing.T) {
+	assert.Equal(t, `a\|b`, escapeMarkdownCell("a|b"))
+	assert.Equal(t, `\*a\_b\* \~\~c\~\~ \`+"`d\\`", escapeMarkdownCell("*a_b* ~~c~~ `d`"))
+	assert.Equal(t, `a\\b<br>c<br>d`, escapeMarkdownCell("a\\b\r\nc\nd"))
+}
+
+func TestMarkdownCodeSpan(t *testing.T) {
+	assert.Equal(t, "`t`", markdownCodeSpan("t"))
+	assert.Equal(t, "``a`b``", markdownCodeSpan("a`b"))
+	assert.Equal(t, "`` `t` ``", markdownCodeSpan("`t`"))
+}
+
+func TestMarkdownDiffBlockFence(t *testing.T) {
+	var buf bytes.Buffer
+	m := newMarkdownDiffWriter(&buf)
+	require.NoError(t, m.writeDiffBlock("create table t (pk int);", "create table t (pk int); -- ```"))
+	out := buf.String()
+	assert.True(t, strings.HasPrefix(out, "````diff\n"), out)
+	assert.True(t, strings.HasSuffix(out, "\n````\n\n"), out)
+
+	buf.Reset()
+	require.NoError(t, m.writeDiffBlock("a", "b"))
+	assert.True(t, strings.HasPrefix(buf.String(), "```diff\n"), buf.String())
+}
@@ -189,7 +189,7 @@ func NewSqlEngine(
 	engine.Analyzer.Catalog.StatsProvider = statsPro
 
 	engine.Analyzer.ExecBuilder = rowexec.NewOverrideBuilder(kvexec.Builder{})
-	sessFactory := doltSessionFactory(pro, statsPro, mrEnv.Config(), bcController, config.Autocommit)
+	sessFactory := doltSessionFactory(pro, statsPro, mrEnv.Config(), bcController, config.Autocommit, engine)
 	sqlEngine.provider = pro
 	sqlEngine.contextFactory = sqlContextFactory()
 	sqlEngine.dsessFactory = sessFactory
@@ -235,6 +235,16 @@ func NewSqlEngine(
 		if err != nil {
 			return nil, err
 		}
+
+		// Each named replication channel applies its changes with its own session
+		dblr.DoltBinlogReplicaController.SetExecutionContextFactory(func() (*sql.Context, error) {
+			channelSession, err := sessFactory(sql.NewBaseSession(), pro)
+			if err != nil {
+				return nil, err
+			}
+			return sqlContextFactory()(context.Background(), channelSession)
+		})
+		dblr.DoltBinlogReplicaController.RegisterStoredProcedures(pro)
 	}
 
 	return sqlEngine, nil
@@ -410,12 +420,13 @@ func sqlContextFactory() contextFactory {
 }
 
 // doltSessionFactory returns a sessionFactory that creates a new DoltSession
-func doltSessionFactory(pro *dsqle.DoltDatabaseProvider, statsPro sql.StatsProvider, config config.ReadWriteConfig, bc *branch_control.Controller, autocommit bool) sessionFactory {
+func doltSessionFactory(pro *dsqle.DoltDatabaseProvider, statsPro sql.StatsProvider, config config.ReadWriteConfig, bc *branch_control.Controller, autocommit bool, engine *gms.Engine) sessionFactory {
 	return func(mysqlSess *sql.BaseSession, provider sql.DatabaseProvider) (*dsess.DoltSession, error) {
 		doltSession, err := dsess.NewDoltSession(mysqlSess, pro, config, bc, statsPro, writer.NewWriteSession)
 		if err != nil {
 			return nil, err
 		}
+		doltSession.SetQueryEngine(engine)
 
 		// nil ctx is actually fine in this context, not used in setting a session variable. Creating a new context isn't
 		// free, and would be throwaway work, since we need to create a session before creating a sql.Context for user work.
@@ -17,16 +17,20 @@ package commands
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/gocraft/dbr/v2"
 	"github.com/gocraft/dbr/v2/dialect"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
 	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bundle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 )
 
@@ -37,6 +41,8 @@ var fetchDocs = cli.CommandDocumentationContent{
 By default dolt will attempt to fetch from a remote named {{.EmphasisLeft}}origin{{.EmphasisRight}}.  The {{.LessThan}}remote{{.GreaterThan}} parameter allows you to specify the name of a different remote you wish to pull from by the remote's name.
 
 When no refspec(s) are specified on the command line, the fetch_specs for the default remote are used.
+
+If {{.LessThan}}remote{{.GreaterThan}} is the path to a bundle file created with {{.EmphasisLeft}}dolt bundle create{{.EmphasisRight}}, the bundle is fetched from as a read-only remote named {{.EmphasisLeft}}bundle{{.EmphasisRight}}, and its branches are fetched into remote-tracking branches under {{.EmphasisLeft}}bundle/{{.EmphasisRight}} unless refspecs are given. Fetching from an incremental bundle fails if this database is missing any of its prerequisite commits. A sql-server only fetches from bundles in the directory set by the {{.EmphasisLeft}}dolt_bundle_dir{{.EmphasisRight}} system variable, and only for users with the SUPER privilege.
 `,
 
 	Synopsis: []string{
@@ -80,6 +86,21 @@ func (cmd FetchCmd) Exec(ctx context.Context, commandStr string, args []string,
 	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, fetchDocs, ap))
 	apr := cli.ParseArgsOrDie(ap, args, help)
 
+	// allow the local engine to read a bundle given on the command line. A running sql-server only reads bundles
+	// from its own configured @@dolt_bundle_dir.
+	if apr.NArg() > 0 && bundle.IsBundle(apr.Arg(0)) {
+		bundleDir, err := filepath.Abs(filepath.Dir(apr.Arg(0)))
+		if err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+		err = sql.SystemVariables.AssignValues(map[string]interface{}{
+			dsess.DoltBundleDir: bundleDir,
+		})
+		if err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+	}
+
 	queryist, sqlCtx, closeFunc, err := cliCtx.QueryEngine(ctx)
 	if err != nil {
 		cli.PrintErrln(err)
@@ -0,0 +1,397 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+const (
+	outputDirectoryParam = "output-directory"
+
+	patchIndent       = "    "
+	patchSeparator    = "---"
+	patchRevertMarker = "-- revert"
+	maxPatchSlugLen   = 52
+
+	// patchDateFormat keeps the fractional seconds of commit dates, which RFC3339 would drop
+	patchDateFormat = time.RFC3339Nano
+
+	// patchStatementLines and patchRevertLines are the header fields holding the number of lines of the statements and
+	// of the revert statements. Statements can span lines and contain any text, for example in the body of a trigger,
+	// so the sections of a patch are read by their line counts rather than by searching for the revert marker.
+	patchStatementLines = "Statement-Lines"
+	patchRevertLines    = "Revert-Lines"
+)
+
+var formatPatchDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Write commits as patch files.",
+	LongDesc: `Writes each non-merge commit in {{.LessThan}}revision range{{.GreaterThan}} to its own patch file, oldest first, so that the commits can be sent to and applied to another database with {{.EmphasisLeft}}dolt am{{.EmphasisRight}}. If a single commit is given instead of a range, the commits since that commit up to HEAD are written.
+
+Each patch file starts with the commit's author, date and message, followed by the SQL statements that make the commit's schema and data changes, as produced by {{.EmphasisLeft}}dolt_patch(){{.EmphasisRight}}. The statements that revert the commit's data changes are also included, which {{.EmphasisLeft}}dolt am{{.EmphasisRight}} uses to detect rows that have changed in the target database since the patch was made.
+
+Patch files are named after the number of the commit in the series and its subject, for example {{.EmphasisLeft}}0001-add-users-table.patch{{.EmphasisRight}}.
+`,
+	Synopsis: []string{
+		"[-o {{.LessThan}}dir{{.GreaterThan}}] {{.LessThan}}revision range{{.GreaterThan}}",
+		"[-o {{.LessThan}}dir{{.GreaterThan}}] {{.LessThan}}commit{{.GreaterThan}}",
+	},
+}
+
+type FormatPatchCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd FormatPatchCmd) Name() string {
+	return "format-patch"
+}
+
+// Description returns a description of the command
+func (cmd FormatPatchCmd) Description() string {
+	return formatPatchDocs.ShortDesc
+}
+
+func (cmd FormatPatchCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(formatPatchDocs, ap)
+}
+
+func (cmd FormatPatchCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"revision range", "The commits to write, as {{.LessThan}}from{{.GreaterThan}}..{{.LessThan}}to{{.GreaterThan}}."})
+	ap.SupportsString(outputDirectoryParam, "o", "dir", "Write the patch files to {{.LessThan}}dir{{.GreaterThan}} instead of the current directory.")
+	return ap
+}
+
+// EventType returns the type of the event to log
+func (cmd FormatPatchCmd) EventType() eventsapi.ClientEventType {
+	return eventsapi.ClientEventType_TYPE_UNSPECIFIED
+}
+
+// Exec executes the command
+func (cmd FormatPatchCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	apr, usage, terminate, status := ParseArgsOrPrintHelp(ap, commandStr, args, formatPatchDocs)
+	if terminate {
+		return status
+	}
+	if apr.NArg() != 1 {
+		return HandleVErrAndExitCode(errhand.BuildDError("%s takes exactly 1 arg", cmd.Name()).SetPrintUsage().Build(), usage)
+	}
+
+	queryist, sqlCtx, closeFunc, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	if closeFunc != nil {
+		defer closeFunc()
+	}
+
+	revRange := apr.Arg(0)
+	if !strings.Contains(revRange, "..") {
+		revRange = revRange + "..HEAD"
+	}
+
+	patches, err := getCommitPatches(queryist, sqlCtx, revRange)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	dir := apr.GetValueOrDefault(outputDirectoryParam, ".")
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	for i, p := range patches {
+		path := filepath.Join(dir, patchFileName(i+1, p.Message))
+		err = writePatchFile(path, p)
+		if err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+		cli.Println(path)
+	}
+
+	return 0
+}
+
+// commitPatch is a commit written as SQL statements, which can be applied to another database with its authorship
+// intact.
+type commitPatch struct {
+	// Commit is the hash of the commit in the database the patch was made from.
+	Commit string `json:"commit"`
+	// Parent is the hash of the commit's parent in the database the patch was made from.
+	Parent  string    `json:"parent"`
+	Author  string    `json:"author"`
+	Date    time.Time `json:"date"`
+	Message string    `json:"message"`
+	// Statements are the SQL statements that make the commit's schema and data changes.
+	Statements string `json:"statements"`
+	// RevertStatements are the SQL statements that revert the commit's data changes.
+	RevertStatements string `json:"revert_statements"`
+}
+
+// getCommitPatches returns the patches for the non-merge commits in |revRange|, oldest first.
+func getCommitPatches(queryist cli.Queryist, sqlCtx *sql.Context, revRange string) ([]*commitPatch, error) {
+	rows, err := InterpolateAndRunQuery(queryist, sqlCtx, "select commit_hash, committer, email, date, message, parents from dolt_log(?, '--parents')", revRange)
+	if err != nil {
+		return nil, err
+	}
+
+	var patches []*commitPatch
+	for i := len(rows) - 1; i >= 0; i-- {
+		row := rows[i]
+		parents := strings.Split(row[5].(string), ", ")
+		if len(parents) != 1 || parents[0] == "" {
+			// merge commits and the initial commit of the database are skipped
+			continue
+		}
+
+		ts, err := getTimestampColAsUint64(row[3])
+		if err != nil {
+			return nil, err
+		}
+		p := &commitPatch{
+			Commit:  row[0].(string),
+			Parent:  parents[0],
+			Author:  fmt.Sprintf("%s <%s>", row[1].(string), row[2].(string)),
+			Date:    time.UnixMilli(int64(ts)).UTC(),
+			Message: row[4].(string),
+		}
+
+		p.Statements, err = getPatchStatements(queryist, sqlCtx, "select statement from dolt_patch(?, ?) order by statement_order", p.Parent, p.Commit)
+		if err != nil {
+			return nil, err
+		}
+		p.RevertStatements, err = getPatchStatements(queryist, sqlCtx, "select statement from dolt_patch(?, ?) where diff_type = 'data' order by statement_order", p.Commit, p.Parent)
+		if err != nil {
+			return nil, err
+		}
+		patches = append(patches, p)
+	}
+
+	if len(patches) == 0 {
+		return nil, fmt.Errorf("no commits found in '%s'", revRange)
+	}
+	return patches, nil
+}
+
+func getPatchStatements(queryist cli.Queryist, sqlCtx *sql.Context, query string, from, to string) (string, error) {
+	rows, err := InterpolateAndRunQuery(queryist, sqlCtx, query, from, to)
+	if err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for _, row := range rows {
+		sb.WriteString(row[0].(string))
+		sb.WriteString("\n")
+	}
+	return sb.String(), nil
+}
+
+// patchFileName returns the name of the |n|th patch file of a series, for a commit with |message|.
+func patchFileName(n int, message string) string {
+	subject, _, _ := strings.Cut(message, "\n")
+
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(subject) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '.' {
+			sb.WriteRune(r)
+			dash = false
+		} else if !dash && sb.Len() > 0 {
+			sb.WriteRune('-')
+			dash = true
+		}
+		if sb.Len() >= maxPatchSlugLen {
+			break
+		}
+	}
+	slug := strings.Trim(sb.String(), "-.")
+	if slug == "" {
+		return fmt.Sprintf("%04d.patch", n)
+	}
+	return fmt.Sprintf("%04d-%s.patch", n, slug)
+}
+
+func writePatchFile(path string, p *commitPatch) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		cerr := f.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+	return writePatch(f, p)
+}
+
+// writePatch writes |p| to |wr|. A patch starts with a header of the commit's metadata, including the number of lines
+// of each section of statements, followed by the commit message indented by four spaces. After a "---" separator line
+// come the statements making the commit's changes, and the statements reverting them after a "-- revert" line.
+func writePatch(wr io.Writer, p *commitPatch) error {
+	statements, revertStatements := terminateLines(p.Statements), terminateLines(p.RevertStatements)
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("From %s\n", p.Commit))
+	sb.WriteString(fmt.Sprintf("Parent: %s\n", p.Parent))
+	sb.WriteString(fmt.Sprintf("Author: %s\n", p.Author))
+	sb.WriteString(fmt.Sprintf("Date: %s\n", p.Date.Format(patchDateFormat)))
+	sb.WriteString(fmt.Sprintf("%s: %d\n", patchStatementLines, strings.Count(statements, "\n")))
+	sb.WriteString(fmt.Sprintf("%s: %d\n", patchRevertLines, strings.Count(revertStatements, "\n")))
+	sb.WriteString("\n")
+	for _, line := range strings.Split(strings.TrimRight(p.Message, "\n"), "\n") {
+		sb.WriteString(strings.TrimRight(patchIndent+line, " ") + "\n")
+	}
+	sb.WriteString("\n" + patchSeparator + "\n")
+	sb.WriteString(statements)
+	sb.WriteString(patchRevertMarker + "\n")
+	sb.WriteString(revertStatements)
+
+	_, err := io.WriteString(wr, sb.String())
+	return err
+}
+
+// terminateLines returns |s| ending with a newline, unless it's empty.
+func terminateLines(s string) string {
+	if s != "" && !strings.HasSuffix(s, "\n") {
+		return s + "\n"
+	}
+	return s
+}
+
+// readPatchFile reads the patch file at |path|.
+func readPatchFile(path string) (*commitPatch, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	p, err := readPatch(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading patch %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// readPatch reads a patch written by writePatch from |rd|.
+func readPatch(rd io.Reader) (*commitPatch, error) {
+	scanner := bufio.NewScanner(rd)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024*1024)
+
+	p := &commitPatch{}
+	statementLines, revertLines := -1, -1
+	if !scanner.Scan() || !strings.HasPrefix(scanner.Text(), "From ") {
+		return nil, errors.New("not a patch file")
+	}
+	p.Commit = strings.TrimPrefix(scanner.Text(), "From ")
+
+	for scanner.Scan() && scanner.Text() != "" {
+		key, val, ok := strings.Cut(scanner.Text(), ": ")
+		if !ok {
+			return nil, fmt.Errorf("invalid patch header line: %s", scanner.Text())
+		}
+		switch key {
+		case "Parent":
+			p.Parent = val
+		case "Author":
+			p.Author = val
+		case "Date":
+			t, err := time.Parse(patchDateFormat, val)
+			if err != nil {
+				return nil, fmt.Errorf("invalid patch date: %s", val)
+			}
+			p.Date = t
+		case patchStatementLines, patchRevertLines:
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid patch header line: %s", scanner.Text())
+			}
+			if key == patchStatementLines {
+				statementLines = n
+			} else {
+				revertLines = n
+			}
+		}
+	}
+	if p.Author == "" || p.Date.IsZero() {
+		return nil, errors.New("patch is missing its author or date")
+	}
+	if statementLines < 0 || revertLines < 0 {
+		return nil, fmt.Errorf("patch is missing its %s or %s", patchStatementLines, patchRevertLines)
+	}
+
+	var message []string
+	separated := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == patchSeparator {
+			separated = true
+			break
+		}
+		message = append(message, strings.TrimPrefix(line, patchIndent))
+	}
+	if !separated {
+		return nil, errors.New("patch has no statements")
+	}
+	p.Message = strings.TrimSpace(strings.Join(message, "\n"))
+
+	readLines := func(n int) (string, error) {
+		var sb strings.Builder
+		for i := 0; i < n; i++ {
+			if !scanner.Scan() {
+				if err := scanner.Err(); err != nil {
+					return "", err
+				}
+				return "", errors.New("patch is truncated")
+			}
+			sb.WriteString(scanner.Text() + "\n")
+		}
+		return sb.String(), nil
+	}
+
+	var err error
+	if p.Statements, err = readLines(statementLines); err != nil {
+		return nil, err
+	}
+	if !scanner.Scan() || scanner.Text() != patchRevertMarker {
+		if err = scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("patch has no '%s' line after its %d statement lines", patchRevertMarker, statementLines)
+	}
+	if p.RevertStatements, err = readLines(revertLines); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
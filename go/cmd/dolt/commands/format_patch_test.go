@@ -0,0 +1,78 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPatchRoundTrip(t *testing.T) {
+	p := &commitPatch{
+		Commit:  "u8s83gapv7ghnbmrtpm8q5es0dbl7lpd",
+		Parent:  "13hfhkqd4rs4fn0h2ukla6tshdhlahjd",
+		Author:  "Jane Doe <jane@example.com>",
+		Date:    time.Date(2025, 3, 4, 5, 6, 7, 123000000, time.UTC),
+		Message: "add rows\n\nThe rows were missing.\n  indented line\n---\nnot a separator",
+		Statements: "CREATE TABLE `t` (\n  `pk` int NOT NULL,\n  PRIMARY KEY (`pk`)\n);\n" +
+			"CREATE TRIGGER `trg` BEFORE INSERT ON `t` FOR EACH ROW BEGIN\n-- revert\nSET new.pk = new.pk;\nEND;\n" +
+			"INSERT INTO `t` (`pk`) VALUES (1);\n",
+		RevertStatements: "DELETE FROM `t` WHERE `pk` = 1;\n",
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writePatch(&buf, p))
+	assert.True(t, strings.HasPrefix(buf.String(), "From u8s83gapv7ghnbmrtpm8q5es0dbl7lpd\n"))
+	assert.Contains(t, buf.String(), "Date: 2025-03-04T05:06:07.123Z\nStatement-Lines: 9\nRevert-Lines: 1\n")
+
+	read, err := readPatch(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, p, read)
+}
+
+func TestReadPatchErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		patch string
+	}{
+		{"empty", ""},
+		{"not a patch", "INSERT INTO t VALUES (1);\n"},
+		{"missing author", "From abc\nDate: 2025-03-04T05:06:07Z\n\n    msg\n\n---\n"},
+		{"bad date", "From abc\nAuthor: a <a@b.c>\nDate: yesterday\n\n    msg\n\n---\n"},
+		{"missing line counts", "From abc\nAuthor: a <a@b.c>\nDate: 2025-03-04T05:06:07Z\n\n    msg\n\n---\n-- revert\n"},
+		{"no statements", "From abc\nAuthor: a <a@b.c>\nDate: 2025-03-04T05:06:07Z\nStatement-Lines: 0\nRevert-Lines: 0\n\n    msg\n"},
+		{"truncated", "From abc\nAuthor: a <a@b.c>\nDate: 2025-03-04T05:06:07Z\nStatement-Lines: 2\nRevert-Lines: 0\n\n    msg\n\n---\nSELECT 1;\n"},
+		{"no revert marker", "From abc\nAuthor: a <a@b.c>\nDate: 2025-03-04T05:06:07Z\nStatement-Lines: 1\nRevert-Lines: 0\n\n    msg\n\n---\nSELECT 1;\nSELECT 2;\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := readPatch(strings.NewReader(test.patch))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPatchFileName(t *testing.T) {
+	assert.Equal(t, "0001-add-users-table.patch", patchFileName(1, "Add users table\n\nWith an index."))
+	assert.Equal(t, "0012-fix-v1.2-prices.patch", patchFileName(12, "Fix v1.2 prices!"))
+	assert.Equal(t, "0003.patch", patchFileName(3, "***"))
+	assert.Equal(t, "0004-"+strings.Repeat("a", maxPatchSlugLen)+".patch", patchFileName(4, strings.Repeat("a", 100)))
+}
@@ -16,6 +16,10 @@ package commands
 
 import (
 	"context"
+	"strings"
+
+	"github.com/gocraft/dbr/v2"
+	"github.com/gocraft/dbr/v2/dialect"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
@@ -41,9 +45,14 @@ copied during a prior garbage collection.
 
 If the {{.EmphasisLeft}}--shallow{{.EmphasisRight}} flag is supplied, a faster but less thorough garbage collection will be performed.
 
-If the {{.EmphasisLeft}}--full{{.EmphasisRight}} flag is supplied, a more thorough garbage collection, fully collecting the old gen and new gen, will be performed.`,
+If the {{.EmphasisLeft}}--full{{.EmphasisRight}} flag is supplied, a more thorough garbage collection, fully collecting the old gen and new gen, will be performed.
+
+If {{.EmphasisLeft}}--prune-history-before{{.EmphasisRight}} is supplied with a date or a commit, the history of the database before it is pruned, and a full garbage collection is performed to reclaim the storage it used. Commits made before the date, or the ancestors of the commit, are removed along with all of their ancestors, and are recorded as ghost commits like the commits missing from a shallow clone. Commits which are pointed to by a branch, tag, remote tracking branch, stash or in-progress merge, rebase or cherry-pick are always kept. The remaining commits keep their hashes, so they can still be pushed to and pulled from remotes with the full history. Since the remaining commits still reference the pruned ones, the database can't be pushed to a remote which doesn't have the pruned history, and can only be cloned with {{.EmphasisLeft}}--depth{{.EmphasisRight}}.
+
+If the {{.EmphasisLeft}}gc.history_retention_days{{.EmphasisRight}} config value is set, every full garbage collection, including those run automatically by sql-server, prunes the history older than that number of days.`,
 	Synopsis: []string{
 		"[--shallow|--full]",
+		"--prune-history-before {{.LessThan}}date|commit{{.GreaterThan}}",
 	},
 }
 
@@ -94,6 +103,9 @@ func (cmd GarbageCollectionCmd) Exec(ctx context.Context, commandStr string, arg
 	if apr.Contains(cli.ShallowFlag) && apr.Contains(cli.FullFlag) {
 		return HandleVErrAndExitCode(errhand.BuildDError("Invalid Argument: --shallow is not compatible with --full").SetPrintUsage().Build(), usage)
 	}
+	if apr.Contains(cli.ShallowFlag) && apr.Contains(cli.PruneHistoryParam) {
+		return HandleVErrAndExitCode(errhand.BuildDError("Invalid Argument: --shallow is not compatible with --%s", cli.PruneHistoryParam).SetPrintUsage().Build(), usage)
+	}
 
 	queryist, sqlCtx, closeFunc, err := cliCtx.QueryEngine(ctx)
 	if err != nil {
@@ -118,14 +130,23 @@ func (cmd GarbageCollectionCmd) Exec(ctx context.Context, commandStr string, arg
 
 // constructDoltGCQuery generates the sql query necessary to call DOLT_GC()
 func constructDoltGCQuery(apr *argparser.ArgParseResults) (string, error) {
-	query := "call DOLT_GC("
+	var args []string
+	var params []interface{}
 	if apr.Contains(cli.ShallowFlag) {
-		query += "'--shallow'"
+		args = append(args, "'--shallow'")
 	}
 	if apr.Contains(cli.FullFlag) {
-		query += "'--full'"
+		args = append(args, "'--full'")
+	}
+	if before, ok := apr.GetValue(cli.PruneHistoryParam); ok {
+		args = append(args, "'--"+cli.PruneHistoryParam+"'", "?")
+		params = append(params, before)
+	}
+
+	query := "call DOLT_GC(" + strings.Join(args, ", ") + ")"
+	if len(params) > 0 {
+		return dbr.InterpolateForDialect(query, params, dialect.MySQL)
 	}
-	query += ")"
 	return query, nil
 }
 
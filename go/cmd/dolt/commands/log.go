@@ -57,7 +57,16 @@ The command takes options to control what is shown and how.
 	
 {{.EmphasisLeft}}dolt log <revisionB>...<revisionA>{{.EmphasisRight}}
 {{.EmphasisLeft}}dolt log <revisionA> <revisionB> --not $(dolt merge-base <revisionA> <revisionB>){{.EmphasisRight}}
-  Different ways to list three dot logs. These will list commit logs reachable by revisionA OR revisionB, while excluding commits reachable by BOTH revisionA AND revisionB.`,
+  Different ways to list three dot logs. These will list commit logs reachable by revisionA OR revisionB, while excluding commits reachable by BOTH revisionA AND revisionB.
+
+{{.EmphasisLeft}}dolt log --author <pattern> --grep <pattern> --since <date> --until <date>{{.EmphasisRight}}
+  Lists only commits whose author and message match the regular expressions given, and which were committed within the dates given.
+
+{{.EmphasisLeft}}dolt log --follow --tables <table>{{.EmphasisRight}}
+  Lists commits with changes to table, continuing with the history of the table under its previous names when it was renamed.
+
+{{.EmphasisLeft}}dolt log --tables <table> --where <filter>{{.EmphasisRight}}
+  Lists commits that added, removed or modified rows of table matching the filter, e.g. {{.EmphasisLeft}}--where "id = 42"{{.EmphasisRight}}. Conditions on primary key columns limit the rows compared between commits to the matching key ranges.`,
 	Synopsis: []string{
 		`[-n {{.LessThan}}num_commits{{.GreaterThan}}] [{{.LessThan}}revision-range{{.GreaterThan}}] [[--] {{.LessThan}}table{{.GreaterThan}}]`,
 	},
@@ -144,25 +153,19 @@ func constructInterpolatedDoltLogQuery(apr *argparser.ArgParseResults, queryist
 		first = false
 	}
 
+	var tableNames []string
 	if apr.PositionalArgsSeparatorIndex >= 0 {
 		for i := 0; i < apr.PositionalArgsSeparatorIndex; i++ {
 			writeToBuffer("?")
 			params = append(params, apr.Arg(i))
 		}
-		var tableNames []string
 		for i := apr.PositionalArgsSeparatorIndex; i < apr.NArg(); i++ {
 			tableNames = append(tableNames, apr.Arg(i))
 		}
-		if len(tableNames) > 0 {
-			params = append(params, strings.Join(tableNames, ","))
-			writeToBuffer("'--tables'")
-			writeToBuffer("?")
-		}
 	} else {
 		var existingTables map[string]bool
 		seenRevs := make(map[string]bool, apr.NArg())
 		finishedRevs := false
-		var tableNames []string
 		for i, arg := range apr.Args {
 			// once we encounter a rev we can't resolve, we assume the rest are table names
 			if finishedRevs {
@@ -209,11 +212,15 @@ func constructInterpolatedDoltLogQuery(apr *argparser.ArgParseResults, queryist
 			}
 
 		}
-		if len(tableNames) > 0 {
-			params = append(params, strings.Join(tableNames, ","))
-			writeToBuffer("'--tables'")
-			writeToBuffer("?")
-		}
+	}
+
+	if tables, hasTables := apr.GetValueList(cli.TablesFlag); hasTables {
+		tableNames = append(tableNames, tables...)
+	}
+	if len(tableNames) > 0 {
+		params = append(params, strings.Join(tableNames, ","))
+		writeToBuffer("'--tables'")
+		writeToBuffer("?")
 	}
 
 	if minParents, hasMinParents := apr.GetValue(cli.MinParentsFlag); hasMinParents {
@@ -233,6 +240,40 @@ func constructInterpolatedDoltLogQuery(apr *argparser.ArgParseResults, queryist
 		}
 	}
 
+	if author, hasAuthor := apr.GetValue(cli.AuthorParam); hasAuthor {
+		writeToBuffer("'--author'")
+		writeToBuffer("?")
+		params = append(params, author)
+	}
+
+	if grep, hasGrep := apr.GetValue(cli.GrepParam); hasGrep {
+		writeToBuffer("'--grep'")
+		writeToBuffer("?")
+		params = append(params, grep)
+	}
+
+	if since, hasSince := apr.GetValue(cli.SinceParam); hasSince {
+		writeToBuffer("'--since'")
+		writeToBuffer("?")
+		params = append(params, since)
+	}
+
+	if until, hasUntil := apr.GetValue(cli.UntilParam); hasUntil {
+		writeToBuffer("'--until'")
+		writeToBuffer("?")
+		params = append(params, until)
+	}
+
+	if apr.Contains(cli.FollowFlag) {
+		writeToBuffer("'--follow'")
+	}
+
+	if where, hasWhere := apr.GetValue(cli.WhereParam); hasWhere {
+		writeToBuffer("'--where'")
+		writeToBuffer("?")
+		params = append(params, where)
+	}
+
 	// included to check for invalid --decorate options
 	if decorate, hasDecorate := apr.GetValue(cli.DecorateFlag); hasDecorate {
 		writeToBuffer("?")
@@ -40,13 +40,16 @@ import (
 var rebaseDocs = cli.CommandDocumentationContent{
 	ShortDesc: "Reapplies commits on top of another base tip",
 	LongDesc: `Rewrites commit history for the current branch by replaying commits, allowing the commits to be reordered, 
-squashed, or dropped. The commits included in the rebase plan are the commits reachable by the current branch, but NOT 
+squashed, dropped, or edited. The commits included in the rebase plan are the commits reachable by the current branch, but NOT 
 reachable from the branch specified as the argument when starting a rebase (also known as the upstream branch). This is 
 the same as Git and Dolt's "two dot log" syntax, or |upstreamBranch|..|currentBranch|.
 
 Rebasing is useful to clean and organize your commit history, especially before merging a feature branch back to a shared 
 branch. For example, you can drop commits that contain debugging or test changes, or squash or fixup small commits into a 
-single commit, or reorder commits so that related changes are adjacent in the new commit history.
+single commit, or reorder commits so that related changes are adjacent in the new commit history. An edit step stops 
+the rebase after applying its commit, and a break step stops the rebase at that point in the plan, so that data can be 
+changed or new commits made before the rebase is continued. Changes that are staged when continuing from an edit step 
+are amended into the commit for that step.
 `,
 	Synopsis: []string{
 		`(-i | --interactive) [--empty=drop|keep] {{.LessThan}}upstream{{.GreaterThan}}`,
@@ -125,11 +128,12 @@ func (cmd RebaseCmd) Exec(ctx context.Context, commandStr string, args []string,
 		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(errors.New("error: "+rows[0][1].(string))), usage)
 	}
 
-	// If the rebase was successful, or if it was aborted, print out the message and
-	// ensure the branch being rebased is checked out in the CLI
+	// If the rebase was successful, if it was aborted, or if it stopped at an edit or break step, print out the
+	// message and ensure the branch the session ended up on is checked out in the CLI
 	message := rows[0][1].(string)
 	if strings.Contains(message, dprocedures.SuccessfulRebaseMessage) ||
-		strings.Contains(message, dprocedures.RebaseAbortedMessage) {
+		strings.Contains(message, dprocedures.RebaseAbortedMessage) ||
+		strings.Contains(message, dprocedures.RebaseStoppedMessage) {
 		cli.Println(message)
 		if err = syncCliBranchToSqlSessionBranch(sqlCtx, dEnv); err != nil {
 			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
@@ -201,7 +205,16 @@ func (cmd RebaseCmd) Exec(ctx context.Context, commandStr string, args []string,
 		return HandleVErrAndExitCode(errhand.VerboseErrorFromError(errors.New("error: "+rows[0][1].(string))), usage)
 	}
 
-	cli.Println(rows[0][1].(string))
+	// If the rebase stopped at an edit or break step, the rebase working branch needs to be checked out in the CLI
+	// so that changes can be made before continuing the rebase
+	message = rows[0][1].(string)
+	if strings.Contains(message, dprocedures.RebaseStoppedMessage) {
+		if err = syncCliBranchToSqlSessionBranch(sqlCtx, dEnv); err != nil {
+			return HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+		}
+	}
+
+	cli.Println(message)
 	return 0
 }
 
@@ -277,8 +290,10 @@ func buildInitialRebaseMsg(sqlCtx *sql.Context, queryist cli.Queryist, rebaseBra
 	buffer.WriteString("# p, pick <commit> = use commit\n")
 	buffer.WriteString("# d, drop <commit> = remove commit\n")
 	buffer.WriteString("# r, reword <commit> = use commit, but edit the commit message\n")
+	buffer.WriteString("# e, edit <commit> = use commit, but stop for amending\n")
 	buffer.WriteString("# s, squash <commit> = use commit, but meld into previous commit\n")
 	buffer.WriteString("# f, fixup <commit> = like \"squash\", but discard this commit's message\n")
+	buffer.WriteString("# b, break = stop here (continue rebase later with 'dolt rebase --continue')\n")
 	buffer.WriteString("# These lines can be re-ordered; they are executed from top to bottom.\n")
 	buffer.WriteString("#\n")
 	buffer.WriteString("# If you remove a line here THAT COMMIT WILL BE LOST.\n")
@@ -307,6 +322,14 @@ func parseRebaseMessage(rebaseMsg string) (*rebase.RebasePlan, error) {
 	splitMsg := strings.Split(rebaseMsg, "\n")
 	for i, line := range splitMsg {
 		if !strings.HasPrefix(line, "#") && strings.TrimSpace(line) != "" {
+			// break steps don't reference a commit
+			if strings.TrimSpace(line) == rebase.RebaseActionBreak {
+				plan.Steps = append(plan.Steps, rebase.RebasePlanStep{
+					Action: rebase.RebaseActionBreak,
+				})
+				continue
+			}
+
 			rebaseStepParts := strings.SplitN(line, " ", 3)
 			if len(rebaseStepParts) != 3 {
 				return nil, fmt.Errorf("invalid line %d: %s", i, line)
@@ -37,9 +37,12 @@ var revertDocs = cli.CommandDocumentationContent{
 		"{{.EmphasisLeft}}HEAD~1..HEAD~2{{.EmphasisRight}}, giving us a patch of what to remove to effectively remove the " +
 		"influence of the specified commit. If multiple commits are specified, then this process is repeated for each " +
 		"commit in the order specified. This requires a clean working set." +
+		"\n\nTo revert a merge commit, use {{.EmphasisLeft}}-m{{.EmphasisRight}} to specify the parent that the changes are " +
+		"reverted relative to. Without {{.EmphasisLeft}}-m{{.EmphasisRight}}, merge commits are reverted relative to " +
+		"their first parent." +
 		"\n\nAny conflicts or constraint violations caused by the merge cause the command to fail.",
 	Synopsis: []string{
-		"<revision>...",
+		"[-m {{.LessThan}}parent-number{{.GreaterThan}}] <revision>...",
 	},
 }
 
@@ -112,6 +115,10 @@ func (cmd RevertCmd) Exec(ctx context.Context, commandStr string, args []string,
 
 	var buffer bytes.Buffer
 	buffer.WriteString("CALL DOLT_REVERT('--author', ?")
+	if mainline, ok := apr.GetValue(cli.MainlineParam); ok {
+		buffer.WriteString(", '--mainline', ?")
+		params = append(params, mainline)
+	}
 	// Loop over args and add them to the query
 	for _, input := range apr.Args {
 		buffer.WriteString(", ?")
@@ -0,0 +1,244 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/cluster"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dprocedures"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+)
+
+// autoGCService is a service that periodically checks the size of the chunk journal and the old generation of each
+// database served by this server, and runs garbage collection on the databases which have grown past the thresholds
+// in its config. Garbage collection is run the same way as the dolt_gc() stored procedure. Default and full GCs kill
+// every connection to the server when they complete, so they are only run when the config enables kill_connections.
+// Otherwise, a shallow GC is run when the journal grows past its threshold, which does not interrupt any connections.
+type autoGCService struct {
+	config   servercfg.AutoGCConfig
+	newCtx   func(context.Context) (*sql.Context, error)
+	statuses *dtables.GCStatusTracker
+	lgr      *logrus.Logger
+
+	// journalBaselines are the sizes of the chunk journal of each database after its last GC. A shallow GC does not
+	// shrink the journal, so the journal is collected again once it grows by the threshold. They are only accessed
+	// from Run.
+	journalBaselines map[string]uint64
+	// oldGenBaselines are the sizes of the old generation of each database after its last full GC, or when it was
+	// first checked. They are only accessed from Run.
+	oldGenBaselines map[string]uint64
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func newAutoGCService(config servercfg.AutoGCConfig, newCtx func(context.Context) (*sql.Context, error), statuses *dtables.GCStatusTracker, lgr *logrus.Logger) *autoGCService {
+	return &autoGCService{
+		config:           config,
+		newCtx:           newCtx,
+		statuses:         statuses,
+		lgr:              lgr,
+		journalBaselines: make(map[string]uint64),
+		oldGenBaselines:  make(map[string]uint64),
+		stopCh:           make(chan struct{}),
+	}
+}
+
+func (s *autoGCService) Init(context.Context) error { return nil }
+
+func (s *autoGCService) Stop() error {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	return nil
+}
+
+func (s *autoGCService) Run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-s.stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	ticker := time.NewTicker(s.config.CheckInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.check(ctx)
+		}
+	}
+}
+
+// check measures every database and runs garbage collection on the ones which need it, one at a time.
+func (s *autoGCService) check(ctx context.Context) {
+	// Like dolt_gc(), only run garbage collection on the primary of a cluster.
+	if _, role, ok := sql.SystemVariables.GetGlobal(dsess.DoltClusterRoleVariable); ok && role.(string) != string(cluster.RolePrimary) {
+		return
+	}
+
+	sqlCtx, err := s.newCtx(ctx)
+	if err != nil {
+		s.lgr.Errorf("auto gc: error creating SQL context: %v", err)
+		return
+	}
+
+	seen := make(map[string]struct{})
+	for _, db := range dsess.DSessFromSess(sqlCtx.Session).Provider().AllDatabases(sqlCtx) {
+		sqlDb, ok := db.(dsess.SqlDatabase)
+		if !ok {
+			continue
+		}
+		name := sqlDb.Name()
+		key := strings.ToLower(name)
+		seen[key] = struct{}{}
+
+		ddb := sqlDb.DbData().Ddb
+		journalSize, oldGenSize, err := ddb.StoreSizes(ctx)
+		if err != nil {
+			s.lgr.Errorf("auto gc: error reading the size of database %s: %v", name, err)
+			continue
+		}
+		baseline, ok := s.oldGenBaselines[key]
+		if !ok {
+			baseline = oldGenSize
+			s.oldGenBaselines[key] = baseline
+		}
+
+		mode := autoGCMode(s.config, journalSize, s.journalBaselines[key], oldGenSize, baseline)
+		s.statuses.Update(name, func(status *dtables.GCStatus) {
+			status.JournalSize = journalSize
+			status.OldGenSize = oldGenSize
+			status.LastCheckedAt = time.Now()
+			status.Running = mode
+		})
+		if mode != "" {
+			s.runGC(ctx, name, ddb, mode)
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+	}
+
+	for key := range s.oldGenBaselines {
+		if _, ok := seen[key]; !ok {
+			delete(s.oldGenBaselines, key)
+			delete(s.journalBaselines, key)
+			s.statuses.Remove(key)
+		}
+	}
+}
+
+// runGC runs a garbage collection of |mode| on the database |dbName|, stored in |ddb|, and records its outcome.
+func (s *autoGCService) runGC(ctx context.Context, dbName string, ddb *doltdb.DoltDB, mode string) {
+	s.lgr.Infof("auto gc: running %s gc on database %s", mode, dbName)
+	start := time.Now()
+	err := s.doGC(ctx, dbName, mode)
+	if err != nil {
+		s.lgr.Errorf("auto gc: error running %s gc on database %s: %v", mode, dbName, err)
+	} else {
+		s.lgr.Infof("auto gc: finished %s gc on database %s in %s", mode, dbName, time.Since(start).Round(time.Millisecond))
+	}
+
+	journalSize, oldGenSize, sizeErr := ddb.StoreSizes(ctx)
+	if err == nil && sizeErr == nil {
+		s.journalBaselines[strings.ToLower(dbName)] = journalSize
+		if mode == dtables.GCModeFull {
+			s.oldGenBaselines[strings.ToLower(dbName)] = oldGenSize
+		}
+	}
+
+	s.statuses.Update(dbName, func(status *dtables.GCStatus) {
+		status.Running = ""
+		status.LastMode = mode
+		status.LastStartedAt = start
+		status.LastCompletedAt = time.Now()
+		if err != nil {
+			status.LastError = err.Error()
+			status.Failures++
+		} else {
+			status.LastError = ""
+			status.Runs++
+		}
+		if sizeErr == nil {
+			status.JournalSize = journalSize
+			status.OldGenSize = oldGenSize
+		}
+	})
+}
+
+func (s *autoGCService) doGC(ctx context.Context, dbName string, mode string) error {
+	// A new context is used for each GC, since the session of the context is invalidated when the GC completes.
+	sqlCtx, err := s.newCtx(ctx)
+	if err != nil {
+		return err
+	}
+	sqlCtx.SetCurrentDatabase(dbName)
+
+	var args []string
+	switch mode {
+	case dtables.GCModeShallow:
+		args = append(args, "--"+cli.ShallowFlag)
+	case dtables.GCModeFull:
+		args = append(args, "--"+cli.FullFlag)
+	}
+	return dprocedures.RunDoltGC(sqlCtx, args...)
+}
+
+// autoGCMode returns the mode of garbage collection to run on a database whose chunk journal and old generation
+// have the given sizes, or the empty string if it does not need one. |journalBaseline| is the size of the journal
+// after the database's last GC, and |oldGenBaseline| is the size of the old generation after its last full GC. A full
+// GC is preferred when both are needed, since it also collects the new generation. Unless the config allows killing
+// connections, only shallow GCs are run.
+func autoGCMode(config servercfg.AutoGCConfig, journalSize, journalBaseline, oldGenSize, oldGenBaseline uint64) string {
+	if !config.KillConnections() {
+		if grewBy(journalSize, journalBaseline, config.JournalSizeThreshold()) {
+			return dtables.GCModeShallow
+		}
+		return ""
+	}
+	if grewBy(oldGenSize, oldGenBaseline, config.OldGenGrowthThreshold()) {
+		return dtables.GCModeFull
+	}
+	if grewBy(journalSize, journalBaseline, config.JournalSizeThreshold()) {
+		return dtables.GCModeDefault
+	}
+	return ""
+}
+
+// grewBy returns whether |size| has grown by at least |threshold| bytes since it was |baseline|. A threshold of 0
+// never triggers.
+func grewBy(size, baseline, threshold uint64) bool {
+	return threshold > 0 && size > baseline && size-baseline >= threshold
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+)
+
+func TestAutoGCMode(t *testing.T) {
+	const mb = 1024 * 1024
+	journalThreshold := uint64(64)
+	oldGenGrowth := uint64(1024)
+	noOldGenGrowth := uint64(0)
+	killConnections := true
+
+	config := &servercfg.AutoGCYAMLConfig{
+		JournalSizeThresholdMB_:  &journalThreshold,
+		OldGenGrowthThresholdMB_: &oldGenGrowth,
+		KillConnections_:         &killConnections,
+	}
+	journalOnly := &servercfg.AutoGCYAMLConfig{
+		JournalSizeThresholdMB_:  &journalThreshold,
+		OldGenGrowthThresholdMB_: &noOldGenGrowth,
+		KillConnections_:         &killConnections,
+	}
+	shallowOnly := &servercfg.AutoGCYAMLConfig{
+		JournalSizeThresholdMB_:  &journalThreshold,
+		OldGenGrowthThresholdMB_: &noOldGenGrowth,
+	}
+
+	tests := []struct {
+		name            string
+		config          servercfg.AutoGCConfig
+		journalSize     uint64
+		journalBaseline uint64
+		oldGenSize      uint64
+		oldGenBaseline  uint64
+		expected        string
+	}{
+		{"below thresholds", config, 10 * mb, 0, 500 * mb, 0, ""},
+		{"journal over threshold", config, 64 * mb, 0, 500 * mb, 0, dtables.GCModeDefault},
+		{"journal large but not grown", config, 100 * mb, 80 * mb, 500 * mb, 0, ""},
+		{"oldgen grew past threshold", config, 10 * mb, 0, 2048 * mb, 1024 * mb, dtables.GCModeFull},
+		{"oldgen large but not grown", config, 10 * mb, 0, 4096 * mb, 4000 * mb, ""},
+		{"full gc preferred", config, 100 * mb, 0, 2048 * mb, 0, dtables.GCModeFull},
+		{"oldgen shrank", config, 10 * mb, 0, 100 * mb, 2048 * mb, ""},
+		{"full gc disabled", journalOnly, 10 * mb, 0, 8192 * mb, 0, ""},
+		{"shallow gc without killing connections", shallowOnly, 64 * mb, 0, 8192 * mb, 0, dtables.GCModeShallow},
+		{"shallow gc after journal grows again", shallowOnly, 100 * mb, 64 * mb, 500 * mb, 0, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, autoGCMode(test.config, test.journalSize, test.journalBaseline, test.oldGenSize, test.oldGenBaseline))
+		})
+	}
+}
@@ -309,6 +309,14 @@ func (cfg *commandLineServerConfig) ClusterConfig() servercfg.ClusterConfig {
 	return nil
 }
 
+func (cfg *commandLineServerConfig) AutoGCConfig() servercfg.AutoGCConfig {
+	return nil
+}
+
+func (cfg *commandLineServerConfig) CDCConfig() servercfg.CDCConfig {
+	return nil
+}
+
 // PrivilegeFilePath returns the path to the file which contains all needed privilege information in the form of a
 // JSON string.
 func (cfg *commandLineServerConfig) PrivilegeFilePath() string {
@@ -333,6 +341,10 @@ func (cfg *commandLineServerConfig) JwksConfig() []servercfg.JwksConfig {
 	return nil
 }
 
+func (cfg *commandLineServerConfig) Webhooks() []servercfg.WebhookConfig {
+	return nil
+}
+
 func (cfg *commandLineServerConfig) AllowCleartextPasswords() bool {
 	return cfg.allowCleartextPasswords
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/cluster"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/clusterdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
 	"github.com/dolthub/dolt/go/libraries/utils/version"
 )
 
@@ -51,14 +52,25 @@ type metricsListener struct {
 	isReplicaGauges      *prometheus.GaugeVec
 	replicationLagGauges *prometheus.GaugeVec
 
+	// automatic gc metrics
+	gcJournalSizeGauges *prometheus.GaugeVec
+	gcOldGenSizeGauges  *prometheus.GaugeVec
+	gcRunningGauges     *prometheus.GaugeVec
+	gcRunsCounters      *prometheus.CounterVec
+	gcFailuresCounters  *prometheus.CounterVec
+
 	// used in updating cluster metrics
 	clusterStatus  clusterdb.ClusterStatusProvider
 	mu             *sync.Mutex
 	done           bool
 	clusterSeenDbs map[string]struct{}
+
+	// used in updating automatic gc metrics
+	gcStatuses *dtables.GCStatusTracker
+	gcSeen     map[string]dtables.GCStatus
 }
 
-func newMetricsListener(labels prometheus.Labels, versionStr string, clusterStatus clusterdb.ClusterStatusProvider) (*metricsListener, error) {
+func newMetricsListener(labels prometheus.Labels, versionStr string, clusterStatus clusterdb.ClusterStatusProvider, gcStatuses *dtables.GCStatusTracker) (*metricsListener, error) {
 	ml := &metricsListener{
 		labels: labels,
 		cntConnections: prometheus.NewCounter(prometheus.CounterOpts{
@@ -102,9 +114,36 @@ func newMetricsListener(labels prometheus.Labels, versionStr string, clusterStat
 			Help:        "one if the server is currently in this role, zero otherwise",
 			ConstLabels: labels,
 		}, []string{dbLabel}),
+		gcJournalSizeGauges: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "dss_gc_journal_size",
+			Help:        "The size in bytes of the chunk journal of the database when it was last checked by automatic gc",
+			ConstLabels: labels,
+		}, []string{dbLabel}),
+		gcOldGenSizeGauges: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "dss_gc_oldgen_size",
+			Help:        "The size in bytes of the old generation of the database when it was last checked by automatic gc",
+			ConstLabels: labels,
+		}, []string{dbLabel}),
+		gcRunningGauges: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name:        "dss_gc_running",
+			Help:        "one if automatic gc is currently running on the database, zero otherwise",
+			ConstLabels: labels,
+		}, []string{dbLabel}),
+		gcRunsCounters: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "dss_gc_runs",
+			Help:        "Count of successful automatic gc runs on the database",
+			ConstLabels: labels,
+		}, []string{dbLabel}),
+		gcFailuresCounters: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "dss_gc_failures",
+			Help:        "Count of failed automatic gc runs on the database",
+			ConstLabels: labels,
+		}, []string{dbLabel}),
 		clusterStatus:  clusterStatus,
 		mu:             &sync.Mutex{},
 		clusterSeenDbs: make(map[string]struct{}),
+		gcStatuses:     gcStatuses,
+		gcSeen:         make(map[string]dtables.GCStatus),
 	}
 
 	u32Version, err := version.Encode(versionStr)
@@ -126,9 +165,14 @@ func newMetricsListener(labels prometheus.Labels, versionStr string, clusterStat
 	prometheus.MustRegister(ml.histQueryDur)
 	prometheus.MustRegister(ml.replicationLagGauges)
 	prometheus.MustRegister(ml.isReplicaGauges)
+	prometheus.MustRegister(ml.gcJournalSizeGauges)
+	prometheus.MustRegister(ml.gcOldGenSizeGauges)
+	prometheus.MustRegister(ml.gcRunningGauges)
+	prometheus.MustRegister(ml.gcRunsCounters)
+	prometheus.MustRegister(ml.gcFailuresCounters)
 
 	go func() {
-		for ml.updateReplMetrics() {
+		for ml.updateReplMetrics() && ml.updateGCMetrics() {
 			time.Sleep(clusterUpdateInterval)
 		}
 	}()
@@ -181,6 +225,56 @@ func (ml *metricsListener) updateReplMetrics() bool {
 	return true
 }
 
+func (ml *metricsListener) updateGCMetrics() bool {
+	ml.mu.Lock()
+	defer ml.mu.Unlock()
+
+	if ml.done {
+		return false
+	}
+
+	if ml.gcStatuses == nil {
+		return true
+	}
+
+	seen := make(map[string]dtables.GCStatus)
+	for _, status := range ml.gcStatuses.List() {
+		db := status.Database
+		seen[db] = status
+
+		ml.gcJournalSizeGauges.WithLabelValues(db).Set(float64(status.JournalSize))
+		ml.gcOldGenSizeGauges.WithLabelValues(db).Set(float64(status.OldGenSize))
+		if status.Running != "" {
+			ml.gcRunningGauges.WithLabelValues(db).Set(1.0)
+		} else {
+			ml.gcRunningGauges.WithLabelValues(db).Set(0.0)
+		}
+
+		// the counters are advanced by the runs since the status was last seen
+		prev := ml.gcSeen[db]
+		if status.Runs > prev.Runs {
+			ml.gcRunsCounters.WithLabelValues(db).Add(float64(status.Runs - prev.Runs))
+		}
+		if status.Failures > prev.Failures {
+			ml.gcFailuresCounters.WithLabelValues(db).Add(float64(status.Failures - prev.Failures))
+		}
+	}
+
+	// deregister metrics for deleted databases
+	for db := range ml.gcSeen {
+		if _, ok := seen[db]; !ok {
+			ml.gcJournalSizeGauges.DeletePartialMatch(prometheus.Labels{dbLabel: db})
+			ml.gcOldGenSizeGauges.DeletePartialMatch(prometheus.Labels{dbLabel: db})
+			ml.gcRunningGauges.DeletePartialMatch(prometheus.Labels{dbLabel: db})
+			ml.gcRunsCounters.DeletePartialMatch(prometheus.Labels{dbLabel: db})
+			ml.gcFailuresCounters.DeletePartialMatch(prometheus.Labels{dbLabel: db})
+		}
+	}
+	ml.gcSeen = seen
+
+	return true
+}
+
 func (ml *metricsListener) ClientConnected() {
 	ml.gaugeConcurrentConn.Add(1.0)
 	ml.cntConnections.Add(1.0)
@@ -208,7 +302,13 @@ func (ml *metricsListener) Close() {
 	prometheus.Unregister(ml.gaugeConcurrentQueries)
 	prometheus.Unregister(ml.histQueryDur)
 
+	// the gc metrics are no longer updated once the replication metrics are closed
 	ml.closeReplicationMetrics()
+	prometheus.Unregister(ml.gcJournalSizeGauges)
+	prometheus.Unregister(ml.gcOldGenSizeGauges)
+	prometheus.Unregister(ml.gcRunningGauges)
+	prometheus.Unregister(ml.gcRunsCounters)
+	prometheus.Unregister(ml.gcFailuresCounters)
 }
 
 func (ml *metricsListener) closeReplicationMetrics() {
@@ -45,9 +45,13 @@ import (
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/engine"
 	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
 	remotesapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/remotesapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/cdc"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/dolt_ci"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/remotesrv"
 	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
@@ -55,11 +59,14 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/cluster"
 	_ "github.com/dolthub/dolt/go/libraries/doltcore/sqle/dfunctions"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
+	"github.com/dolthub/dolt/go/libraries/doltcore/webhooks"
 	"github.com/dolthub/dolt/go/libraries/events"
 	"github.com/dolthub/dolt/go/libraries/utils/config"
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
 	"github.com/dolthub/dolt/go/libraries/utils/svcs"
+	"github.com/dolthub/dolt/go/store/hash"
 )
 
 const (
@@ -303,7 +310,7 @@ func ConfigureServices(
 	controller.Register(PersistNondeterministicSystemVarDefaults)
 
 	InitBinlogging := &svcs.AnonService{
-		InitF: func(context.Context) error {
+		InitF: func(ctx context.Context) error {
 			primaryController := sqlEngine.GetUnderlyingEngine().Analyzer.Catalog.BinlogPrimaryController
 			doltBinlogPrimaryController, ok := primaryController.(*binlogreplication.DoltBinlogPrimaryController)
 			if !ok {
@@ -342,7 +349,7 @@ func ConfigureServices(
 			}
 
 			if logBin == 1 {
-				logrus.Infof("Enabling binary logging for branch %s", logBinBranch)
+				logrus.Infof("Enabling binary logging (default replication branch: %s)", binlogreplication.BinlogBranch)
 				binlogProducer, err := binlogreplication.NewBinlogProducer(dEnv.FS)
 				if err != nil {
 					return err
@@ -353,6 +360,13 @@ func ConfigureServices(
 					return err
 				}
 				binlogProducer.LogManager(logManager)
+				sqlCtx, err := sqlEngine.NewDefaultContext(ctx)
+				if err != nil {
+					return err
+				}
+				if err = binlogProducer.InitializeBranchLogManagers(sqlCtx); err != nil {
+					return err
+				}
 				doltdb.RegisterDatabaseUpdateListener(binlogProducer)
 				doltBinlogPrimaryController.BinlogProducer(binlogProducer)
 
@@ -369,6 +383,137 @@ func ConfigureServices(
 	}
 	controller.Register(InitBinlogging)
 
+	// Run dolt ci workflows with push event triggers whenever a branch head is updated on this server.
+	InitCIWorkflowTriggers := &svcs.AnonService{
+		InitF: func(ctx context.Context) error {
+			// Keep the workflow run history in the cfg_dir, so that it survives restarts of the server
+			err := dtables.WorkflowRuns.Load(filepath.Join(serverConfig.CfgDir(), dtables.WorkflowRunHistoryFilename))
+			if err != nil {
+				return err
+			}
+
+			// Workflows run in a new session of the client that updated the branch, with that client's privileges
+			newWorkflowContext := func(ctx context.Context, client sql.Client) (*sql.Context, error) {
+				sqlCtx, err := sqlEngine.NewDefaultContext(ctx)
+				if err != nil {
+					return nil, err
+				}
+				sqlCtx.Session.SetClient(client)
+				return sqlCtx, nil
+			}
+
+			bThreads := sqlEngine.GetUnderlyingEngine().BackgroundThreads
+			err = mrEnv.Iter(func(name string, dEnv *env.DoltEnv) (stop bool, err error) {
+				hook, err := dolt_ci.NewWorkflowTriggerHook(bThreads, name, newWorkflowContext, sqlEngine.Query, dtables.WorkflowRuns)
+				if err != nil {
+					return true, err
+				}
+				_ = hook.SetLogger(ctx, cli.CliOut)
+				dEnv.DoltDB.PrependCommitHook(ctx, hook)
+				return false, nil
+			})
+			if err != nil {
+				return err
+			}
+
+			provider := sqlEngine.GetUnderlyingEngine().Analyzer.Catalog.DbProvider
+			if doltProvider, ok := provider.(*sqle.DoltDatabaseProvider); ok {
+				doltProvider.AddInitDatabaseHook(dolt_ci.NewWorkflowTriggerInitDatabaseHook(bThreads, newWorkflowContext, sqlEngine.Query, dtables.WorkflowRuns))
+			}
+			return nil
+		},
+	}
+	controller.Register(InitCIWorkflowTriggers)
+
+	// Notify the webhooks configured for this server whenever a branch head is updated.
+	InitWebhooks := &svcs.AnonService{
+		InitF: func(ctx context.Context) error {
+			if len(serverConfig.Webhooks()) == 0 {
+				return nil
+			}
+
+			bThreads := sqlEngine.GetUnderlyingEngine().BackgroundThreads
+			hooks, err := webhooks.NewWebhooks(bThreads, serverConfig.Webhooks(), cli.CliErr)
+			if err != nil {
+				return err
+			}
+			err = mrEnv.Iter(func(name string, dEnv *env.DoltEnv) (stop bool, err error) {
+				hook, err := webhooks.NewBranchUpdateHook(ctx, bThreads, name, dEnv.DoltDB, hooks)
+				if err != nil {
+					return true, err
+				}
+				_ = hook.SetLogger(ctx, cli.CliErr)
+				dEnv.DoltDB.PrependCommitHook(ctx, hook)
+				return false, nil
+			})
+			if err != nil {
+				return err
+			}
+
+			provider := sqlEngine.GetUnderlyingEngine().Analyzer.Catalog.DbProvider
+			if doltProvider, ok := provider.(*sqle.DoltDatabaseProvider); ok {
+				doltProvider.AddInitDatabaseHook(webhooks.NewBranchUpdateInitDatabaseHook(bThreads, hooks, cli.CliErr))
+			}
+			return nil
+		},
+	}
+	controller.Register(InitWebhooks)
+
+	InitCDC := &svcs.AnonService{
+		InitF: func(ctx context.Context) error {
+			cfg := serverConfig.CDCConfig()
+			if cfg == nil {
+				return nil
+			}
+
+			sinkPath := cfg.Path()
+			if cfg.Sink() == servercfg.CDCSinkFile {
+				var err error
+				if sinkPath, err = resolveServerPath(dEnv, sinkPath); err != nil {
+					return err
+				}
+			}
+			positionFile := cfg.PositionFile()
+			if positionFile == "" {
+				positionFile = filepath.Join(serverConfig.CfgDir(), cdc.DefaultPositionFilename)
+			}
+			positionFile, err := resolveServerPath(dEnv, positionFile)
+			if err != nil {
+				return err
+			}
+
+			sink, err := cdc.NewSink(cfg, sinkPath, cli.CliOut)
+			if err != nil {
+				return err
+			}
+			bThreads := sqlEngine.GetUnderlyingEngine().BackgroundThreads
+			stream, err := cdc.NewStream(bThreads, cfg, sink, positionFile, version, cli.CliErr)
+			if err != nil {
+				sink.Close()
+				return err
+			}
+			err = mrEnv.Iter(func(name string, dEnv *env.DoltEnv) (stop bool, err error) {
+				hook, err := cdc.NewCommitHook(ctx, stream, name, dEnv.DoltDB)
+				if err != nil {
+					return true, err
+				}
+				_ = hook.SetLogger(ctx, cli.CliErr)
+				dEnv.DoltDB.PrependCommitHook(ctx, hook)
+				return false, nil
+			})
+			if err != nil {
+				return err
+			}
+
+			provider := sqlEngine.GetUnderlyingEngine().Analyzer.Catalog.DbProvider
+			if doltProvider, ok := provider.(*sqle.DoltDatabaseProvider); ok {
+				doltProvider.AddInitDatabaseHook(cdc.NewInitDatabaseHook(stream, cli.CliErr))
+			}
+			return nil
+		},
+	}
+	controller.Register(InitCDC)
+
 	// MySQL creates a root superuser when the mysql install is first initialized. Depending on the options
 	// specified, the root superuser is created without a password, or with a random password. This varies
 	// slightly in some OS-specific installers. Dolt initializes the root superuser the first time a
@@ -460,7 +605,7 @@ func ConfigureServices(
 	InitMetricsListener := &svcs.AnonService{
 		InitF: func(context.Context) (err error) {
 			labels := serverConfig.MetricsLabels()
-			metListener, err = newMetricsListener(labels, version, clusterController)
+			metListener, err = newMetricsListener(labels, version, clusterController, dtables.GCStatuses)
 			return err
 		},
 		StopF: func() error {
@@ -759,6 +904,25 @@ func ConfigureServices(
 		},
 	}
 	controller.Register(RunSQLServer)
+
+	// Run garbage collection in the background when the databases of this server grow past the configured thresholds.
+	if autoGCConfig := serverConfig.AutoGCConfig(); autoGCConfig != nil && autoGCConfig.Enable() {
+		newGCContext := func(ctx context.Context) (*sql.Context, error) {
+			sess, err := sqlEngine.NewDoltSession(ctx, sql.NewBaseSession())
+			if err != nil {
+				return nil, err
+			}
+			// Like the server's other background work, the session has no client, so it doesn't depend on any account
+			// existing. The GC safepoint kills every other connection to the server, so the context needs the server's
+			// process list and the ability to kill connections.
+			return sql.NewContext(ctx,
+				sql.WithSession(sess),
+				sql.WithProcessList(sqlEngine.GetUnderlyingEngine().ProcessList),
+				sql.WithServices(sql.Services{KillConnection: mySQLServer.SessionManager().KillConnection}),
+			), nil
+		}
+		controller.Register(newAutoGCService(autoGCConfig, newGCContext, dtables.GCStatuses, lgr))
+	}
 }
 
 // heartbeatService is a service that sends a heartbeat event to the metrics server once a day
@@ -927,6 +1091,222 @@ func (r *remotesapiAuth) ApiAuthorize(ctx context.Context, superUserRequired boo
 	return true, nil
 }
 
+var _ remotesrv.RepoAccessControl = (*remotesapiAuth)(nil)
+
+// ApiAuthorizeRepoRead implements remotesrv.RepoAccessControl. When branch control reads are enforced, fetching or
+// cloning a database requires that the user can read every branch of that database, as the chunks that are sent are not
+// limited to any single branch.
+func (r *remotesapiAuth) ApiAuthorizeRepoRead(ctx context.Context, repoPath string) (bool, error) {
+	if !dsess.BranchReadsEnforced() {
+		return true, nil
+	}
+
+	sqlCtx, ok := ctx.Value(ApiSqleContextKey).(*sql.Context)
+	if !ok {
+		return false, fmt.Errorf("Runtime error: could not get SQL context from context")
+	}
+
+	sess := dsess.DSessFromSess(sqlCtx.Session)
+	db, err := sess.Provider().Database(sqlCtx, repoPath)
+	if sql.ErrDatabaseNotFound.Is(err) {
+		// the request handler reports unknown databases
+		return true, nil
+	} else if err != nil {
+		return false, fmt.Errorf("API Authorization Failure: %w", err)
+	}
+	sdb, ok := db.(dsess.SqlDatabase)
+	if !ok {
+		return true, nil
+	}
+
+	branches, err := sdb.DbData().Ddb.GetBranches(sqlCtx)
+	if err != nil {
+		return false, err
+	}
+	for _, branch := range branches {
+		if err = dsess.CheckReadAccess(sqlCtx, repoPath, branch.GetPath()); err != nil {
+			return false, fmt.Errorf("API Authorization Failure: %w", err)
+		}
+	}
+	return true, nil
+}
+
+var _ remotesrv.CommitAccessControl = (*remotesapiAuth)(nil)
+
+// ApiAuthorizeCommit implements remotesrv.CommitAccessControl. Every branch that is created, moved, or deleted by the
+// commit is checked against branch_control, which also rejects updates to protected branches that are not fast-forwards.
+// A push may only change the working set of a branch that it updates, and only to the branch's new head, as is done
+// when pushing to a branch with a clean working set. Changes to any other dataset, such as tags, remotes, or the
+// working sets of other branches, are denied.
+func (r *remotesapiAuth) ApiAuthorizeCommit(ctx context.Context, repoPath string, last, current hash.Hash) (bool, error) {
+	sqlCtx, ok := ctx.Value(ApiSqleContextKey).(*sql.Context)
+	if !ok {
+		return false, fmt.Errorf("Runtime error: could not get SQL context from context")
+	}
+
+	sess := dsess.DSessFromSess(sqlCtx.Session)
+	db, err := sess.Provider().Database(sqlCtx, repoPath)
+	if sql.ErrDatabaseNotFound.Is(err) {
+		// the request handler reports unknown databases
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	sdb, ok := db.(dsess.SqlDatabase)
+	if !ok {
+		return true, nil
+	}
+	ddb := sdb.DbData().Ddb
+
+	lastDatasets := make(map[string]hash.Hash)
+	if !last.IsEmpty() {
+		lastDatasets, err = ddb.GetDatasetsByRootHash(sqlCtx, last)
+		if err != nil {
+			return false, err
+		}
+	}
+	currDatasets, err := ddb.GetDatasetsByRootHash(sqlCtx, current)
+	if err != nil {
+		return false, err
+	}
+
+	changed := make(map[string]struct{})
+	for id, addr := range currDatasets {
+		if prev, existed := lastDatasets[id]; !existed || prev != addr {
+			changed[id] = struct{}{}
+		}
+	}
+	for id := range lastDatasets {
+		if _, exists := currDatasets[id]; !exists {
+			changed[id] = struct{}{}
+		}
+	}
+
+	var workingSets []ref.WorkingSetRef
+	for id := range changed {
+		if ref.IsWorkingSet(id) {
+			workingSets = append(workingSets, ref.NewWorkingSetRef(id))
+			continue
+		}
+		if !ref.IsRef(id) {
+			return false, remotesrv.CommitDeniedError{Reason: fmt.Errorf("a push may not update %s", id)}
+		}
+		dref, err := ref.Parse(id)
+		if err != nil {
+			return false, remotesrv.CommitDeniedError{Reason: fmt.Errorf("a push may not update %s", id)}
+		}
+		switch dref.GetType() {
+		case ref.BranchRefType:
+			prev, existed := lastDatasets[id]
+			curr, exists := currDatasets[id]
+			// creating a branch counts as a fast-forward, while deleting one does not
+			isFastForward := !existed
+			if existed && exists {
+				isFastForward, err = isFastForwardUpdate(sqlCtx, ddb, prev, curr)
+				if err != nil {
+					return false, err
+				}
+			}
+			if err = branch_control.CanPushBranch(sqlCtx, repoPath, dref.GetPath(), !existed, isFastForward); err != nil {
+				return false, remotesrv.CommitDeniedError{Reason: err}
+			}
+		default:
+			return false, remotesrv.CommitDeniedError{Reason: fmt.Errorf("a push may not update %s", id)}
+		}
+	}
+
+	for _, wsRef := range workingSets {
+		if err = checkPushedWorkingSet(sqlCtx, ddb, wsRef, changed, currDatasets, current); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// checkPushedWorkingSet checks that the working set |wsRef|, which is changed by a push to |current|, belongs to a
+// branch that the push also changes. A working set that still exists must be clean at the branch's new head.
+func checkPushedWorkingSet(ctx *sql.Context, ddb *doltdb.DoltDB, wsRef ref.WorkingSetRef, changed map[string]struct{}, currDatasets map[string]hash.Hash, current hash.Hash) error {
+	headRef, err := wsRef.ToHeadRef()
+	if err != nil || headRef.GetType() != ref.BranchRefType {
+		return remotesrv.CommitDeniedError{Reason: fmt.Errorf("a push may not update %s", wsRef.String())}
+	}
+	if _, ok := changed[headRef.String()]; !ok {
+		return remotesrv.CommitDeniedError{Reason: fmt.Errorf("a push may not update %s", wsRef.String())}
+	}
+	if _, exists := currDatasets[wsRef.String()]; !exists {
+		// the working set is deleted along with its branch
+		if _, branchExists := currDatasets[headRef.String()]; branchExists {
+			return remotesrv.CommitDeniedError{Reason: fmt.Errorf("a push may not delete %s", wsRef.String())}
+		}
+		return nil
+	}
+
+	ws, err := ddb.ResolveWorkingSetAtRoot(ctx, wsRef, current)
+	if err != nil {
+		return err
+	}
+	headCm, err := ddb.ResolveCommitRefAtRoot(ctx, headRef, current)
+	if err != nil {
+		return err
+	}
+	headRoot, err := headCm.GetRootValue(ctx)
+	if err != nil {
+		return err
+	}
+	headHash, err := headRoot.HashOf()
+	if err != nil {
+		return err
+	}
+	workingHash, err := ws.WorkingRoot().HashOf()
+	if err != nil {
+		return err
+	}
+	stagedHash, err := ws.StagedRoot().HashOf()
+	if err != nil {
+		return err
+	}
+	if workingHash != headHash || stagedHash != headHash || ws.MergeActive() {
+		return remotesrv.CommitDeniedError{Reason: fmt.Errorf("a push may only reset %s to the head of %s", wsRef.String(), headRef.GetPath())}
+	}
+	return nil
+}
+
+// isFastForwardUpdate returns whether moving a branch from the commit |from| to the commit |to| is a fast-forward.
+func isFastForwardUpdate(ctx context.Context, ddb *doltdb.DoltDB, from, to hash.Hash) (bool, error) {
+	optFrom, err := ddb.ReadCommit(ctx, from)
+	if err != nil {
+		return false, err
+	}
+	fromCm, ok := optFrom.ToCommit()
+	if !ok {
+		return false, doltdb.ErrGhostCommitEncountered
+	}
+	optTo, err := ddb.ReadCommit(ctx, to)
+	if err != nil {
+		return false, err
+	}
+	toCm, ok := optTo.ToCommit()
+	if !ok {
+		return false, doltdb.ErrGhostCommitEncountered
+	}
+
+	optAncestor, err := doltdb.GetCommitAncestor(ctx, fromCm, toCm)
+	if errors.Is(err, doltdb.ErrNoCommonAncestor) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return optAncestor.Addr == from, nil
+}
+
+// resolveServerPath resolves |path| relative to the root of |dEnv| if it is not an absolute path.
+func resolveServerPath(dEnv *env.DoltEnv, path string) (string, error) {
+	if filepath.IsAbs(path) {
+		return path, nil
+	}
+	return dEnv.FS.Abs(path)
+}
+
 // doesPrivilegesDbExist looks for an existing privileges database as the specified |privilegeFilePath|. If
 // |privilegeFilePath| is an absolute path, it is used directly. If it is a relative path, then it is resolved
 // relative to the root of the specified |dEnv|.
@@ -608,7 +608,32 @@ branch_control_file: dir1/dir2/abc.db
     # - https://standby_replica_two.svc.cluster.local
     # server_name_dns:
     # - standby_replica_one.svc.cluster.local
-    # - standby_replica_two.svc.cluster.local`
+    # - standby_replica_two.svc.cluster.local
+
+# webhooks:
+# - name: ci
+  # url: https://ci.example.com/hooks/dolt
+  # branches:
+  # - main
+  # - release/*
+  # secret: webhook_secret
+  # max_retries: 3
+
+# auto_gc:
+  # enable: true
+  # check_interval_millis: 60000
+  # journal_size_threshold_mb: 256
+  # oldgen_growth_threshold_mb: 4096
+  # kill_connections: true
+
+# cdc:
+  # name: dolt
+  # sink: file
+  # path: cdc/changes.jsonl
+  # max_file_size_mb: 100
+  # max_files: 10
+  # branches:
+  # - main`
 
 	ap := SqlServerCmd{}.ArgParser()
 
@@ -0,0 +1,102 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stashcmds
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var stashApplyDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Apply a single stash on top of the current working set without removing it from the stash list.",
+	LongDesc: `Like dolt stash pop, but do not remove the stash entry from the stash list (e.g. 'dolt stash apply stash@{1}' will apply the stash entry at index 1 in the stash list). The latest stash entry is applied if none is given.
+`,
+	Synopsis: []string{
+		"[{{.LessThan}}stash{{.GreaterThan}}]",
+	},
+}
+
+type StashApplyCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd StashApplyCmd) Name() string {
+	return "apply"
+}
+
+// Description returns a description of the command
+func (cmd StashApplyCmd) Description() string {
+	return "Apply a single stash on top of the current working set without removing it from the stash list."
+}
+
+func (cmd StashApplyCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(stashApplyDocs, ap)
+}
+
+func (cmd StashApplyCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	return ap
+}
+
+// Exec executes the command
+func (cmd StashApplyCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	if !dEnv.DoltDB.Format().UsesFlatbuffers() {
+		cli.PrintErrln(ErrStashNotSupportedForOldFormat.Error())
+		return 1
+	}
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, stashApplyDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	_, sqlCtx, closer, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		cli.PrintErrln(err.Error())
+		return 1
+	}
+	if closer != nil {
+		defer closer()
+	}
+
+	var idx = 0
+	if apr.NArg() == 1 {
+		idx, err = doltdb.ParseStashIndex(apr.Args[0])
+		if err != nil {
+			cli.Println(err.Error())
+			return 1
+		}
+	}
+
+	workingRoot, err := dEnv.WorkingRoot(sqlCtx)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	success, err := applyStashAtIdx(sqlCtx, dEnv, workingRoot, idx)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	ret := commands.StatusCmd{}.Exec(sqlCtx, "status", []string{}, dEnv, cliCtx)
+	if ret != 0 || !success {
+		return 1
+	}
+	return 0
+}
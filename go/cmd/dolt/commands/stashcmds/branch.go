@@ -0,0 +1,133 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stashcmds
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var stashBranchDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Create a new branch from the commit a stash entry was based on and apply the stash there.",
+	LongDesc: `Creates and checks out a new branch named {{.LessThan}}branchname{{.GreaterThan}} starting from the commit at which the stash entry was originally made, applies the changes recorded in the stash entry to the new working set, and drops the stash entry if it applied successfully. The latest stash entry is used if none is given.
+
+This is useful if the branch on which you ran dolt stash has changed enough that dolt stash apply fails due to conflicts. Since the stash entry is applied on top of the commit that was HEAD at the time dolt stash was run, it restores the originally stashed state with no conflicts.
+`,
+	Synopsis: []string{
+		"{{.LessThan}}branchname{{.GreaterThan}} [{{.LessThan}}stash{{.GreaterThan}}]",
+	},
+}
+
+type StashBranchCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd StashBranchCmd) Name() string {
+	return "branch"
+}
+
+// Description returns a description of the command
+func (cmd StashBranchCmd) Description() string {
+	return "Create a new branch from the commit a stash entry was based on and apply the stash there."
+}
+
+func (cmd StashBranchCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(stashBranchDocs, ap)
+}
+
+func (cmd StashBranchCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 2)
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"branchname", "The name of the branch to create."})
+	return ap
+}
+
+// Exec executes the command
+func (cmd StashBranchCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	if !dEnv.DoltDB.Format().UsesFlatbuffers() {
+		cli.PrintErrln(ErrStashNotSupportedForOldFormat.Error())
+		return 1
+	}
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, stashBranchDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	if apr.NArg() == 0 {
+		usage()
+		return 1
+	}
+	branchName := apr.Arg(0)
+
+	_, sqlCtx, closer, err := cliCtx.QueryEngine(ctx)
+	if err != nil {
+		cli.PrintErrln(err.Error())
+		return 1
+	}
+	if closer != nil {
+		defer closer()
+	}
+
+	var idx = 0
+	if apr.NArg() == 2 {
+		idx, err = doltdb.ParseStashIndex(apr.Arg(1))
+		if err != nil {
+			cli.Println(err.Error())
+			return 1
+		}
+	}
+
+	_, headCommit, _, err := dEnv.DoltDB.GetStashRootAndHeadCommitAtIdx(sqlCtx, idx)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+	headHash, err := headCommit.HashOf()
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	ret := commands.CheckoutCmd{}.Exec(sqlCtx, "checkout", []string{"-b", branchName, headHash.String()}, dEnv, cliCtx)
+	if ret != 0 {
+		return ret
+	}
+
+	workingRoot, err := dEnv.WorkingRoot(sqlCtx)
+	if err != nil {
+		return handleStashPopErr(usage, err)
+	}
+
+	success, err := applyStashAtIdx(sqlCtx, dEnv, workingRoot, idx)
+	if err != nil {
+		return handleStashPopErr(usage, err)
+	}
+
+	ret = commands.StatusCmd{}.Exec(sqlCtx, "status", []string{}, dEnv, cliCtx)
+	if ret != 0 || !success {
+		cli.Println("The stash entry is kept in case you need it again.")
+		return 1
+	}
+
+	cli.Println()
+	err = dropStashAtIdx(sqlCtx, dEnv, idx)
+	if err != nil {
+		return handleStashPopErr(usage, err)
+	}
+
+	return 0
+}
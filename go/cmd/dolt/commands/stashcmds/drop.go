@@ -17,13 +17,12 @@ package stashcmds
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands"
 	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
 	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 )
@@ -78,11 +77,9 @@ func (cmd StashDropCmd) Exec(ctx context.Context, commandStr string, args []stri
 	var idx = 0
 	var err error
 	if apr.NArg() == 1 {
-		stashName := apr.Args[0]
-		stashName = strings.TrimSuffix(strings.TrimPrefix(stashName, "stash@{"), "}")
-		idx, err = strconv.Atoi(stashName)
+		idx, err = doltdb.ParseStashIndex(apr.Args[0])
 		if err != nil {
-			cli.Printf("error: %s is not a valid reference", stashName)
+			cli.Println(err.Error())
 			return 1
 		}
 	}
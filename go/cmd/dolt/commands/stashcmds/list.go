@@ -28,7 +28,7 @@ import (
 
 var stashListDocs = cli.CommandDocumentationContent{
 	ShortDesc: "List the stash entries that you currently have.",
-	LongDesc: `Each stash entry is listed with its name (e.g. stash@{0} is the latest entry, stash@{1} is the one before, etc.), the name of the branch that was current when the entry was made, and a short description of the commit the entry was based on.
+	LongDesc: `Each stash entry is listed with its name (e.g. stash@{0} is the latest entry, stash@{1} is the one before, etc.), the name of the branch that was current when the entry was made, and a short description of the commit the entry was based on, or the message given when the entry was made.
 `,
 	Synopsis: []string{
 		"",
@@ -86,11 +86,11 @@ func listStashes(ctx context.Context, dEnv *env.DoltEnv) error {
 	}
 
 	for _, stash := range stashes {
-		commitHash, err := stash.HeadCommit.HashOf()
+		summary, err := stash.Summary()
 		if err != nil {
 			return err
 		}
-		cli.Println(fmt.Sprintf("%s: WIP on %s: %s %s", stash.Name, stash.BranchName, commitHash.String(), stash.Description))
+		cli.Println(fmt.Sprintf("%s: %s", stash.Name, summary))
 	}
 	return nil
 }
@@ -16,7 +16,6 @@ package stashcmds
 
 import (
 	"context"
-	"strconv"
 	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -90,11 +89,9 @@ func (cmd StashPopCmd) Exec(ctx context.Context, commandStr string, args []strin
 
 	var idx = 0
 	if apr.NArg() == 1 {
-		stashName := apr.Args[0]
-		stashName = strings.TrimSuffix(strings.TrimPrefix(stashName, "stash@{"), "}")
-		idx, err = strconv.Atoi(stashName)
+		idx, err = doltdb.ParseStashIndex(apr.Args[0])
 		if err != nil {
-			cli.Printf("error: %s is not a valid reference", stashName)
+			cli.Println(err.Error())
 			return 1
 		}
 	}
@@ -130,52 +127,17 @@ func applyStashAtIdx(ctx *sql.Context, dEnv *env.DoltEnv, curWorkingRoot doltdb.
 		return false, err
 	}
 
-	hch, err := headCommit.HashOf()
-	if err != nil {
-		return false, err
-	}
-	headCommitSpec, err := doltdb.NewCommitSpec(hch.String())
-	if err != nil {
-		return false, err
-	}
-	headRef, err := dEnv.RepoStateReader().CWBHeadRef()
-	if err != nil {
-		return false, err
-	}
-	optCmt, err := dEnv.DoltDB.Resolve(ctx, headCommitSpec, headRef)
-	if err != nil {
-		return false, err
-	}
-	parentCommit, ok := optCmt.ToCommit()
-	if !ok {
-		// Should not be possible to get into this situation. The parent of the stashed commit
-		// Must have been present at the time it was created
-		return false, doltdb.ErrGhostCommitEncountered
-	}
-
-	parentRoot, err := parentCommit.GetRootValue(ctx)
-	if err != nil {
-		return false, err
-	}
-
 	tmpDir, err := dEnv.TempTableFilesDir()
 	if err != nil {
 		return false, err
 	}
 
 	opts := editor.Options{Deaf: dEnv.BulkDbEaFactory(), Tempdir: tmpDir}
-	result, err := merge.MergeRoots(ctx, curWorkingRoot, stashRoot, parentRoot, stashRoot, parentCommit, opts, merge.MergeOpts{IsCherryPick: false})
+	mergedRoot, tablesWithConflict, err := merge.ApplyStash(ctx, curWorkingRoot, stashRoot, headCommit, opts)
 	if err != nil {
 		return false, err
 	}
 
-	var tablesWithConflict []doltdb.TableName
-	for tbl, stats := range result.Stats {
-		if stats.HasConflicts() {
-			tablesWithConflict = append(tablesWithConflict, tbl)
-		}
-	}
-
 	if len(tablesWithConflict) > 0 {
 		tblNames := strings.Join(doltdb.FlattenTableNames(tablesWithConflict), "', '")
 		cli.Printf("error: Your local changes to the following tables would be overwritten by applying stash %d:\n"+
@@ -184,7 +146,7 @@ func applyStashAtIdx(ctx *sql.Context, dEnv *env.DoltEnv, curWorkingRoot doltdb.
 		return false, nil
 	}
 
-	err = dEnv.UpdateWorkingRoot(ctx, result.Root)
+	err = dEnv.UpdateWorkingRoot(ctx, mergedRoot)
 	if err != nil {
 		return false, err
 	}
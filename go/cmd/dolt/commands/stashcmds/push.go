@@ -0,0 +1,94 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stashcmds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+)
+
+var stashPushDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Save your local modifications to a new stash entry.",
+	LongDesc: `Save your local modifications to a new stash entry and roll them back to HEAD. Running dolt stash without any subcommand is equivalent to dolt stash push.
+
+If a message is given with {{.EmphasisLeft}}-m{{.EmphasisRight}}, it is used to describe the stash entry instead of the HEAD commit the entry is based on.
+
+If one or more tables are given, only the changes to those tables are stashed, and the changes to all other tables are left in place. Named tables are stashed even if they are untracked.
+`,
+	Synopsis: []string{
+		"[-u | -a] [-m {{.LessThan}}message{{.GreaterThan}}] [{{.LessThan}}table{{.GreaterThan}}...]",
+	},
+}
+
+type StashPushCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd StashPushCmd) Name() string {
+	return "push"
+}
+
+// Description returns a description of the command
+func (cmd StashPushCmd) Description() string {
+	return "Save your local modifications to a new stash entry."
+}
+
+func (cmd StashPushCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(stashPushDocs, ap)
+}
+
+func (cmd StashPushCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithVariableArgs(cmd.Name())
+	ap.ArgListHelp = append(ap.ArgListHelp, [2]string{"table", "The tables to stash. All changed tables are stashed if none are given."})
+	ap.SupportsFlag(IncludeUntrackedFlag, "u", "Untracked tables are also stashed.")
+	ap.SupportsFlag(AllFlag, "a", "All tables are stashed, including untracked and ignored tables.")
+	ap.SupportsString(cli.MessageArg, "m", "msg", "Use the given {{.LessThan}}msg{{.GreaterThan}} to describe the stash entry.")
+	return ap
+}
+
+// EventType returns the type of the event to log
+func (cmd StashPushCmd) EventType() eventsapi.ClientEventType {
+	return eventsapi.ClientEventType_STASH
+}
+
+// Exec executes the command
+func (cmd StashPushCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	ap := cmd.ArgParser()
+	help, _ := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, stashPushDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	if !dEnv.DoltDB.Format().UsesFlatbuffers() {
+		cli.PrintErrln(ErrStashNotSupportedForOldFormat.Error())
+		return 1
+	}
+
+	if apr.NArg() > 0 && (apr.Contains(IncludeUntrackedFlag) || apr.Contains(AllFlag)) {
+		cli.PrintErrln(fmt.Sprintf("error: --%s and --%s cannot be used when stashing specific tables", IncludeUntrackedFlag, AllFlag))
+		return 1
+	}
+
+	err := stashChanges(ctx, dEnv, apr)
+	if err != nil {
+		commands.PrintStagingError(err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,131 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stashcmds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands"
+	"github.com/dolthub/dolt/go/cmd/dolt/errhand"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/store/datas"
+)
+
+var stashShowDocs = cli.CommandDocumentationContent{
+	ShortDesc: "Show the changes recorded in a stash entry.",
+	LongDesc: `Show the changes recorded in the stash entry as a diff between the stashed contents and the commit the entry was based on. By default, a summary of the changes to each table is shown, as with {{.EmphasisLeft}}dolt diff --stat{{.EmphasisRight}}. The latest stash entry is shown if none is given.
+`,
+	Synopsis: []string{
+		"[-p] [{{.LessThan}}stash{{.GreaterThan}}]",
+	},
+}
+
+type StashShowCmd struct{}
+
+// Name returns the name of the Dolt cli command. This is what is used on the command line to invoke the command
+func (cmd StashShowCmd) Name() string {
+	return "show"
+}
+
+// Description returns a description of the command
+func (cmd StashShowCmd) Description() string {
+	return "Show the changes recorded in a stash entry."
+}
+
+func (cmd StashShowCmd) Docs() *cli.CommandDocumentation {
+	ap := cmd.ArgParser()
+	return cli.NewCommandDocumentation(stashShowDocs, ap)
+}
+
+func (cmd StashShowCmd) ArgParser() *argparser.ArgParser {
+	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 1)
+	ap.SupportsFlag(cli.PatchFlag, "p", "Show the full diff of the stash entry instead of a summary.")
+	return ap
+}
+
+// Exec executes the command
+func (cmd StashShowCmd) Exec(ctx context.Context, commandStr string, args []string, dEnv *env.DoltEnv, cliCtx cli.CliContext) int {
+	if !dEnv.DoltDB.Format().UsesFlatbuffers() {
+		cli.PrintErrln(ErrStashNotSupportedForOldFormat.Error())
+		return 1
+	}
+	ap := cmd.ArgParser()
+	help, usage := cli.HelpAndUsagePrinters(cli.CommandDocsForCommandString(commandStr, stashShowDocs, ap))
+	apr := cli.ParseArgsOrDie(ap, args, help)
+
+	var idx = 0
+	var err error
+	if apr.NArg() == 1 {
+		idx, err = doltdb.ParseStashIndex(apr.Args[0])
+		if err != nil {
+			cli.Println(err.Error())
+			return 1
+		}
+	}
+
+	headHash, stashHash, err := stashDiffCommits(ctx, dEnv.DoltDB, idx)
+	if err != nil {
+		return commands.HandleVErrAndExitCode(errhand.VerboseErrorFromError(err), usage)
+	}
+
+	diffArgs := []string{headHash, stashHash}
+	if !apr.Contains(cli.PatchFlag) {
+		diffArgs = append([]string{"--" + cli.StatFlag}, diffArgs...)
+	}
+	return commands.DiffCmd{}.Exec(ctx, "diff", diffArgs, dEnv, cliCtx)
+}
+
+// stashDiffCommits returns the hash of the commit the stash entry at |idx| was based on, and the hash of a commit
+// holding the stashed contents with that commit as its parent, so that the stash entry can be diffed like any other
+// commit. The stash commit is written as a dangling commit with the same metadata as its parent, so it is the same
+// commit every time the stash entry is shown.
+func stashDiffCommits(ctx context.Context, ddb *doltdb.DoltDB, idx int) (string, string, error) {
+	stashRoot, headCommit, _, err := ddb.GetStashRootAndHeadCommitAtIdx(ctx, idx)
+	if err != nil {
+		return "", "", err
+	}
+
+	headHash, err := headCommit.HashOf()
+	if err != nil {
+		return "", "", err
+	}
+	headMeta, err := headCommit.GetCommitMeta(ctx)
+	if err != nil {
+		return "", "", err
+	}
+
+	_, valHash, err := ddb.WriteRootValue(ctx, stashRoot)
+	if err != nil {
+		return "", "", err
+	}
+	meta, err := datas.NewCommitMetaWithUserTS(headMeta.Name, headMeta.Email, fmt.Sprintf("stash@{%d}", idx), headMeta.Time())
+	if err != nil {
+		return "", "", err
+	}
+	stashCommit, err := ddb.CommitDanglingWithParentCommits(ctx, valHash, []*doltdb.Commit{headCommit}, meta)
+	if err != nil {
+		return "", "", err
+	}
+	stashHash, err := stashCommit.HashOf()
+	if err != nil {
+		return "", "", err
+	}
+
+	return headHash.String(), stashHash.String(), nil
+}
@@ -16,13 +16,11 @@ package stashcmds
 
 import (
 	"context"
-	"errors"
 	"fmt"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands"
 	eventsapi "github.com/dolthub/dolt/go/gen/proto/dolt/services/eventsapi/v1alpha1"
-	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
@@ -30,18 +28,22 @@ import (
 	"github.com/dolthub/dolt/go/store/datas"
 )
 
-var ErrStashNotSupportedForOldFormat = errors.New("stash is not supported for old storage format")
+var ErrStashNotSupportedForOldFormat = doltdb.ErrStashNotSupportedForOldFormat
 
 var StashCommands = cli.NewSubCommandHandlerWithUnspecified("stash", "Stash the changes in a dirty working directory away.", false, StashCmd{}, []cli.Command{
+	StashApplyCmd{},
+	StashBranchCmd{},
 	StashClearCmd{},
 	StashDropCmd{},
 	StashListCmd{},
 	StashPopCmd{},
+	StashPushCmd{},
+	StashShowCmd{},
 })
 
 const (
-	IncludeUntrackedFlag = "include-untracked"
-	AllFlag              = "all"
+	IncludeUntrackedFlag = cli.IncludeUntrackedFlag
+	AllFlag              = cli.AllFlag
 )
 
 var stashDocs = cli.CommandDocumentationContent{
@@ -52,10 +54,14 @@ The command saves your local modifications away and reverts the working director
 `,
 	Synopsis: []string{
 		"", // this is for `dolt stash` itself.
+		"push [-u | -a] [-m {{.LessThan}}message{{.GreaterThan}}] [{{.LessThan}}table{{.GreaterThan}}...]",
 		"list",
-		"pop {{.LessThan}}stash{{.GreaterThan}}",
+		"show [-p] [{{.LessThan}}stash{{.GreaterThan}}]",
+		"pop [{{.LessThan}}stash{{.GreaterThan}}]",
+		"apply [{{.LessThan}}stash{{.GreaterThan}}]",
+		"branch {{.LessThan}}branchname{{.GreaterThan}} [{{.LessThan}}stash{{.GreaterThan}}]",
 		"clear",
-		"drop {{.LessThan}}stash{{.GreaterThan}}",
+		"drop [{{.LessThan}}stash{{.GreaterThan}}]",
 	},
 }
 
@@ -80,6 +86,7 @@ func (cmd StashCmd) ArgParser() *argparser.ArgParser {
 	ap := argparser.NewArgParserWithMaxArgs(cmd.Name(), 0)
 	ap.SupportsFlag(IncludeUntrackedFlag, "u", "Untracked tables are also stashed.")
 	ap.SupportsFlag(AllFlag, "a", "All tables are stashed, including untracked and ignored tables.")
+	ap.SupportsString(cli.MessageArg, "m", "msg", "Use the given {{.LessThan}}msg{{.GreaterThan}} to describe the stash entry.")
 	return ap
 }
 
@@ -107,83 +114,27 @@ func (cmd StashCmd) Exec(ctx context.Context, commandStr string, args []string,
 	return 0
 }
 
-func hasLocalChanges(ctx context.Context, dEnv *env.DoltEnv, roots doltdb.Roots, apr *argparser.ArgParseResults) (bool, error) {
-	headRoot, err := dEnv.HeadRoot(ctx)
-	if err != nil {
-		return false, err
-	}
-	workingRoot, err := dEnv.WorkingRoot(ctx)
-	if err != nil {
-		return false, err
-	}
-	stagedRoot, err := dEnv.StagedRoot(ctx)
-	if err != nil {
-		return false, err
-	}
-	headHash, err := headRoot.HashOf()
-	if err != nil {
-		return false, err
-	}
-	workingHash, err := workingRoot.HashOf()
-	if err != nil {
-		return false, err
-	}
-	stagedHash, err := stagedRoot.HashOf()
-	if err != nil {
-		return false, err
-	}
-
-	// Are there staged changes? If so, stash them.
-	if !headHash.Equal(stagedHash) {
-		return true, nil
-	}
-
-	// No staged changes, but are there any unstaged changes? If not, no work is needed.
-	if headHash.Equal(workingHash) {
-		return false, nil
-	}
-
-	// There are unstaged changes, is --all set? If so, nothing else matters. Stash them.
-	if apr.Contains(AllFlag) {
-		return true, nil
-	}
-
-	// --all was not set, so we can ignore tables. Is every table ignored?
-	allIgnored, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots)
-	if err != nil {
-		return false, err
-	}
-
-	if allIgnored {
-		return false, nil
-	}
-
-	// There are unignored, unstaged tables. Is --include-untracked set. If so, nothing else matters. Stash them.
-	if apr.Contains(IncludeUntrackedFlag) {
-		return true, nil
-	}
-
-	// --include-untracked was not set, so we can skip untracked tables. Is every table untracked?
-	allUntracked, err := workingSetContainsOnlyUntrackedTables(ctx, roots)
-	if err != nil {
-		return false, err
-	}
-
-	if allUntracked {
-		return false, nil
-	}
-
-	// There are changes to tracked tables. Stash them.
-	return true, nil
-}
-
+// stashChanges stashes the changes in the working set and the staging area of |dEnv|. When tables are given as
+// arguments in |apr|, only the changes to those tables are stashed.
 func stashChanges(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgParseResults) error {
 	roots, err := dEnv.Roots(ctx)
 	if err != nil {
 		return fmt.Errorf("couldn't get working root, cause: %s", err.Error())
 	}
 
-	hasChanges, err := hasLocalChanges(ctx, dEnv, roots, apr)
+	includeUntracked, all := apr.Contains(IncludeUntrackedFlag), apr.Contains(AllFlag)
+	tbls := doltdb.ToTableNames(apr.Args, doltdb.DefaultSchemaName)
+
+	var hasChanges bool
+	if len(tbls) > 0 {
+		err = actions.ValidateTables(ctx, tbls, roots.Head, roots.Staged, roots.Working)
+		if err != nil {
+			return err
+		}
+		hasChanges, err = actions.HasStashableTableChanges(ctx, roots, tbls)
+	} else {
+		hasChanges, err = actions.HasStashableChanges(ctx, roots, includeUntracked, all)
+	}
 	if err != nil {
 		return err
 	}
@@ -192,33 +143,17 @@ func stashChanges(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgPars
 		return nil
 	}
 
-	roots, err = actions.StageModifiedAndDeletedTables(ctx, roots)
-	if err != nil {
-		return err
+	var stashRoot doltdb.RootValue
+	var addedTblsToStage []doltdb.TableName
+	if len(tbls) > 0 {
+		stashRoot, addedTblsToStage, roots, err = actions.StashTables(ctx, roots, tbls)
+	} else {
+		stashRoot, addedTblsToStage, roots, err = actions.StashChanges(ctx, roots, includeUntracked, all)
 	}
-
-	// all tables with changes that are going to be stashed are staged at this point
-
-	allTblsToBeStashed, addedTblsToStage, err := stashedTableSets(ctx, roots)
 	if err != nil {
 		return err
 	}
 
-	// stage untracked files to include them in the stash,
-	// but do not include them in added table set,
-	// because they should not be staged when popped.
-	if apr.Contains(IncludeUntrackedFlag) || apr.Contains(AllFlag) {
-		allTblsToBeStashed, err = doltdb.UnionTableNames(ctx, roots.Staged, roots.Working)
-		if err != nil {
-			return err
-		}
-
-		roots, err = actions.StageTables(ctx, roots, allTblsToBeStashed, !apr.Contains(AllFlag))
-		if err != nil {
-			return err
-		}
-	}
-
 	curHeadRef, err := dEnv.RepoStateReader().CWBHeadRef()
 	if err != nil {
 		return err
@@ -242,15 +177,9 @@ func stashChanges(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgPars
 		return err
 	}
 
-	err = dEnv.DoltDB.AddStash(ctx, commit, roots.Staged, datas.NewStashMeta(curBranchName, commitMeta.Description, doltdb.FlattenTableNames(addedTblsToStage)))
-	if err != nil {
-		return err
-	}
-
-	// setting STAGED to current HEAD RootValue resets staged set of changed, so
-	// these changes are now in working set of changes, which needs to be checked out
-	roots.Staged = roots.Head
-	roots, err = actions.MoveTablesFromHeadToWorking(ctx, roots, allTblsToBeStashed)
+	meta := datas.NewStashMeta(curBranchName, commitMeta.Description, doltdb.FlattenTableNames(addedTblsToStage))
+	meta.Message, _ = apr.GetValue(cli.MessageArg)
+	err = dEnv.DoltDB.AddStash(ctx, commit, stashRoot, meta)
 	if err != nil {
 		return err
 	}
@@ -260,52 +189,11 @@ func stashChanges(ctx context.Context, dEnv *env.DoltEnv, apr *argparser.ArgPars
 		return err
 	}
 
-	commitHash, err := commit.HashOf()
+	stash := doltdb.Stash{BranchName: meta.BranchName, Description: meta.Description, Message: meta.Message, HeadCommit: commit}
+	summary, err := stash.Summary()
 	if err != nil {
 		return err
 	}
-	cli.Println(fmt.Sprintf("Saved working directory and index state WIP on %s: %s %s", curBranchName, commitHash.String(), commitMeta.Description))
+	cli.Println(fmt.Sprintf("Saved working directory and index state %s", summary))
 	return nil
 }
-
-// workingSetContainsOnlyUntrackedTables returns true if all changes in working set are untracked files/added tables.
-// Untracked files are part of working set changes, but should not be stashed unless staged or --include-untracked flag is used.
-func workingSetContainsOnlyUntrackedTables(ctx context.Context, roots doltdb.Roots) (bool, error) {
-	_, unstaged, err := diff.GetStagedUnstagedTableDeltas(ctx, roots)
-	if err != nil {
-		return false, err
-	}
-
-	// All ignored files are also untracked files
-	for _, tableDelta := range unstaged {
-		if !tableDelta.IsAdd() {
-			return false, nil
-		}
-	}
-
-	return true, nil
-}
-
-// stashedTableSets returns array of table names for all tables that are being stashed and added tables in staged.
-// These table names are determined from all tables in the staged set of changes as they are being stashed only.
-func stashedTableSets(ctx context.Context, roots doltdb.Roots) ([]doltdb.TableName, []doltdb.TableName, error) {
-	var addedTblsInStaged []doltdb.TableName
-	var allTbls []doltdb.TableName
-	staged, _, err := diff.GetStagedUnstagedTableDeltas(ctx, roots)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	for _, tableDelta := range staged {
-		tblName := tableDelta.ToName
-		if tableDelta.IsAdd() {
-			addedTblsInStaged = append(addedTblsInStaged, tableDelta.ToName)
-		}
-		if tableDelta.IsDrop() {
-			tblName = tableDelta.FromName
-		}
-		allTbls = append(allTbls, tblName)
-	}
-
-	return allTbls, addedTblsInStaged, nil
-}
@@ -85,6 +85,13 @@ var jsonInputFileHelp = "The expected JSON input file format is:" + `
 	}
 
 where column_name is the name of a column of the table being imported and value is the data for that column in the table.
+
+Newline delimited JSON files (type jsonl, with a .jsonl or .ndjson extension) instead contain one row object per line:
+
+	{ "column_name":"value", ... }
+	{ "column_name":"value", ... }
+
+JSON and JSONL imports use the schema of the existing table, or the schema file given with {{.EmphasisLeft}}--schema{{.EmphasisRight}} when creating a table. JSONL can also be read from stdin by omitting the file and passing {{.EmphasisLeft}}--file-type jsonl{{.EmphasisRight}}.
 `
 
 var importDocs = cli.CommandDocumentationContent{
@@ -109,7 +116,7 @@ During import, if there is an error importing any row, the import will be aborte
 		`
 ` + jsonInputFileHelp +
 		`
-In create, update, and replace scenarios the file's extension is used to infer the type of the file.  If a file does not have the expected extension then the {{.EmphasisLeft}}--file-type{{.EmphasisRight}} parameter should be used to explicitly define the format of the file in one of the supported formats (csv, psv, json, xlsx).  For files separated by a delimiter other than a ',' (type csv) or a '|' (type psv), the --delim parameter can be used to specify a delimiter`,
+In create, update, and replace scenarios the file's extension is used to infer the type of the file.  If a file does not have the expected extension then the {{.EmphasisLeft}}--file-type{{.EmphasisRight}} parameter should be used to explicitly define the format of the file in one of the supported formats (csv, psv, json, jsonl, xlsx).  For files separated by a delimiter other than a ',' (type csv) or a '|' (type psv), the --delim parameter can be used to specify a delimiter`,
 
 	Synopsis: []string{
 		"-c [-f] [--pk {{.LessThan}}field{{.GreaterThan}}] [--all-text] [--schema {{.LessThan}}file{{.GreaterThan}}] [--map {{.LessThan}}file{{.GreaterThan}}] [--continue]  [--quiet] [--disable-fk-checks] [--file-type {{.LessThan}}type{{.GreaterThan}}] {{.LessThan}}table{{.GreaterThan}} {{.LessThan}}file{{.GreaterThan}}",
@@ -224,7 +231,7 @@ func getImportMoveOptions(ctx context.Context, apr *argparser.ArgParseResults, d
 		if val.Format == mvdata.XlsxFile {
 			// table name must match sheet name currently
 			srcOpts = mvdata.XlsxOptions{SheetName: tableName}
-		} else if val.Format == mvdata.JsonFile {
+		} else if val.Format == mvdata.JsonFile || val.Format == mvdata.JsonlFile {
 			srcOpts = mvdata.JSONOptions{TableName: tableName, SchFile: schemaFile}
 		} else if val.Format == mvdata.ParquetFile {
 			srcOpts = mvdata.ParquetOptions{TableName: tableName, SchFile: schemaFile}
@@ -238,6 +245,8 @@ func getImportMoveOptions(ctx context.Context, apr *argparser.ArgParseResults, d
 
 		if hasDelim {
 			srcOpts = mvdata.CsvOptions{Delim: delim}
+		} else if val.Format == mvdata.JsonlFile {
+			srcOpts = mvdata.JSONOptions{TableName: tableName, SchFile: schemaFile}
 		}
 	}
 
@@ -338,14 +347,22 @@ func validateImportArgs(apr *argparser.ArgParseResults) errhand.VerboseError {
 		}
 	}
 
+	_, hasSchema := apr.GetValue(schemaParam)
+	if srcStreamLoc, isStream := srcLoc.(mvdata.StreamDataLocation); isStream {
+		if srcStreamLoc.Format == mvdata.JsonlFile && apr.Contains(createParam) && !hasSchema {
+			return errhand.BuildDError("Please specify schema file for .jsonl tables.").Build()
+		}
+	}
+
 	if srcFileLoc, isFileType := srcLoc.(mvdata.FileDataLocation); isFileType {
 		if srcFileLoc.Format == mvdata.SqlFile {
 			return errhand.BuildDError("For SQL import, please pipe SQL input files to `dolt sql`").Build()
 		}
 
-		_, hasSchema := apr.GetValue(schemaParam)
 		if srcFileLoc.Format == mvdata.JsonFile && apr.Contains(createParam) && !hasSchema {
 			return errhand.BuildDError("Please specify schema file for .json tables.").Build()
+		} else if srcFileLoc.Format == mvdata.JsonlFile && apr.Contains(createParam) && !hasSchema {
+			return errhand.BuildDError("Please specify schema file for .jsonl tables.").Build()
 		} else if srcFileLoc.Format == mvdata.ParquetFile && apr.Contains(createParam) && !hasSchema {
 			return errhand.BuildDError("Please specify schema file for .parquet tables.").Build()
 		}
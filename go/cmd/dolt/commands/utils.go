@@ -587,12 +587,23 @@ func PrintCommitInfo(pager *outputpager.Pager, minParents int, showParents, show
 
 	pager.Writer.Write([]byte(fmt.Sprintf("\nAuthor: %s <%s>", comm.commitMeta.Name, comm.commitMeta.Email)))
 
+	// The committer is only shown when the commit was created by someone other than its author
+	if comm.commitMeta.HasDistinctCommitter() {
+		committerName, committerEmail := comm.commitMeta.Committer()
+		pager.Writer.Write([]byte(fmt.Sprintf("\nCommitter: %s <%s>", committerName, committerEmail)))
+	}
+
 	timeStr := comm.commitMeta.FormatTS()
 	pager.Writer.Write([]byte(fmt.Sprintf("\nDate:  %s", timeStr)))
 
 	formattedDesc := "\n\n\t" + strings.Replace(comm.commitMeta.Description, "\n", "\n\t", -1) + "\n\n"
 	pager.Writer.Write([]byte(fmt.Sprintf("%s", formattedDesc)))
 
+	if len(comm.commitMeta.Trailers) > 0 {
+		formattedTrailers := "\t" + strings.Replace(datas.FormatTrailers(comm.commitMeta.Trailers), "\n", "\n\t", -1) + "\n\n"
+		pager.Writer.Write([]byte(formattedTrailers))
+	}
+
 }
 
 // printRefs prints the refs associated with the commit in the formatting used by log and show.
@@ -660,12 +671,12 @@ func getCommitInfoWithOptions(queryist cli.Queryist, sqlCtx *sql.Context, ref st
 
 	var q string
 	if opts.showSignature {
-		q, err = dbr.InterpolateForDialect("select * from dolt_log(?, '--parents', '--decorate=full', '--show-signature')", []interface{}{ref}, dialect.MySQL)
+		q, err = dbr.InterpolateForDialect("select commit_hash, committer, email, date, message, parents, committer_name, committer_email, committer_date, trailers, signature from dolt_log(?, '--parents', '--show-signature')", []interface{}{ref}, dialect.MySQL)
 		if err != nil {
 			return nil, fmt.Errorf("error interpolating query: %v", err)
 		}
 	} else {
-		q, err = dbr.InterpolateForDialect("select * from dolt_log(?, '--parents', '--decorate=full')", []interface{}{ref}, dialect.MySQL)
+		q, err = dbr.InterpolateForDialect("select commit_hash, committer, email, date, message, parents, committer_name, committer_email, committer_date, trailers from dolt_log(?, '--parents')", []interface{}{ref}, dialect.MySQL)
 		if err != nil {
 			return nil, fmt.Errorf("error interpolating query: %v", err)
 		}
@@ -690,13 +701,23 @@ func getCommitInfoWithOptions(queryist cli.Queryist, sqlCtx *sql.Context, ref st
 	}
 	message := row[4].(string)
 	parent := row[5].(string)
+	committerName := row[6].(string)
+	committerEmail := row[7].(string)
+	committerTimestamp, err := getTimestampColAsUint64(row[8])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing timestamp '%s': %v", row[8], err)
+	}
+	trailers, err := datas.ParseTrailers(row[9].(string))
+	if err != nil {
+		return nil, err
+	}
 	height := uint64(len(rows))
 
 	isHead := commitHash == hashOfHead
 
 	var signature string
-	if len(row) > 7 {
-		signature = row[7].(string)
+	if len(row) > 10 {
+		signature = row[10].(string)
 	}
 
 	localBranchesForHash, err := getBranchesForHash(queryist, sqlCtx, commitHash, true)
@@ -714,12 +735,15 @@ func getCommitInfoWithOptions(queryist cli.Queryist, sqlCtx *sql.Context, ref st
 
 	ci := &CommitInfo{
 		commitMeta: &datas.CommitMeta{
-			Name:          name,
-			Email:         email,
-			Timestamp:     timestamp,
-			Description:   message,
-			UserTimestamp: int64(timestamp),
-			Signature:     signature,
+			Name:           name,
+			Email:          email,
+			Timestamp:      committerTimestamp,
+			Description:    message,
+			UserTimestamp:  int64(timestamp),
+			Signature:      signature,
+			CommitterName:  committerName,
+			CommitterEmail: committerEmail,
+			Trailers:       trailers,
 		},
 		commitHash:        commitHash,
 		height:            height,
@@ -43,6 +43,8 @@ import (
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/admin"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands/bisectcmds"
+	"github.com/dolthub/dolt/go/cmd/dolt/commands/bundlecmds"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/ci"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/cnfcmds"
 	"github.com/dolthub/dolt/go/cmd/dolt/commands/credcmds"
@@ -90,6 +92,8 @@ var doltSubCommands = []cli.Command{
 	cnfcmds.Commands,
 	commands.CherryPickCmd{},
 	commands.RevertCmd{},
+	commands.FormatPatchCmd{},
+	commands.AmCmd{},
 	commands.CloneCmd{},
 	commands.FetchCmd{},
 	commands.PullCmd{},
@@ -126,6 +130,8 @@ var doltSubCommands = []cli.Command{
 	commands.QueryDiff{},
 	commands.ReflogCmd{},
 	commands.RebaseCmd{},
+	bisectcmds.BisectCommands,
+	bundlecmds.BundleCommands,
 	commands.ArchiveCmd{},
 	ci.Commands,
 }
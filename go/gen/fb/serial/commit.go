@@ -222,7 +222,46 @@ func (rcv *Commit) Signature() []byte {
 	return nil
 }
 
-const CommitNumFields = 10
+func (rcv *Commit) CommitterName() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(24))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *Commit) CommitterEmail() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(26))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *Commit) TryTrailers(obj *CommitTrailer, j int) (bool, error) {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(28))
+	if o != 0 {
+		x := rcv._tab.Vector(o)
+		x += flatbuffers.UOffsetT(j) * 4
+		x = rcv._tab.Indirect(x)
+		obj.Init(rcv._tab.Bytes, x)
+		if CommitTrailerNumFields < obj.Table().NumFields() {
+			return false, flatbuffers.ErrTableHasUnknownFields
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (rcv *Commit) TrailersLength() int {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(28))
+	if o != 0 {
+		return rcv._tab.VectorLen(o)
+	}
+	return 0
+}
+
+const CommitNumFields = 13
 
 func CommitStart(builder *flatbuffers.Builder) {
 	builder.StartObject(CommitNumFields)
@@ -266,6 +305,81 @@ func CommitAddUserTimestampMillis(builder *flatbuffers.Builder, userTimestampMil
 func CommitAddSignature(builder *flatbuffers.Builder, signature flatbuffers.UOffsetT) {
 	builder.PrependUOffsetTSlot(9, flatbuffers.UOffsetT(signature), 0)
 }
+func CommitAddCommitterName(builder *flatbuffers.Builder, committerName flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(10, flatbuffers.UOffsetT(committerName), 0)
+}
+func CommitAddCommitterEmail(builder *flatbuffers.Builder, committerEmail flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(11, flatbuffers.UOffsetT(committerEmail), 0)
+}
+func CommitAddTrailers(builder *flatbuffers.Builder, trailers flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(12, flatbuffers.UOffsetT(trailers), 0)
+}
+func CommitStartTrailersVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
+	return builder.StartVector(4, numElems, 4)
+}
 func CommitEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
+
+type CommitTrailer struct {
+	_tab flatbuffers.Table
+}
+
+func InitCommitTrailerRoot(o *CommitTrailer, buf []byte, offset flatbuffers.UOffsetT) error {
+	n := flatbuffers.GetUOffsetT(buf[offset:])
+	return o.Init(buf, n+offset)
+}
+
+func TryGetRootAsCommitTrailer(buf []byte, offset flatbuffers.UOffsetT) (*CommitTrailer, error) {
+	x := &CommitTrailer{}
+	return x, InitCommitTrailerRoot(x, buf, offset)
+}
+
+func TryGetSizePrefixedRootAsCommitTrailer(buf []byte, offset flatbuffers.UOffsetT) (*CommitTrailer, error) {
+	x := &CommitTrailer{}
+	return x, InitCommitTrailerRoot(x, buf, offset+flatbuffers.SizeUint32)
+}
+
+func (rcv *CommitTrailer) Init(buf []byte, i flatbuffers.UOffsetT) error {
+	rcv._tab.Bytes = buf
+	rcv._tab.Pos = i
+	if CommitTrailerNumFields < rcv.Table().NumFields() {
+		return flatbuffers.ErrTableHasUnknownFields
+	}
+	return nil
+}
+
+func (rcv *CommitTrailer) Table() flatbuffers.Table {
+	return rcv._tab
+}
+
+func (rcv *CommitTrailer) Key() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(4))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+func (rcv *CommitTrailer) Value() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(6))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+const CommitTrailerNumFields = 2
+
+func CommitTrailerStart(builder *flatbuffers.Builder) {
+	builder.StartObject(CommitTrailerNumFields)
+}
+func CommitTrailerAddKey(builder *flatbuffers.Builder, key flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(key), 0)
+}
+func CommitTrailerAddValue(builder *flatbuffers.Builder, value flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(1, flatbuffers.UOffsetT(value), 0)
+}
+func CommitTrailerEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
+	return builder.EndObject()
+}
@@ -153,7 +153,15 @@ func (rcv *Stash) TablesToStageLength() int {
 	return 0
 }
 
-const StashNumFields = 5
+func (rcv *Stash) Message() []byte {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(14))
+	if o != 0 {
+		return rcv._tab.ByteVector(o + rcv._tab.Pos)
+	}
+	return nil
+}
+
+const StashNumFields = 6
 
 func StashStart(builder *flatbuffers.Builder) {
 	builder.StartObject(StashNumFields)
@@ -182,6 +190,9 @@ func StashAddTablesToStage(builder *flatbuffers.Builder, tablesToStage flatbuffe
 func StashStartTablesToStageVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT {
 	return builder.StartVector(4, numElems, 4)
 }
+func StashAddMessage(builder *flatbuffers.Builder, message flatbuffers.UOffsetT) {
+	builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(message), 0)
+}
 func StashEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT {
 	return builder.EndObject()
 }
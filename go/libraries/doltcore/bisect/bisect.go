@@ -0,0 +1,235 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisect
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"os"
+	"path/filepath"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/commitwalk"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const (
+	// BranchName is the name of the temporary branch that is moved to each commit that needs to be tested.
+	BranchName = "dolt_bisect"
+
+	stateFile = "bisect_state.json"
+)
+
+// ErrNotBisecting is returned when a bisect command other than start is used without a bisect in progress.
+var ErrNotBisecting = errors.New("error: no bisect in progress, use 'dolt bisect start' to start one")
+
+// ErrBisectInProgress is returned when starting a bisect while another one is in progress.
+var ErrBisectInProgress = errors.New("error: a bisect is already in progress, use 'dolt bisect reset' to end it")
+
+// State is the state of a bisect session, which is saved in the .dolt directory between commands. Commits are
+// recorded by their hash strings.
+type State struct {
+	// StartBranch is the branch that was checked out when the bisect was started, and is checked out again on reset.
+	StartBranch string `json:"start_branch"`
+	// Bad is the newest commit known to be bad, or empty if no bad commit has been given yet.
+	Bad string `json:"bad"`
+	// Good holds every commit marked as good.
+	Good []string `json:"good"`
+	// Skip holds every commit marked as untestable.
+	Skip []string `json:"skip"`
+}
+
+func getStateFile() string {
+	return filepath.Join(dbfactory.DoltDir, stateFile)
+}
+
+// InProgress returns whether there is a bisect in progress in the repository on |fs|.
+func InProgress(fs filesys.ReadableFS) bool {
+	exists, _ := fs.Exists(getStateFile())
+	return exists
+}
+
+// LoadState loads the state of the bisect in progress in the repository on |fs|, and returns ErrNotBisecting if
+// there isn't one.
+func LoadState(fs filesys.ReadableFS) (*State, error) {
+	if !InProgress(fs) {
+		return nil, ErrNotBisecting
+	}
+
+	data, err := fs.ReadFile(getStateFile())
+	if err != nil {
+		return nil, err
+	}
+
+	var state State
+	err = json.Unmarshal(data, &state)
+	if err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
+
+// Save writes this bisect state to the repository on |fs|.
+func (s *State) Save(fs filesys.WritableFS) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(getStateFile(), data, os.ModePerm)
+}
+
+// RemoveState deletes the bisect state from the repository on |fs|, ending the bisect.
+func RemoveState(fs filesys.WritableFS) error {
+	return fs.DeleteFile(getStateFile())
+}
+
+// Step is the result of narrowing down a bisect.
+type Step struct {
+	// Next is the commit that should be tested next. It is empty when the bisect is finished.
+	Next hash.Hash
+	// Remaining is the number of revisions that are left to test after Next, whether it turns out good or bad.
+	Remaining int
+	// Steps is a rough estimate of how many more commits need to be tested after Next.
+	Steps int
+	// FirstBad is the first bad commit, set once it has been found.
+	FirstBad hash.Hash
+	// Candidates holds the commits that could be the first bad commit when only skipped commits are left to test.
+	Candidates []hash.Hash
+}
+
+// Done returns whether there is nothing left to test.
+func (s *Step) Done() bool {
+	return s.Next.IsEmpty()
+}
+
+// NextStep returns the next commit to test given the commits marked in |state|, which must have both a bad commit
+// and at least one good commit. The candidates for the first bad commit are the commits reachable from the bad commit
+// but not from any good commit, and the commit picked to be tested next is the one that splits those candidates most
+// evenly.
+func NextStep(ctx context.Context, ddb *doltdb.DoltDB, state *State) (*Step, error) {
+	bad, ok := hash.MaybeParse(state.Bad)
+	if !ok {
+		return nil, fmt.Errorf("invalid bad commit hash %s", state.Bad)
+	}
+	goods, err := parseHashes(state.Good)
+	if err != nil {
+		return nil, err
+	}
+	skips, err := parseHashes(state.Skip)
+	if err != nil {
+		return nil, err
+	}
+
+	// parents of each candidate that are also candidates, in topological order starting with |bad|
+	var candidates []hash.Hash
+	parents := make(map[hash.Hash][]hash.Hash)
+	iter, err := commitwalk.GetDotDotRevisionsIterator(ctx, ddb, []hash.Hash{bad}, ddb, goods, nil)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		h, optCmt, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		cmt, ok := optCmt.ToCommit()
+		if !ok {
+			return nil, doltdb.ErrGhostCommitEncountered
+		}
+		parentHashes, err := cmt.ParentHashes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, h)
+		parents[h] = parentHashes
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("error: the bad commit %s is an ancestor of a good commit", bad.String())
+	}
+
+	skipped := make(map[hash.Hash]bool, len(skips))
+	for _, h := range skips {
+		skipped[h] = true
+	}
+
+	var best hash.Hash
+	bestScore, bestAncestors := -1, 0
+	for _, h := range candidates {
+		if h == bad || skipped[h] {
+			continue
+		}
+		n := countAncestors(h, parents)
+		score := min(n, len(candidates)-n)
+		if score > bestScore {
+			best, bestScore, bestAncestors = h, score, n
+		}
+	}
+
+	if best.IsEmpty() {
+		step := &Step{FirstBad: bad}
+		if len(candidates) > 1 {
+			// everything left to test was skipped, so any of the remaining candidates could be the first bad commit
+			step.FirstBad = hash.Hash{}
+			step.Candidates = candidates
+		}
+		return step, nil
+	}
+
+	// if |best| is bad its ancestors are left to test, otherwise the rest of the candidates other than |bad| are
+	remaining := max(bestAncestors-1, len(candidates)-bestAncestors-1)
+	return &Step{
+		Next:      best,
+		Remaining: remaining,
+		Steps:     bits.Len(uint(remaining)),
+	}, nil
+}
+
+// countAncestors returns the number of commits reachable from |h| in |parents|, including |h| itself.
+func countAncestors(h hash.Hash, parents map[hash.Hash][]hash.Hash) int {
+	seen := map[hash.Hash]bool{h: true}
+	stack := []hash.Hash{h}
+	for len(stack) > 0 {
+		curr := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, p := range parents[curr] {
+			if _, ok := parents[p]; ok && !seen[p] {
+				seen[p] = true
+				stack = append(stack, p)
+			}
+		}
+	}
+	return len(seen)
+}
+
+func parseHashes(strs []string) ([]hash.Hash, error) {
+	hashes := make([]hash.Hash, len(strs))
+	for i, s := range strs {
+		h, ok := hash.MaybeParse(s)
+		if !ok {
+			return nil, fmt.Errorf("invalid commit hash %s", s)
+		}
+		hashes[i] = h
+	}
+	return hashes, nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bisect
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+const (
+	testHomeDir = "/doesnotexist/home"
+	workingDir  = "/doesnotexist/work"
+)
+
+func createTestEnv(t *testing.T) *env.DoltEnv {
+	fs := filesys.NewInMemFS([]string{testHomeDir, workingDir}, nil, workingDir)
+	dEnv := env.Load(context.Background(), func() (string, error) { return testHomeDir, nil }, fs, doltdb.InMemDoltDB, "test")
+	err := dEnv.InitRepo(context.Background(), types.Format_Default, "Bill Billerson", "bill@billerson.com", env.DefaultInitBranch)
+	require.NoError(t, err)
+	return dEnv
+}
+
+// createLinearHistory adds |n| commits on top of the initial commit on main and returns the hashes of all the commits
+// on main, oldest first.
+func createLinearHistory(t *testing.T, ddb *doltdb.DoltDB, n int) []hash.Hash {
+	ctx := context.Background()
+	cs, err := doltdb.NewCommitSpec(env.DefaultInitBranch)
+	require.NoError(t, err)
+	opt, err := ddb.Resolve(ctx, cs, nil)
+	require.NoError(t, err)
+	commit, ok := opt.ToCommit()
+	require.True(t, ok)
+	rv, err := commit.GetRootValue(ctx)
+	require.NoError(t, err)
+	_, rvh, err := ddb.WriteRootValue(ctx, rv)
+	require.NoError(t, err)
+
+	head, err := commit.HashOf()
+	require.NoError(t, err)
+	hashes := []hash.Hash{head}
+	ts := time.Now()
+	for i := 0; i < n; i++ {
+		ts = ts.Add(time.Second)
+		cm, err := datas.NewCommitMetaWithUserTS("Bill Billerson", "bill@billerson.com", "A New Commit.", ts)
+		require.NoError(t, err)
+		parent, err := doltdb.NewCommitSpec(head.String())
+		require.NoError(t, err)
+		commit, err = ddb.CommitWithParentSpecs(ctx, rvh, ref.NewBranchRef(env.DefaultInitBranch), []*doltdb.CommitSpec{parent}, cm)
+		require.NoError(t, err)
+		head, err = commit.HashOf()
+		require.NoError(t, err)
+		hashes = append(hashes, head)
+	}
+	return hashes
+}
+
+func TestNextStep(t *testing.T) {
+	ctx := context.Background()
+	dEnv := createTestEnv(t)
+	commits := createLinearHistory(t, dEnv.DoltDB, 8)
+
+	// commits[5] is the first bad commit; bisect the way a user would, starting from commits[0]..commits[8]
+	firstBad := 5
+	state := &State{Bad: commits[8].String(), Good: []string{commits[0].String()}}
+	tested := 0
+	for {
+		step, err := NextStep(ctx, dEnv.DoltDB, state)
+		require.NoError(t, err)
+		if step.Done() {
+			assert.Equal(t, commits[firstBad], step.FirstBad)
+			break
+		}
+
+		tested++
+		require.LessOrEqual(t, tested, 4)
+		idx := -1
+		for i, h := range commits {
+			if h == step.Next {
+				idx = i
+			}
+		}
+		require.True(t, idx > 0 && idx < 8, "commit to test should be between the good and bad commits")
+		if idx >= firstBad {
+			state.Bad = step.Next.String()
+		} else {
+			state.Good = append(state.Good, step.Next.String())
+		}
+	}
+	assert.Equal(t, 3, tested)
+
+	t.Run("midpoint", func(t *testing.T) {
+		step, err := NextStep(ctx, dEnv.DoltDB, &State{Bad: commits[8].String(), Good: []string{commits[0].String()}})
+		require.NoError(t, err)
+		assert.Equal(t, commits[4], step.Next)
+		assert.Equal(t, 3, step.Remaining)
+		assert.Equal(t, 2, step.Steps)
+	})
+
+	t.Run("only skipped commits left", func(t *testing.T) {
+		state := &State{
+			Bad:  commits[3].String(),
+			Good: []string{commits[0].String()},
+			Skip: []string{commits[1].String(), commits[2].String()},
+		}
+		step, err := NextStep(ctx, dEnv.DoltDB, state)
+		require.NoError(t, err)
+		assert.True(t, step.Done())
+		assert.True(t, step.FirstBad.IsEmpty())
+		assert.ElementsMatch(t, commits[1:4], step.Candidates)
+	})
+
+	t.Run("skipped commits are not tested", func(t *testing.T) {
+		state := &State{
+			Bad:  commits[8].String(),
+			Good: []string{commits[0].String()},
+			Skip: []string{commits[4].String()},
+		}
+		step, err := NextStep(ctx, dEnv.DoltDB, state)
+		require.NoError(t, err)
+		assert.False(t, step.Done())
+		assert.NotEqual(t, commits[4], step.Next)
+	})
+
+	t.Run("bad commit is an ancestor of a good commit", func(t *testing.T) {
+		_, err := NextStep(ctx, dEnv.DoltDB, &State{Bad: commits[2].String(), Good: []string{commits[5].String()}})
+		require.Error(t, err)
+	})
+}
+
+func TestState(t *testing.T) {
+	dEnv := createTestEnv(t)
+	assert.False(t, InProgress(dEnv.FS))
+	_, err := LoadState(dEnv.FS)
+	assert.ErrorIs(t, err, ErrNotBisecting)
+
+	state := &State{StartBranch: "main", Bad: "bad", Good: []string{"good"}}
+	require.NoError(t, state.Save(dEnv.FS))
+	assert.True(t, InProgress(dEnv.FS))
+	loaded, err := LoadState(dEnv.FS)
+	require.NoError(t, err)
+	assert.Equal(t, state, loaded)
+
+	require.NoError(t, RemoveState(dEnv.FS))
+	assert.False(t, InProgress(dEnv.FS))
+}
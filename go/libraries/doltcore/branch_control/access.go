@@ -29,15 +29,17 @@ import (
 type Permissions uint64
 
 const (
-	Permissions_Admin Permissions = 1 << iota // Permissions_Admin grants unrestricted control over a branch, including modification of table entries
-	Permissions_Write                         // Permissions_Write allows for all modifying operations on a branch, but does not allow modification of table entries
-	Permissions_Read                          // Permissions_Read allows for reading from a branch, which is equivalent to having no permissions
+	Permissions_Admin     Permissions = 1 << iota // Permissions_Admin grants unrestricted control over a branch, including modification of table entries
+	Permissions_Write                             // Permissions_Write allows for all modifying operations on a branch, but does not allow modification of table entries
+	Permissions_Read                              // Permissions_Read allows for reading from a branch, which is only restricted when read enforcement is enabled
+	Permissions_Protected                         // Permissions_Protected prevents pushes from deleting a branch or updating it with anything other than a fast-forward
 
-	Permissions_None Permissions = 0 // Permissions_None represents a lack of permissions, which defaults to allowing reading
+	Permissions_None Permissions = 0 // Permissions_None represents a lack of permissions, which allows reading unless read enforcement is enabled
 )
 
 // Access contains all of the expressions that comprise the "dolt_branch_control" table, which handles write Access to
-// branches, along with write access to the branch control system tables.
+// branches, along with write access to the branch control system tables. When read enforcement is enabled, it also
+// handles read access to branches, with any matching entry granting the ability to read.
 type Access struct {
 	Root     *MatchNode
 	RWMutex  *sync.RWMutex
@@ -86,6 +88,19 @@ func (tbl *Access) Match(database string, branch string, user string, host strin
 	return len(results) > 0, perms
 }
 
+// MatchProtected returns whether any entry matching the given database, branch, user, and host protects the branch.
+// Unlike Match, this considers every matching entry rather than only the most specific ones, so that a broader entry
+// that protects a branch cannot be bypassed by a more specific entry that only grants write access. Requires external
+// synchronization handling, therefore manually manage the RWMutex.
+func (tbl *Access) MatchProtected(database string, branch string, user string, host string) bool {
+	for _, result := range tbl.Root.Match(database, branch, user, host) {
+		if result.Permissions&Permissions_Protected == Permissions_Protected {
+			return true
+		}
+	}
+	return false
+}
+
 // GetBinlog returns the table's binlog.
 func (tbl *Access) GetBinlog() *Binlog {
 	return tbl.binlog
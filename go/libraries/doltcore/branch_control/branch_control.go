@@ -34,6 +34,9 @@ var (
 	ErrIncorrectPermissions  = errors.NewKind("`%s`@`%s` does not have the correct permissions on branch `%s`")
 	ErrCannotCreateBranch    = errors.NewKind("`%s`@`%s` cannot create a branch named `%s`")
 	ErrCannotDeleteBranch    = errors.NewKind("`%s`@`%s` cannot delete the branch `%s`")
+	ErrCannotReadBranch      = errors.NewKind("`%s`@`%s` cannot read the branch `%s`")
+	ErrCannotReadCommit      = errors.NewKind("`%s`@`%s` cannot read the commit `%s`, as it is not reachable from a readable branch")
+	ErrProtectedBranch       = errors.NewKind("`%s`@`%s` cannot force push to or delete the protected branch `%s`")
 	ErrExpressionsTooLong    = errors.NewKind("expressions are too long [%q, %q, %q, %q]")
 	ErrInsertingAccessRow    = errors.NewKind("`%s`@`%s` cannot add the row [%q, %q, %q, %q, %q]")
 	ErrInsertingNamespaceRow = errors.NewKind("`%s`@`%s` cannot add the row [%q, %q, %q, %q]")
@@ -315,6 +318,81 @@ func CanDeleteBranch(ctx context.Context, branchName string) error {
 	return ErrCannotDeleteBranch.New(user, host, branchName)
 }
 
+// CanReadBranch returns whether the given context can read from the given branch on the given database. Any matching
+// entry in the access table grants read access, regardless of its permissions. Users that may modify the branch control
+// tables for the database are always allowed to read, so that they cannot lock themselves out of the tables that would
+// let them restore their access. Whether reads are restricted at all is decided by the caller. A context without a
+// session is always allowed to read.
+func CanReadBranch(ctx context.Context, database string, branch string) error {
+	branchAwareSession := GetBranchAwareSession(ctx)
+	// A nil session means we're not in the SQL context, so we allow the read operation
+	if branchAwareSession == nil {
+		return nil
+	}
+	controller := branchAwareSession.GetController()
+	// Any context that has a non-nil session should always have a non-nil controller, so this is an error
+	if controller == nil {
+		return ErrMissingController.New()
+	}
+	database = getDatabaseNameOnly(database)
+	if HasDatabasePrivileges(branchAwareSession, database) {
+		return nil
+	}
+	controller.Access.RWMutex.RLock()
+	defer controller.Access.RWMutex.RUnlock()
+
+	user := branchAwareSession.GetUser()
+	host := branchAwareSession.GetHost()
+	// Any matching row grants read access, as every permission implies the ability to read
+	if matched, _ := controller.Access.Match(database, branch, user, host); matched {
+		return nil
+	}
+	return ErrCannotReadBranch.New(user, host, branch)
+}
+
+// CanPushBranch returns whether the given context can update the given branch on the given database as part of a push.
+// The user must be able to write to the branch, and must be able to create it if it does not yet exist. Branches that
+// are protected may only be fast-forwarded, meaning that |isFastForward| must be true. Deleting a branch is never a
+// fast-forward. A branch is protected when any matching entry protects it. A context without a session is always
+// allowed to push.
+func CanPushBranch(ctx context.Context, database string, branch string, isNew bool, isFastForward bool) error {
+	branchAwareSession := GetBranchAwareSession(ctx)
+	// A nil session means we're not in the SQL context, so we allow the push
+	if branchAwareSession == nil {
+		return nil
+	}
+	controller := branchAwareSession.GetController()
+	// Any context that has a non-nil session should always have a non-nil controller, so this is an error
+	if controller == nil {
+		return ErrMissingController.New()
+	}
+	database = getDatabaseNameOnly(database)
+	user := branchAwareSession.GetUser()
+	host := branchAwareSession.GetHost()
+
+	if isNew {
+		controller.Namespace.RWMutex.RLock()
+		canCreate := controller.Namespace.CanCreate(database, branch, user, host)
+		controller.Namespace.RWMutex.RUnlock()
+		if !canCreate {
+			return ErrCannotCreateBranch.New(user, host, branch)
+		}
+	}
+
+	controller.Access.RWMutex.RLock()
+	defer controller.Access.RWMutex.RUnlock()
+	// Get the permissions for the branch, user, and host combination
+	_, perms := controller.Access.Match(database, branch, user, host)
+	if perms&(Permissions_Admin|Permissions_Write) == 0 {
+		return ErrIncorrectPermissions.New(user, host, branch)
+	}
+	// Protection applies when any matching entry sets it, even if a more specific entry decides the permissions above
+	if !isFastForward && controller.Access.MatchProtected(database, branch, user, host) {
+		return ErrProtectedBranch.New(user, host, branch)
+	}
+	return nil
+}
+
 // AddAdminForContext adds an entry in the access table for the user represented by the given context. If the
 // context is missing some functionality that is needed to perform the addition, such as a user or the Controller, then
 // this simply returns.
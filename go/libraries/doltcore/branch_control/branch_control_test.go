@@ -0,0 +1,67 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package branch_control
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+)
+
+// testPushContext is a minimal branch aware context for a user without any SQL privileges.
+type testPushContext struct {
+	context.Context
+	controller *Controller
+	user       string
+	host       string
+}
+
+var _ Context = testPushContext{}
+
+func (c testPushContext) GetBranch() (string, error)                  { return "main", nil }
+func (c testPushContext) GetCurrentDatabase() string                  { return "mydb" }
+func (c testPushContext) GetUser() string                             { return c.user }
+func (c testPushContext) GetHost() string                             { return c.host }
+func (c testPushContext) GetPrivilegeSet() (sql.PrivilegeSet, uint64) { return nil, 0 }
+func (c testPushContext) GetController() *Controller                  { return c.controller }
+func (c testPushContext) GetFileSystem() filesys.Filesys              { return nil }
+
+func TestCanPushBranchProtectedByBroaderRow(t *testing.T) {
+	ctx := context.Background()
+	controller := CreateDefaultController(ctx)
+	require.NotNil(t, controller)
+	controller.Access.Insert("mydb", "main", "%", "%", Permissions_Write|Permissions_Protected)
+	controller.Access.Insert("mydb", "main", "alice", "localhost", Permissions_Write)
+
+	alice := testPushContext{Context: ctx, controller: controller, user: "alice", host: "localhost"}
+	bob := testPushContext{Context: ctx, controller: controller, user: "bob", host: "localhost"}
+
+	// The more specific row decides alice's permissions, but the broader row still protects the branch
+	_, perms := controller.Access.Match("mydb", "main", "alice", "localhost")
+	assert.Equal(t, Permissions_Write, perms)
+
+	err := CanPushBranch(alice, "mydb", "main", false, false)
+	assert.True(t, ErrProtectedBranch.Is(err), "expected a protected branch error, got %v", err)
+	err = CanPushBranch(bob, "mydb", "main", false, false)
+	assert.True(t, ErrProtectedBranch.Is(err), "expected a protected branch error, got %v", err)
+
+	assert.NoError(t, CanPushBranch(alice, "mydb", "main", false, true))
+	assert.NoError(t, CanPushBranch(alice, "mydb", "other", false, false))
+}
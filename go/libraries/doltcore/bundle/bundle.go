@@ -0,0 +1,452 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle implements bundle files, which move commits between databases without a remote. A bundle is a tar
+// archive holding a JSON header followed by the table files of a database containing the bundled refs. An
+// incremental bundle only holds the chunks which are not reachable from its prerequisite commits, which the
+// receiving database must already have.
+package bundle
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/utils/earl"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/chunks"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+const (
+	// Version is the version of the bundle format written by Create.
+	Version = 1
+
+	headerFileName = "bundle.json"
+	tableFilesDir  = "tables/"
+)
+
+// ErrNotABundle is returned when opening a file which is not a bundle.
+var ErrNotABundle = errors.New("not a dolt bundle")
+
+// ErrMissingPrerequisites is returned when a database lacks the prerequisite commits of an incremental bundle.
+var ErrMissingPrerequisites = errors.New("database is missing the prerequisite commits of the bundle")
+
+// Header describes the contents of a bundle. It is the first entry of the bundle archive.
+type Header struct {
+	// Version is the version of the bundle format.
+	Version int `json:"version"`
+	// Format is the storage format version of the bundled database.
+	Format string `json:"format"`
+	// Root is the root hash of the bundled database.
+	Root string `json:"root"`
+	// Refs are the refs included in the bundle, with the hashes they point to.
+	Refs []Ref `json:"refs"`
+	// Prerequisites are the commits the receiving database must already have to use an incremental bundle.
+	Prerequisites []string `json:"prerequisites,omitempty"`
+	// TableFiles are the table files included in the bundle.
+	TableFiles []TableFile `json:"table_files"`
+}
+
+// Ref is a ref included in a bundle.
+type Ref struct {
+	Ref  string `json:"ref"`
+	Hash string `json:"hash"`
+}
+
+// TableFile is a table file included in a bundle.
+type TableFile struct {
+	ID        string `json:"id"`
+	NumChunks int    `json:"num_chunks"`
+}
+
+// Create writes a bundle of |refs| of |ddb| to the file at |path|. If |prerequisites| are given, the bundle is
+// incremental and only holds the chunks which are not reachable from them. |tempDir| is used for the intermediate
+// database the bundle is built from.
+func Create(ctx context.Context, ddb *doltdb.DoltDB, tempDir, path string, refs []doltdb.RefWithHash, prerequisites []hash.Hash) (*Header, error) {
+	if len(refs) == 0 {
+		return nil, errors.New("refusing to create an empty bundle")
+	}
+
+	st, err := newTempStore(ctx, ddb.Format(), tempDir)
+	if err != nil {
+		return nil, err
+	}
+	defer st.close()
+
+	// Pulling the prerequisites first means the table files written by the pull of |refs| only hold new chunks.
+	known := make(map[string]struct{})
+	if len(prerequisites) > 0 {
+		err = st.ddb.PullChunks(ctx, tempDir, ddb, prerequisites, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		_, tableFiles, _, err := st.tfs.Sources(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, tf := range tableFiles {
+			known[tf.FileID()] = struct{}{}
+		}
+	}
+
+	heads := make([]hash.Hash, len(refs))
+	for i, r := range refs {
+		heads[i] = r.Hash
+	}
+	err = st.ddb.PullChunks(ctx, tempDir, ddb, heads, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range refs {
+		err = st.ddb.SetHead(ctx, r.Ref, r.Hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	root, tableFiles, _, err := st.tfs.Sources(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &Header{
+		Version: Version,
+		Format:  ddb.Format().VersionString(),
+		Root:    root.String(),
+	}
+	for _, r := range refs {
+		header.Refs = append(header.Refs, Ref{Ref: r.Ref.String(), Hash: r.Hash.String()})
+	}
+	for _, p := range prerequisites {
+		header.Prerequisites = append(header.Prerequisites, p.String())
+	}
+	var toWrite []chunks.TableFile
+	for _, tf := range tableFiles {
+		if _, ok := known[tf.FileID()]; ok || tf.NumChunks() == 0 {
+			continue
+		}
+		toWrite = append(toWrite, tf)
+		header.TableFiles = append(header.TableFiles, TableFile{ID: tf.FileID(), NumChunks: tf.NumChunks()})
+	}
+
+	err = writeArchive(ctx, path, header, toWrite)
+	if err != nil {
+		_ = os.Remove(path)
+		return nil, err
+	}
+	return header, nil
+}
+
+func writeArchive(ctx context.Context, path string, header *Header, tableFiles []chunks.TableFile) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		cerr := f.Close()
+		if err == nil {
+			err = cerr
+		}
+	}()
+
+	tw := tar.NewWriter(f)
+	data, err := json.MarshalIndent(header, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = tw.WriteHeader(&tar.Header{Name: headerFileName, Mode: 0644, Size: int64(len(data))})
+	if err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	if err != nil {
+		return err
+	}
+
+	for _, tf := range tableFiles {
+		err = func() error {
+			rd, sz, err := tf.Open(ctx)
+			if err != nil {
+				return err
+			}
+			defer rd.Close()
+
+			err = tw.WriteHeader(&tar.Header{Name: tableFilesDir + tf.FileID(), Mode: 0644, Size: int64(sz)})
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(tw, rd)
+			return err
+		}()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tw.Close()
+}
+
+// ReadHeader reads the header of the bundle at |path|, returning ErrNotABundle if the file is not a bundle.
+func ReadHeader(path string) (*Header, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readHeader(tar.NewReader(f))
+}
+
+func readHeader(tr *tar.Reader) (*Header, error) {
+	th, err := tr.Next()
+	if err != nil || th.Name != headerFileName {
+		return nil, ErrNotABundle
+	}
+
+	var header Header
+	err = json.NewDecoder(tr).Decode(&header)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotABundle, err.Error())
+	}
+	if header.Version != Version {
+		return nil, fmt.Errorf("unsupported bundle version %d", header.Version)
+	}
+	return &header, nil
+}
+
+// IsBundle returns whether there is a bundle file at |path|.
+func IsBundle(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return false
+	}
+	_, err = ReadHeader(path)
+	return err == nil
+}
+
+// MissingPrerequisites returns the prerequisite commits of the bundle which are missing from |ddb|.
+func (h *Header) MissingPrerequisites(ctx context.Context, ddb *doltdb.DoltDB) ([]hash.Hash, error) {
+	var missing []hash.Hash
+	for _, p := range h.Prerequisites {
+		addr, ok := hash.MaybeParse(p)
+		if !ok {
+			return nil, fmt.Errorf("invalid prerequisite commit in bundle: %s", p)
+		}
+		has, err := ddb.Has(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+		if !has {
+			missing = append(missing, addr)
+		}
+	}
+	return missing, nil
+}
+
+// CheckPrerequisites returns an error listing the prerequisite commits of the bundle which are missing from |ddb|,
+// or nil if it has all of them.
+func (h *Header) CheckPrerequisites(ctx context.Context, ddb *doltdb.DoltDB) error {
+	missing, err := h.MissingPrerequisites(ctx, ddb)
+	if err != nil {
+		return err
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	strs := make([]string, len(missing))
+	for i, m := range missing {
+		strs[i] = m.String()
+	}
+	return fmt.Errorf("%w: %s", ErrMissingPrerequisites, strings.Join(strs, ", "))
+}
+
+// Bundle is an opened bundle, whose contents can be read as a database.
+type Bundle struct {
+	Header *Header
+	st     *tempStore
+}
+
+// Open opens the bundle at |path|, extracting its table files to a temporary database in |tempDir|. The database
+// only holds the chunks of the bundle, so the chunks of an incremental bundle's prerequisites can't be read from it.
+// The returned Bundle must be closed to remove the temporary database.
+func Open(ctx context.Context, path, tempDir string) (*Bundle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	tr := tar.NewReader(f)
+	header, err := readHeader(tr)
+	if err != nil {
+		return nil, err
+	}
+	nbf, err := types.GetFormatForVersionString(header.Format)
+	if err != nil {
+		return nil, err
+	}
+	root, ok := hash.MaybeParse(header.Root)
+	if !ok {
+		return nil, fmt.Errorf("invalid root hash in bundle: %s", header.Root)
+	}
+
+	numChunks := make(map[string]int, len(header.TableFiles))
+	for _, tf := range header.TableFiles {
+		numChunks[tf.ID] = tf.NumChunks
+	}
+
+	st, err := newTempStore(ctx, nbf, tempDir)
+	if err != nil {
+		return nil, err
+	}
+
+	err = func() error {
+		written := make(map[string]int, len(numChunks))
+		for {
+			th, err := tr.Next()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+
+			id := strings.TrimPrefix(th.Name, tableFilesDir)
+			n, ok := numChunks[id]
+			if !ok || id == th.Name {
+				return fmt.Errorf("unexpected file in bundle: %s", th.Name)
+			}
+			err = st.tfs.WriteTableFile(ctx, id, n, nil, func() (io.ReadCloser, uint64, error) {
+				return io.NopCloser(tr), uint64(th.Size), nil
+			})
+			if err != nil {
+				return err
+			}
+			written[id] = n
+		}
+		if len(written) != len(numChunks) {
+			return errors.New("bundle is truncated, some table files are missing")
+		}
+
+		err = st.tfs.AddTableFilesToManifest(ctx, written)
+		if err != nil {
+			return err
+		}
+		return st.tfs.SetRootChunk(ctx, root, hash.Hash{})
+	}()
+	if err != nil {
+		st.close()
+		return nil, err
+	}
+
+	return &Bundle{Header: header, st: st}, nil
+}
+
+// DoltDB returns the database holding the contents of the bundle, which may be used as a read-only remote.
+func (b *Bundle) DoltDB() *doltdb.DoltDB {
+	return b.st.ddb
+}
+
+// Verify checks that the bundle can be applied to |ddb|: that |ddb| has all of its prerequisites, and that the
+// bundle holds all of its refs.
+func (b *Bundle) Verify(ctx context.Context, ddb *doltdb.DoltDB) error {
+	err := b.Header.CheckPrerequisites(ctx, ddb)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range b.Header.Refs {
+		dref, err := ref.Parse(r.Ref)
+		if err != nil {
+			return err
+		}
+		addr, ok := hash.MaybeParse(r.Hash)
+		if !ok {
+			return fmt.Errorf("invalid hash for %s in bundle: %s", r.Ref, r.Hash)
+		}
+		has, err := b.st.ddb.Has(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if !has {
+			return fmt.Errorf("bundle is corrupt, %s points to %s which it does not contain", dref.String(), r.Hash)
+		}
+	}
+	return nil
+}
+
+// Close removes the temporary database of the bundle.
+func (b *Bundle) Close() error {
+	return b.st.close()
+}
+
+// tempStore is a database in a temporary directory that is stored as table files and a manifest, without a chunk
+// journal, so that its table files can be copied in and out of bundles.
+type tempStore struct {
+	ddb     *doltdb.DoltDB
+	tfs     chunks.TableFileStore
+	dir     string
+	urlPath string
+}
+
+func newTempStore(ctx context.Context, nbf *types.NomsBinFormat, tempDir string) (*tempStore, error) {
+	dir, err := os.MkdirTemp(tempDir, "bundle")
+	if err != nil {
+		return nil, err
+	}
+
+	urlStr := earl.FileUrlFromPath(filepath.ToSlash(dir), os.PathSeparator)
+	u, err := url.Parse(urlStr)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+
+	ddb, err := doltdb.LoadDoltDB(ctx, nbf, urlStr, filesys.LocalFS)
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return nil, err
+	}
+	st := &tempStore{ddb: ddb, dir: dir, urlPath: u.Path}
+
+	tfs, ok := datas.ChunkStoreFromDatabase(doltdb.HackDatasDatabaseFromDoltDB(ddb)).(chunks.TableFileStore)
+	if !ok {
+		st.close()
+		return nil, errors.New("temporary bundle database does not support table files")
+	}
+	st.tfs = tfs
+	return st, nil
+}
+
+func (st *tempStore) close() error {
+	err := st.ddb.Close()
+	_ = dbfactory.DeleteFromSingletonCache(st.urlPath)
+	rerr := os.RemoveAll(st.dir)
+	if err == nil {
+		err = rerr
+	}
+	return err
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle
+
+import (
+	"archive/tar"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestArchive(t *testing.T, path, name string, contents []byte) {
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	tw := tar.NewWriter(f)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(contents))}))
+	_, err = tw.Write(contents)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+}
+
+func TestReadHeader(t *testing.T) {
+	dir := t.TempDir()
+
+	expected := Header{
+		Version:       Version,
+		Format:        "__DOLT__",
+		Root:          "0123456789abcdefghijklmnopqrstuv",
+		Refs:          []Ref{{Ref: "refs/heads/main", Hash: "0123456789abcdefghijklmnopqrstuv"}},
+		Prerequisites: []string{"0123456789abcdefghijklmnopqrstuv"},
+		TableFiles:    []TableFile{{ID: "0123456789abcdefghijklmnopqrstuv", NumChunks: 3}},
+	}
+	data, err := json.Marshal(expected)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		path := filepath.Join(dir, "valid.bundle")
+		writeTestArchive(t, path, headerFileName, data)
+		header, err := ReadHeader(path)
+		require.NoError(t, err)
+		require.Equal(t, expected, *header)
+		require.True(t, IsBundle(path))
+	})
+
+	t.Run("not an archive", func(t *testing.T) {
+		path := filepath.Join(dir, "text.bundle")
+		require.NoError(t, os.WriteFile(path, []byte("not a bundle"), 0644))
+		_, err := ReadHeader(path)
+		require.ErrorIs(t, err, ErrNotABundle)
+		require.False(t, IsBundle(path))
+	})
+
+	t.Run("missing header", func(t *testing.T) {
+		path := filepath.Join(dir, "other.bundle")
+		writeTestArchive(t, path, "other.json", data)
+		_, err := ReadHeader(path)
+		require.ErrorIs(t, err, ErrNotABundle)
+	})
+
+	t.Run("unsupported version", func(t *testing.T) {
+		newer := expected
+		newer.Version = Version + 1
+		data, err := json.Marshal(newer)
+		require.NoError(t, err)
+
+		path := filepath.Join(dir, "newer.bundle")
+		writeTestArchive(t, path, headerFileName, data)
+		_, err = ReadHeader(path)
+		require.Error(t, err)
+		require.False(t, IsBundle(path))
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		require.False(t, IsBundle(dir))
+	})
+}
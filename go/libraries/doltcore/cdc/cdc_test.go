@@ -0,0 +1,187 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+func TestStreamMatchesBranch(t *testing.T) {
+	allBranches := &Stream{}
+	require.True(t, allBranches.MatchesBranch("main"))
+	require.True(t, allBranches.MatchesBranch("feature"))
+
+	someBranches := &Stream{branches: []string{"main", "release/*"}}
+	require.True(t, someBranches.MatchesBranch("main"))
+	require.True(t, someBranches.MatchesBranch("release/1.0"))
+	require.False(t, someBranches.MatchesBranch("feature"))
+	require.False(t, someBranches.MatchesBranch("release/1.0/hotfix"))
+}
+
+func staticEvents(events ...[]byte) Events {
+	return func(emit func([]byte) error) error {
+		for _, e := range events {
+			if err := emit(e); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func TestStreamEnqueue(t *testing.T) {
+	s := &Stream{branches: []string{"main", "feature"}, pending: make(map[branchKey]branchUpdate), ready: make(chan struct{}, 1)}
+	h1, h2, h3 := hash.Of([]byte("one")), hash.Of([]byte("two")), hash.Of([]byte("three"))
+
+	// enqueue never blocks, and a pending update is replaced by a later update of the same branch
+	s.enqueue(branchUpdate{dbName: "db", branch: "main", addr: h1})
+	s.enqueue(branchUpdate{dbName: "db", branch: "feature", addr: h2})
+	s.enqueue(branchUpdate{dbName: "db", branch: "other", addr: h2})
+	s.enqueue(branchUpdate{dbName: "db", branch: "main", addr: h3})
+	require.Len(t, s.ready, 1)
+
+	u, ok := s.next()
+	require.True(t, ok)
+	require.Equal(t, "main", u.branch)
+	require.Equal(t, h3, u.addr)
+	u, ok = s.next()
+	require.True(t, ok)
+	require.Equal(t, "feature", u.branch)
+	_, ok = s.next()
+	require.False(t, ok)
+}
+
+func TestFileSinkRotation(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "cdc", "changes.jsonl")
+	sink := &fileSink{path: path, maxSize: 8, maxFiles: 2}
+	defer sink.Close()
+
+	for _, event := range []string{"{\"a\":1}", "{\"b\":2}", "{\"c\":3}", "{\"d\":4}"} {
+		require.NoError(t, sink.Write(ctx, staticEvents([]byte(event))))
+	}
+
+	readFile := func(p string) string {
+		data, err := os.ReadFile(p)
+		require.NoError(t, err)
+		return string(data)
+	}
+	require.Equal(t, "{\"d\":4}\n", readFile(path))
+	require.Equal(t, "{\"c\":3}\n", readFile(path+".1"))
+	require.Equal(t, "{\"b\":2}\n", readFile(path+".2"))
+	_, err := os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+
+	// a new sink appends to the existing file
+	require.NoError(t, sink.Close())
+	sink = &fileSink{path: path}
+	require.NoError(t, sink.Write(ctx, staticEvents([]byte("{\"e\":5}"), []byte("{\"f\":6}"))))
+	require.Equal(t, "{\"d\":4}\n{\"e\":5}\n{\"f\":6}\n", readFile(path))
+}
+
+func TestHTTPSinkRetries(t *testing.T) {
+	defer func(d time.Duration) { httpSinkRetryDelay = d }(httpSinkRetryDelay)
+	httpSinkRetryDelay = time.Millisecond
+
+	var mu sync.Mutex
+	var bodies []string
+	failures := 2
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		bodies = append(bodies, string(body))
+		if r.Header.Get("Content-Type") != "application/x-ndjson" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	sink := &httpSink{url: server.URL, maxRetries: 2, client: server.Client()}
+	require.NoError(t, sink.Write(ctx, staticEvents([]byte("{\"a\":1}"), []byte("{\"b\":2}"))))
+	mu.Lock()
+	require.Len(t, bodies, 3)
+	require.Equal(t, "{\"a\":1}\n{\"b\":2}\n", bodies[2])
+	failures = 3
+	mu.Unlock()
+
+	require.Error(t, sink.Write(ctx, staticEvents([]byte("{\"c\":3}"))))
+	mu.Lock()
+	require.Len(t, bodies, 6)
+	failures = 0
+	mu.Unlock()
+
+	// commits without events aren't delivered, and errors producing events aren't retried
+	require.NoError(t, sink.Write(ctx, staticEvents()))
+	eventsErr := errors.New("events failed")
+	err := sink.Write(ctx, func(emit func([]byte) error) error {
+		if err := emit([]byte("{\"d\":4}")); err != nil {
+			return err
+		}
+		return eventsErr
+	})
+	require.ErrorIs(t, err, eventsErr)
+	mu.Lock()
+	defer mu.Unlock()
+	require.LessOrEqual(t, len(bodies), 7)
+}
+
+func TestPositionStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cdc_position.json")
+	positions, err := loadPositions(path)
+	require.NoError(t, err)
+	_, ok := positions.get("db", "main")
+	require.False(t, ok)
+
+	h1 := hash.Of([]byte("one"))
+	h2 := hash.Of([]byte("two"))
+	require.NoError(t, positions.set("db", "main", h1))
+	require.NoError(t, positions.set("db", "feature", h2))
+	require.NoError(t, positions.set("db", "main", h2))
+
+	positions, err = loadPositions(path)
+	require.NoError(t, err)
+	h, ok := positions.get("db", "main")
+	require.True(t, ok)
+	require.Equal(t, h2, h)
+	h, ok = positions.get("db", "feature")
+	require.True(t, ok)
+	require.Equal(t, h2, h)
+	_, ok = positions.get("other", "main")
+	require.False(t, ok)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"db":{"main":"not a hash"}}`), 0644))
+	_, err = loadPositions(path)
+	require.Error(t, err)
+}
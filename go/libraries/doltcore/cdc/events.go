@@ -0,0 +1,285 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	typedjson "github.com/dolthub/dolt/go/libraries/doltcore/table/typed/json"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+const (
+	// OpCreate is the op of an Envelope for an inserted row
+	OpCreate = "c"
+	// OpUpdate is the op of an Envelope for an updated row
+	OpUpdate = "u"
+	// OpDelete is the op of an Envelope for a deleted row
+	OpDelete = "d"
+
+	// Connector is the connector named in the Source of every Envelope
+	Connector = "dolt"
+)
+
+// Envelope is the JSON payload emitted for a single row change. It uses the same layout as the value of a Debezium
+// change event with schemas disabled, so that consumers of Debezium change events can read it. Before is nil for
+// inserted rows and After is nil for deleted rows.
+type Envelope struct {
+	Before map[string]interface{} `json:"before"`
+	After  map[string]interface{} `json:"after"`
+	Source Source                 `json:"source"`
+	Op     string                 `json:"op"`
+	TsMs   int64                  `json:"ts_ms"`
+}
+
+// Source describes the commit a row change in an Envelope was made by. TsMs is the time of the commit, and the
+// envelope's TsMs is the time the change was emitted.
+type Source struct {
+	Version   string `json:"version"`
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	Db        string `json:"db"`
+	Table     string `json:"table"`
+	Branch    string `json:"branch"`
+	Commit    string `json:"commit"`
+}
+
+// commitEvents returns the Events producing the JSON encoded envelopes of every row changed between |fromRoot| and the
+// root of |cm|, the head of |branch| in the database named |dbName|. Dropped tables do not produce any events, and
+// tables whose primary key changed produce a delete of every old row and an insert of every new row.
+func (s *Stream) commitEvents(ctx *sql.Context, dbName, branch string, cm *doltdb.Commit, fromRoot doltdb.RootValue) (Events, error) {
+	toRoot, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	h, err := cm.HashOf()
+	if err != nil {
+		return nil, err
+	}
+	meta, err := cm.GetCommitMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deltas, err := diff.GetTableDeltas(ctx, fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(emit func(event []byte) error) error {
+		for _, td := range deltas {
+			if td.IsDrop() {
+				continue
+			}
+			changed, err := td.HasDataChanged(ctx)
+			if err != nil {
+				return err
+			}
+			if !changed {
+				continue
+			}
+
+			src := Source{
+				Version:   s.version,
+				Connector: Connector,
+				Name:      s.name,
+				TsMs:      meta.CommitterTime().UnixMilli(),
+				Snapshot:  "false",
+				Db:        dbName,
+				Table:     td.ToName.Name,
+				Branch:    branch,
+				Commit:    h.String(),
+			}
+			err = tableEvents(ctx, td, func(op string, before, after map[string]interface{}) error {
+				data, err := json.Marshal(Envelope{
+					Before: before,
+					After:  after,
+					Source: src,
+					Op:     op,
+					TsMs:   time.Now().UnixMilli(),
+				})
+				if err != nil {
+					return err
+				}
+				return emit(data)
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+type tableRows struct {
+	sch  schema.Schema
+	rows prolly.Map
+}
+
+type rowChangeFn func(op string, before, after map[string]interface{}) error
+
+// tableEvents calls |cb| for each row changed in the table delta |td|.
+func tableEvents(ctx *sql.Context, td diff.TableDelta, cb rowChangeFn) error {
+	if !types.IsFormat_DOLT(td.ToTable.Format()) {
+		return fmt.Errorf("change data capture is only supported for tables in the %s format", types.Format_DOLT.VersionString())
+	}
+
+	fromIdx, toIdx, err := td.GetRowData(ctx)
+	if err != nil {
+		return err
+	}
+	toSch, err := td.ToTable.GetSchema(ctx)
+	if err != nil {
+		return err
+	}
+	to := tableRows{sch: toSch, rows: durable.ProllyMapFromIndex(toIdx)}
+
+	if td.FromTable == nil {
+		empty, err := emptyTableRows(ctx, to)
+		if err != nil {
+			return err
+		}
+		return diffTableRows(ctx, empty, to, cb)
+	}
+
+	fromSch, err := td.FromTable.GetSchema(ctx)
+	if err != nil {
+		return err
+	}
+	from := tableRows{sch: fromSch, rows: durable.ProllyMapFromIndex(fromIdx)}
+
+	if !from.rows.KeyDesc().Equals(to.rows.KeyDesc()) {
+		// Rows can't be matched up when the primary key changes, so every row is deleted and inserted again.
+		emptyFrom, err := emptyTableRows(ctx, from)
+		if err != nil {
+			return err
+		}
+		if err = diffTableRows(ctx, from, emptyFrom, cb); err != nil {
+			return err
+		}
+		emptyTo, err := emptyTableRows(ctx, to)
+		if err != nil {
+			return err
+		}
+		return diffTableRows(ctx, emptyTo, to, cb)
+	}
+	return diffTableRows(ctx, from, to, cb)
+}
+
+func emptyTableRows(ctx context.Context, other tableRows) (tableRows, error) {
+	kd, vd := other.rows.Descriptors()
+	empty, err := prolly.NewMapFromTuples(ctx, other.rows.NodeStore(), kd, vd)
+	if err != nil {
+		return tableRows{}, err
+	}
+	return tableRows{sch: other.sch, rows: empty}, nil
+}
+
+func diffTableRows(ctx *sql.Context, from, to tableRows, cb rowChangeFn) error {
+	err := prolly.DiffMaps(ctx, from.rows, to.rows, false, func(_ context.Context, d tree.Diff) error {
+		var before, after map[string]interface{}
+		var err error
+		if d.From != nil {
+			before, err = rowImage(ctx, from, val.Tuple(d.Key), val.Tuple(d.From))
+			if err != nil {
+				return err
+			}
+		}
+		if d.To != nil {
+			after, err = rowImage(ctx, to, val.Tuple(d.Key), val.Tuple(d.To))
+			if err != nil {
+				return err
+			}
+		}
+
+		if !schema.IsKeyless(to.sch) {
+			switch d.Type {
+			case tree.AddedDiff:
+				return cb(OpCreate, nil, after)
+			case tree.ModifiedDiff:
+				return cb(OpUpdate, before, after)
+			case tree.RemovedDiff:
+				return cb(OpDelete, before, nil)
+			default:
+				return fmt.Errorf("unexpected diff type: %v", d.Type)
+			}
+		}
+
+		// Keyless tables store a count of identical rows, so a change is a number of inserts or deletes.
+		fromCount, toCount := keylessRowCount(from.sch, d.From), keylessRowCount(to.sch, d.To)
+		for ; toCount > fromCount; toCount-- {
+			if err = cb(OpCreate, nil, after); err != nil {
+				return err
+			}
+		}
+		for ; fromCount > toCount; fromCount-- {
+			if err = cb(OpDelete, before, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil && err != io.EOF {
+		return err
+	}
+	return nil
+}
+
+// keylessRowCount returns the number of identical rows the value |v| of a keyless table represents.
+func keylessRowCount(sch schema.Schema, v tree.Item) uint64 {
+	if v == nil {
+		return 0
+	}
+	count, _ := sch.GetValueDescriptor().GetUint64(0, val.Tuple(v))
+	return count
+}
+
+// rowImage returns the values of the row with |key| and |value| in |rows|, keyed by column name.
+func rowImage(ctx *sql.Context, rows tableRows, key, value val.Tuple) (map[string]interface{}, error) {
+	row, err := index.BuildRow(ctx, key, value, rows.sch, rows.rows.NodeStore())
+	if err != nil {
+		return nil, err
+	}
+
+	cols := rows.sch.GetAllCols().GetColumns()
+	image := make(map[string]interface{}, len(cols))
+	for i, col := range cols {
+		v := row[i]
+		if v != nil {
+			v, err = typedjson.ColumnJSONValue(col, v)
+			if err != nil {
+				return nil, err
+			}
+		}
+		image[col.Name] = v
+	}
+	return image, nil
+}
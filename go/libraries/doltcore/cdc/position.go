@@ -0,0 +1,109 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+// positionStore durably records the position of a Stream, which is the last commit of each branch of each database
+// whose changes have been written to the sink. The positions are stored as a JSON object in a file, keyed by database
+// and then by branch, which is replaced atomically each time a position changes.
+type positionStore struct {
+	path      string
+	mu        sync.Mutex
+	positions map[string]map[string]string
+}
+
+// loadPositions loads the positions stored in the file at |path|. There are no positions if the file does not exist.
+func loadPositions(path string) (*positionStore, error) {
+	p := &positionStore{path: path, positions: make(map[string]map[string]string)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return p, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, &p.positions); err != nil {
+		return nil, fmt.Errorf("invalid cdc position file %s: %w", path, err)
+	}
+	for dbName, branches := range p.positions {
+		for branch, s := range branches {
+			if _, ok := hash.MaybeParse(s); !ok {
+				return nil, fmt.Errorf("invalid cdc position file %s: invalid commit hash '%s' for branch %s of database %s", path, s, branch, dbName)
+			}
+		}
+	}
+	return p, nil
+}
+
+// get returns the position of |branch| in the database named |dbName|, and whether it has one.
+func (p *positionStore) get(dbName, branch string) (hash.Hash, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s, ok := p.positions[dbName][branch]
+	if !ok {
+		return hash.Hash{}, false
+	}
+	return hash.Parse(s), true
+}
+
+// set moves the position of |branch| in the database named |dbName| to |h| and saves the positions.
+func (p *positionStore) set(dbName, branch string, h hash.Hash) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	branches, ok := p.positions[dbName]
+	if !ok {
+		branches = make(map[string]string)
+		p.positions[dbName] = branches
+	}
+	branches[branch] = h.String()
+	return p.save()
+}
+
+func (p *positionStore) save() error {
+	data, err := json.MarshalIndent(p.positions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(p.path), os.ModePerm); err != nil {
+		return err
+	}
+
+	tmp := p.path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p.path)
+}
@@ -0,0 +1,304 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+const (
+	httpSinkTimeout       = 30 * time.Second
+	httpSinkMaxRetryDelay = 30 * time.Second
+)
+
+// httpSinkRetryDelay is the delay before the first retry of a failed delivery. The delay doubles for each subsequent
+// retry, up to httpSinkMaxRetryDelay.
+var httpSinkRetryDelay = 500 * time.Millisecond
+
+// Events produces the JSON encoded envelopes of the changes made by a single commit, passing each one to |emit| as it
+// is produced, so that a commit's events never need to be held in memory at once. Events may be called again to
+// produce the same events, for example to retry a failed delivery.
+type Events func(emit func(event []byte) error) error
+
+// Sink is where the events of a Stream are written to.
+type Sink interface {
+	// Write writes |events|, the envelopes of the changes made by a single commit, as one line each. The stream only
+	// moves its position past the commit once Write returns without an error.
+	Write(ctx context.Context, events Events) error
+	// Close releases the resources held by the sink.
+	Close() error
+}
+
+// NewSink returns the Sink configured by |cfg|. The events of the file sink are written to |path|, which is the
+// configured path resolved against the server's data directory, and the events of the stdout sink are written to
+// |stdout|.
+func NewSink(cfg servercfg.CDCConfig, path string, stdout io.Writer) (Sink, error) {
+	switch cfg.Sink() {
+	case servercfg.CDCSinkFile:
+		return &fileSink{path: path, maxSize: cfg.MaxFileSize(), maxFiles: cfg.MaxFiles()}, nil
+	case servercfg.CDCSinkStdout:
+		return writerSink{wr: stdout}, nil
+	case servercfg.CDCSinkHTTP:
+		return &httpSink{url: cfg.URL(), maxRetries: cfg.MaxRetries(), client: &http.Client{Timeout: httpSinkTimeout}}, nil
+	default:
+		return nil, fmt.Errorf("unknown cdc sink: %s", cfg.Sink())
+	}
+}
+
+// writeNDJSON writes |events| to |wr| as newline delimited JSON, and returns the number of events written.
+func writeNDJSON(wr io.Writer, events Events) (int, error) {
+	bw := bufio.NewWriter(wr)
+	count := 0
+	err := events(func(event []byte) error {
+		count++
+		if _, err := bw.Write(event); err != nil {
+			return err
+		}
+		return bw.WriteByte('\n')
+	})
+	if err != nil {
+		return count, err
+	}
+	return count, bw.Flush()
+}
+
+// writerSink writes events to an io.Writer, such as stdout.
+type writerSink struct {
+	wr io.Writer
+}
+
+func (s writerSink) Write(_ context.Context, events Events) error {
+	_, err := writeNDJSON(s.wr, events)
+	return err
+}
+
+func (s writerSink) Close() error {
+	return nil
+}
+
+// fileSink appends events to a file, which is synced after each write. Once the file reaches |maxSize| it is rotated
+// before the next write: it is renamed to |path|.1, and previously rotated files are renamed from |path|.N to
+// |path|.N+1. Only the |maxFiles| most recently rotated files are kept, unless |maxFiles| is 0.
+type fileSink struct {
+	path     string
+	maxSize  uint64
+	maxFiles int
+	f        *os.File
+	size     uint64
+}
+
+func (s *fileSink) Write(_ context.Context, events Events) error {
+	if s.f == nil {
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+	if s.maxSize > 0 && s.size >= s.maxSize {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+	}
+
+	count, err := writeNDJSON(countingWriter{wr: s.f, n: &s.size}, events)
+	if err != nil || count == 0 {
+		return err
+	}
+	return s.f.Sync()
+}
+
+// countingWriter adds the number of bytes written to |wr| to |n|.
+type countingWriter struct {
+	wr io.Writer
+	n  *uint64
+}
+
+func (w countingWriter) Write(p []byte) (int, error) {
+	n, err := w.wr.Write(p)
+	*w.n += uint64(n)
+	return n, err
+}
+
+func (s *fileSink) open() error {
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	s.f = f
+	s.size = uint64(info.Size())
+	return nil
+}
+
+func (s *fileSink) rotate() error {
+	if err := s.f.Close(); err != nil {
+		return err
+	}
+	s.f = nil
+
+	last := 0
+	for {
+		if _, err := os.Stat(s.rotatedPath(last + 1)); os.IsNotExist(err) {
+			break
+		} else if err != nil {
+			return err
+		}
+		last++
+	}
+
+	for i := last; i > 0; i-- {
+		if s.maxFiles > 0 && i >= s.maxFiles {
+			if err := os.Remove(s.rotatedPath(i)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := os.Rename(s.rotatedPath(i), s.rotatedPath(i+1)); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(s.path, s.rotatedPath(1)); err != nil {
+		return err
+	}
+
+	return s.open()
+}
+
+func (s *fileSink) rotatedPath(i int) string {
+	return fmt.Sprintf("%s.%d", s.path, i)
+}
+
+func (s *fileSink) Close() error {
+	if s.f == nil {
+		return nil
+	}
+	err := s.f.Close()
+	s.f = nil
+	return err
+}
+
+// httpSink POSTs the events of each commit as newline delimited JSON to a URL. The body of each request is streamed
+// as the events are produced, and no request is made for a commit without any events. A failed delivery is retried
+// with exponential backoff until it succeeds or the sink's retries are exhausted.
+type httpSink struct {
+	url        string
+	maxRetries int
+	client     *http.Client
+}
+
+// errEventsFailed wraps errors producing the events of a commit, which are not retried.
+type errEventsFailed struct {
+	err error
+}
+
+func (e errEventsFailed) Error() string {
+	return e.err.Error()
+}
+
+func (e errEventsFailed) Unwrap() error {
+	return e.err
+}
+
+func (s *httpSink) Write(ctx context.Context, events Events) error {
+	delay := httpSinkRetryDelay
+	for attempt := 0; ; attempt++ {
+		err := s.post(ctx, events)
+		if err == nil {
+			return nil
+		}
+		var eventsErr errEventsFailed
+		if errors.As(err, &eventsErr) {
+			return eventsErr.err
+		}
+		if attempt >= s.maxRetries {
+			return fmt.Errorf("delivery to %s failed after %d attempts: %w", s.url, attempt+1, err)
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		delay = min(delay*2, httpSinkMaxRetryDelay)
+	}
+}
+
+func (s *httpSink) post(ctx context.Context, events Events) (err error) {
+	pr, pw := io.Pipe()
+	eventsErr := make(chan error, 1)
+	go func() {
+		_, err := writeNDJSON(pw, events)
+		eventsErr <- err
+		pw.CloseWithError(err)
+	}()
+	defer func() {
+		// Unblock the producer if the request ended before reading the whole body
+		pr.CloseWithError(io.ErrClosedPipe)
+		evErr := <-eventsErr
+		if errors.Is(evErr, io.ErrClosedPipe) {
+			if err == nil {
+				err = fmt.Errorf("%s responded before reading every event", s.url)
+			}
+		} else if evErr != nil {
+			err = errEventsFailed{err: evErr}
+		}
+	}()
+
+	body := bufio.NewReader(pr)
+	if _, err := body.Peek(1); err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	req.Header.Set("User-Agent", "dolt-cdc")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s responded with status %s", s.url, resp.Status)
+	}
+	return nil
+}
+
+func (s *httpSink) Close() error {
+	return nil
+}
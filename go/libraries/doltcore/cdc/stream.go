@@ -0,0 +1,363 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sync"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const (
+	// DefaultPositionFilename is the name of the file, in the server's cfg_dir, which the position of the stream is
+	// stored in when no position_file is configured.
+	DefaultPositionFilename = "cdc_position.json"
+
+	streamThreadName = "cdc_stream"
+
+	// maxFirstParentWalk is how many first parents of a new branch head are searched for the last captured commit.
+	maxFirstParentWalk = 1000
+)
+
+type branchUpdate struct {
+	dbName string
+	ddb    *doltdb.DoltDB
+	branch string
+	addr   hash.Hash
+}
+
+type branchKey struct {
+	dbName string
+	branch string
+}
+
+// Stream captures the rows changed by commits to the branches of a server's databases, and writes them to a Sink as
+// Debezium-style JSON envelopes. Branch updates are processed one at a time, on a background thread.
+//
+// Queuing a branch update never blocks the commit that made it, even while the sink is slow or retrying. Only the
+// latest pending update of each branch is kept: an update replaces one for the same branch which has not been
+// processed yet, since capturing the later head also captures the commits made before it.
+//
+// The stream records the last commit it captured on each branch in a position file. When the head of a branch moves,
+// the changes made by each commit between the recorded commit and the new head, following first parents, are written
+// to the sink and the position is moved past them. If the recorded commit is not a recent first parent of the new
+// head, for example because the branch was reset, the changes between the two commits are written as if they were
+// made by the new head. A commit's changes which fail to be written are written again on the next update to the
+// branch, including after the server restarts, so every change is written at least once.
+type Stream struct {
+	name      string
+	version   string
+	branches  []string
+	sink      Sink
+	positions *positionStore
+	out       io.Writer
+
+	mu      sync.Mutex
+	pending map[branchKey]branchUpdate
+	order   []branchKey
+	ready   chan struct{}
+}
+
+// NewStream creates a Stream configured by |cfg| which writes to |sink| and stores its position in |positionFile|, and
+// starts the background thread that captures changes. |version| is the version of Dolt named in every event. Errors
+// are logged to |out|, which may be nil.
+func NewStream(bThreads *sql.BackgroundThreads, cfg servercfg.CDCConfig, sink Sink, positionFile, version string, out io.Writer) (*Stream, error) {
+	positions, err := loadPositions(positionFile)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Stream{
+		name:      cfg.Name(),
+		version:   version,
+		branches:  cfg.Branches(),
+		sink:      sink,
+		positions: positions,
+		out:       out,
+		pending:   make(map[branchKey]branchUpdate),
+		ready:     make(chan struct{}, 1),
+	}
+
+	err = bThreads.Add(streamThreadName, func(ctx context.Context) {
+		defer s.sink.Close()
+		for {
+			select {
+			case <-s.ready:
+				for u, ok := s.next(); ok && ctx.Err() == nil; u, ok = s.next() {
+					if err := s.capture(ctx, u); err != nil {
+						s.logf("cdc: error capturing changes to branch %s of database %s: %s\n", u.branch, u.dbName, err.Error())
+					}
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// MatchesBranch returns whether changes to |branch| are captured. Every branch matches if no branches are configured,
+// and branches may be glob patterns, such as "release/*".
+func (s *Stream) MatchesBranch(branch string) bool {
+	if len(s.branches) == 0 {
+		return true
+	}
+	for _, pattern := range s.branches {
+		if pattern == branch {
+			return true
+		}
+		if matched, err := path.Match(pattern, branch); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// enqueue queues |u| to be captured, replacing any pending update of the same branch. It never blocks.
+func (s *Stream) enqueue(u branchUpdate) {
+	if !s.MatchesBranch(u.branch) {
+		return
+	}
+
+	s.mu.Lock()
+	key := branchKey{dbName: u.dbName, branch: u.branch}
+	if _, ok := s.pending[key]; !ok {
+		s.order = append(s.order, key)
+	}
+	s.pending[key] = u
+	s.mu.Unlock()
+
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+// next removes and returns the oldest pending branch update, or false if there is none.
+func (s *Stream) next() (branchUpdate, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.order) == 0 {
+		return branchUpdate{}, false
+	}
+	key := s.order[0]
+	s.order = s.order[1:]
+	u := s.pending[key]
+	delete(s.pending, key)
+	return u, true
+}
+
+func (s *Stream) logf(format string, args ...interface{}) {
+	if s.out != nil {
+		s.out.Write([]byte(fmt.Sprintf(format, args...)))
+	}
+}
+
+// capture writes the changes made to |u.branch| since its position to the sink.
+func (s *Stream) capture(ctx context.Context, u branchUpdate) error {
+	from, ok := s.positions.get(u.dbName, u.branch)
+	if !ok {
+		// The stream starts capturing changes to a branch it has not seen before from its current head.
+		return s.positions.set(u.dbName, u.branch, u.addr)
+	}
+	if from == u.addr {
+		return nil
+	}
+
+	sqlCtx := sql.NewContext(ctx)
+	head, err := readCommit(ctx, u.ddb, u.addr)
+	if err != nil {
+		return err
+	}
+
+	commits, parents, err := firstParentsSince(ctx, head, from)
+	if err != nil {
+		return err
+	}
+	if commits == nil {
+		fromCm, err := readCommit(ctx, u.ddb, from)
+		if err != nil {
+			s.logf("cdc: unable to read commit %s, the position of branch %s of database %s; resuming from %s without capturing the changes between them: %s\n",
+				from.String(), u.branch, u.dbName, u.addr.String(), err.Error())
+			return s.positions.set(u.dbName, u.branch, u.addr)
+		}
+		commits, parents = []*doltdb.Commit{head}, []*doltdb.Commit{fromCm}
+	}
+
+	for i, cm := range commits {
+		fromRoot, err := parents[i].GetRootValue(ctx)
+		if err != nil {
+			return err
+		}
+		events, err := s.commitEvents(sqlCtx, u.dbName, u.branch, cm, fromRoot)
+		if err != nil {
+			return err
+		}
+		if err = s.sink.Write(ctx, events); err != nil {
+			return err
+		}
+
+		h, err := cm.HashOf()
+		if err != nil {
+			return err
+		}
+		if err = s.positions.set(u.dbName, u.branch, h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// firstParentsSince returns the commits on the first parent path from |head| back to, but not including, the commit
+// |from|, ordered from oldest to newest, along with the first parent of each. It returns nil if |from| is not found
+// within maxFirstParentWalk commits of |head|.
+func firstParentsSince(ctx context.Context, head *doltdb.Commit, from hash.Hash) (commits, parents []*doltdb.Commit, err error) {
+	cm := head
+	for i := 0; i < maxFirstParentWalk && cm.NumParents() > 0; i++ {
+		optCmt, err := cm.GetParent(ctx, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		parent, ok := optCmt.ToCommit()
+		if !ok {
+			return nil, nil, nil
+		}
+		commits = append(commits, cm)
+		parents = append(parents, parent)
+
+		h, err := parent.HashOf()
+		if err != nil {
+			return nil, nil, err
+		}
+		if h == from {
+			for l, r := 0, len(commits)-1; l < r; l, r = l+1, r-1 {
+				commits[l], commits[r] = commits[r], commits[l]
+				parents[l], parents[r] = parents[r], parents[l]
+			}
+			return commits, parents, nil
+		}
+		cm = parent
+	}
+	return nil, nil, nil
+}
+
+func readCommit(ctx context.Context, ddb *doltdb.DoltDB, addr hash.Hash) (*doltdb.Commit, error) {
+	optCmt, err := ddb.ReadCommit(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := optCmt.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	return cm, nil
+}
+
+// CommitHook is a doltdb.CommitHook that sends updates to the heads of the branches of a database to a Stream.
+type CommitHook struct {
+	stream *Stream
+	dbName string
+	ddb    *doltdb.DoltDB
+	out    io.Writer
+}
+
+var _ doltdb.CommitHook = (*CommitHook)(nil)
+
+// NewCommitHook creates a CommitHook which sends updates to the branches of |ddb|, the database named |dbName|, to
+// |stream|. The current head of each branch is sent to the stream as well, so that it captures the commits made while
+// the server was not running.
+func NewCommitHook(ctx context.Context, stream *Stream, dbName string, ddb *doltdb.DoltDB) (*CommitHook, error) {
+	branches, err := ddb.GetBranchesWithHashes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range branches {
+		stream.enqueue(branchUpdate{dbName: dbName, ddb: ddb, branch: b.Ref.GetPath(), addr: b.Hash})
+	}
+
+	return &CommitHook{stream: stream, dbName: dbName, ddb: ddb}, nil
+}
+
+// Execute implements doltdb.CommitHook. Updates to branch heads are queued to be captured by the stream.
+func (h *CommitHook) Execute(ctx context.Context, ds datas.Dataset, _ datas.Database) (func(context.Context) error, error) {
+	addr, ok := ds.MaybeHeadAddr()
+	if !ok {
+		return nil, nil
+	}
+
+	if !ref.IsRef(ds.ID()) {
+		return nil, nil
+	}
+	dref, err := ref.Parse(ds.ID())
+	if err != nil {
+		return nil, err
+	}
+	if dref.GetType() != ref.BranchRefType {
+		return nil, nil
+	}
+
+	h.stream.enqueue(branchUpdate{dbName: h.dbName, ddb: h.ddb, branch: dref.GetPath(), addr: addr})
+	return nil, nil
+}
+
+// HandleError implements doltdb.CommitHook
+func (h *CommitHook) HandleError(ctx context.Context, err error) error {
+	if h.out != nil {
+		h.out.Write([]byte(fmt.Sprintf("cdc: error queuing changes of database %s: %s\n", h.dbName, err.Error())))
+	}
+	return nil
+}
+
+// SetLogger implements doltdb.CommitHook
+func (h *CommitHook) SetLogger(ctx context.Context, wr io.Writer) error {
+	h.out = wr
+	return nil
+}
+
+// ExecuteForWorkingSets implements doltdb.CommitHook
+func (*CommitHook) ExecuteForWorkingSets() bool {
+	return false
+}
+
+// NewInitDatabaseHook returns a sqle.InitDatabaseHook that installs a CommitHook sending updates to |stream| on each
+// database created after the server has started.
+func NewInitDatabaseHook(stream *Stream, out io.Writer) sqle.InitDatabaseHook {
+	return func(ctx *sql.Context, _ *sqle.DoltDatabaseProvider, name string, denv *env.DoltEnv, _ dsess.SqlDatabase) error {
+		hook, err := NewCommitHook(ctx, stream, name, denv.DoltDB)
+		if err != nil {
+			return err
+		}
+		_ = hook.SetLogger(ctx, out)
+		denv.DoltDB.PrependCommitHook(ctx, hook)
+		return nil
+	}
+}
@@ -17,19 +17,31 @@ package cherry_pick
 import (
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/commitwalk"
 	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
 )
 
 // ErrCherryPickUncommittedChanges is returned when a cherry-pick is attempted without a clean working set.
 var ErrCherryPickUncommittedChanges = errors.New("cannot cherry-pick with uncommitted changes")
 
+// ErrNoCherryPickInProgress is returned when a cherry-pick is continued, but no cherry-pick is in progress.
+var ErrNoCherryPickInProgress = errors.New("error: There is no cherry-pick in progress")
+
+// ErrEmptyCherryPickRange is returned when the commits specified to cherry-pick do not include any commits.
+var ErrEmptyCherryPickRange = errors.New("error: empty commit set passed")
+
 // CherryPickOptions specifies optional parameters specifying how a cherry-pick is performed.
 type CherryPickOptions struct {
 	// Amend controls whether the commit at HEAD is amended and combined with the commit to be cherry-picked.
@@ -52,6 +64,11 @@ type CherryPickOptions struct {
 	// and Dolt cherry-pick implementations, the default action is to fail when an empty commit is specified. In Git
 	// and Dolt rebase implementations, the default action is to keep commits that start off as empty.
 	EmptyCommitHandling doltdb.EmptyCommitHandling
+
+	// Mainline selects the parent, numbered starting from 1, that a merge commit's changes are replayed relative to.
+	// Merge commits can only be cherry-picked when a mainline parent is specified. When Mainline is 0, no parent is
+	// specified. Mainline is ignored for commits that aren't merge commits.
+	Mainline int
 }
 
 // NewCherryPickOptions creates a new CherryPickOptions instance, filled out with default values for cherry-pick.
@@ -78,15 +95,15 @@ func CherryPick(ctx *sql.Context, commit string, options CherryPickOptions) (str
 		return "", nil, fmt.Errorf("failed to get roots for current session")
 	}
 
-	mergeResult, commitMsg, err := cherryPick(ctx, doltSession, roots, dbName, commit, options.EmptyCommitHandling)
+	mergeResult, cherryCommitMeta, err := cherryPick(ctx, doltSession, roots, dbName, commit, options.EmptyCommitHandling, options.Mainline)
 	if err != nil {
 		return "", mergeResult, err
 	}
 
-	// If we're amending the previous commit and a new commit message hasn't been provided,
-	// grab the previous commit message and reuse it.
-	if options.Amend && options.CommitMessage == "" {
-		commitMsg, err = previousCommitMessage(ctx)
+	// If we're amending the previous commit, it keeps its own authorship, and its commit message unless a new
+	// commit message has been provided.
+	if options.Amend {
+		cherryCommitMeta, err = previousCommitMeta(ctx)
 		if err != nil {
 			return "", nil, err
 		}
@@ -116,8 +133,9 @@ func CherryPick(ctx *sql.Context, commit string, options CherryPickOptions) (str
 	// If no commit message was explicitly provided in the cherry-pick options,
 	// use the commit message from the cherry-picked commit.
 	if commitProps.Message == "" {
-		commitProps.Message = commitMsg
+		commitProps.Message = cherryCommitMeta.Description
 	}
+	PreserveAuthorship(commitProps, cherryCommitMeta)
 
 	// NOTE: roots are old here (after staging the tables) and need to be refreshed
 	roots, ok = doltSession.GetRoots(ctx, dbName)
@@ -150,6 +168,337 @@ func CherryPick(ctx *sql.Context, commit string, options CherryPickOptions) (str
 	return h.String(), nil, nil
 }
 
+// ResolveCherryPickCommits resolves |specs| into the list of commits to cherry-pick, in the order they should be
+// applied. Each spec is either a single commit, or a range of the form A..B that includes every commit reachable from
+// B but not from A, oldest first. Either side of a range may be omitted, in which case it defaults to HEAD. Commits
+// are resolved up front, since HEAD moves as each commit is applied, so the returned list contains commit hashes. The
+// exception is a single spec naming a single commit, which is returned as given so that it is recorded as specified
+// in the merge state if the cherry-pick stops on conflicts.
+func ResolveCherryPickCommits(ctx *sql.Context, specs []string) ([]string, error) {
+	if len(specs) == 1 && !strings.Contains(specs[0], "..") {
+		return specs, nil
+	}
+
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	dbName := ctx.GetCurrentDatabase()
+
+	doltDB, ok := doltSession.GetDoltDB(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("failed to get DoltDB")
+	}
+	dbData, ok := doltSession.GetDbData(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("failed to get dbData")
+	}
+	headRef, err := dbData.Rsr.CWBHeadRef()
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []string
+	for _, spec := range specs {
+		if strings.Contains(spec, "...") {
+			return nil, fmt.Errorf("error: cherry-picking a symmetric difference is not supported: %s", spec)
+		}
+
+		if from, to, isRange := strings.Cut(spec, ".."); isRange {
+			rangeCommits, err := resolveCherryPickRange(ctx, doltDB, headRef, from, to)
+			if err != nil {
+				return nil, err
+			}
+			commits = append(commits, rangeCommits...)
+			continue
+		}
+
+		h, err := resolveCommitHash(ctx, doltDB, headRef, spec)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, h.String())
+	}
+
+	if len(commits) == 0 {
+		return nil, ErrEmptyCherryPickRange
+	}
+	return commits, nil
+}
+
+// resolveCherryPickRange returns the hashes of the commits reachable from |to| but not from |from|, oldest first.
+func resolveCherryPickRange(ctx *sql.Context, doltDB *doltdb.DoltDB, headRef ref.DoltRef, from, to string) ([]string, error) {
+	if from == "" {
+		from = "HEAD"
+	}
+	if to == "" {
+		to = "HEAD"
+	}
+
+	fromHash, err := resolveCommitHash(ctx, doltDB, headRef, from)
+	if err != nil {
+		return nil, err
+	}
+	toHash, err := resolveCommitHash(ctx, doltDB, headRef, to)
+	if err != nil {
+		return nil, err
+	}
+
+	itr, err := commitwalk.GetDotDotRevisionsIterator(ctx, doltDB, []hash.Hash{toHash}, doltDB, []hash.Hash{fromHash}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []string
+	for {
+		h, _, err := itr.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		commits = append(commits, h.String())
+	}
+
+	// The iterator returns the newest commits first, but they need to be applied oldest first
+	for i, j := 0, len(commits)-1; i < j; i, j = i+1, j-1 {
+		commits[i], commits[j] = commits[j], commits[i]
+	}
+	return commits, nil
+}
+
+func resolveCommitHash(ctx *sql.Context, doltDB *doltdb.DoltDB, headRef ref.DoltRef, spec string) (hash.Hash, error) {
+	commitSpec, err := doltdb.NewCommitSpec(spec)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	optCmt, err := doltDB.Resolve(ctx, commitSpec, headRef)
+	if err != nil {
+		return hash.Hash{}, err
+	}
+	commit, ok := optCmt.ToCommit()
+	if !ok {
+		return hash.Hash{}, doltdb.ErrGhostCommitEncountered
+	}
+	return commit.HashOf()
+}
+
+// CherryPickCommits cherry-picks each of |commits| in order, creating a new commit for each of them, and returns the
+// hash of the last commit created. If cherry-picking a commit results in conflicts or constraint violations, the
+// sequence stops and the merge result for that commit is returned. The commits that have not been applied yet are
+// recorded in the working set, so that the sequence can be resumed with ContinueCherryPick once the conflicts have
+// been resolved, along with the HEAD from before the sequence started, which AbortCherryPick resets to.
+func CherryPickCommits(ctx *sql.Context, commits []string, options CherryPickOptions) (string, *merge.Result, error) {
+	var origHead hash.Hash
+	if len(commits) > 1 {
+		doltSession := dsess.DSessFromSess(ctx.Session)
+		headCommit, err := doltSession.GetHeadCommit(ctx, ctx.GetCurrentDatabase())
+		if err != nil {
+			return "", nil, err
+		}
+		if origHead, err = headCommit.HashOf(); err != nil {
+			return "", nil, err
+		}
+	}
+	return cherryPickCommits(ctx, commits, options, origHead)
+}
+
+// cherryPickCommits cherry-picks |commits| as CherryPickCommits does, as part of a sequence that started at
+// |origHead|. |origHead| is empty when the commits are not part of a sequence.
+func cherryPickCommits(ctx *sql.Context, commits []string, options CherryPickOptions, origHead hash.Hash) (string, *merge.Result, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	dbName := ctx.GetCurrentDatabase()
+
+	lastCommit := ""
+	for i, commit := range commits {
+		// Each cherry-picked commit commits the current transaction, so make sure one is started for the next commit
+		if doltSession.GetTransaction() == nil {
+			if _, err := doltSession.StartTransaction(ctx, sql.ReadWrite); err != nil {
+				return "", nil, err
+			}
+		}
+
+		newCommit, mergeResult, err := CherryPick(ctx, commit, options)
+		if err != nil {
+			return "", mergeResult, err
+		}
+
+		if mergeResult != nil {
+			remaining := commits[i+1:]
+			if len(remaining) > 0 || !origHead.IsEmpty() {
+				queue := make([]hash.Hash, len(remaining))
+				for j, s := range remaining {
+					h, ok := hash.MaybeParse(s)
+					if !ok {
+						return "", nil, fmt.Errorf("invalid commit hash in cherry-pick sequence: %s", s)
+					}
+					queue[j] = h
+				}
+				ws, err := doltSession.WorkingSet(ctx, dbName)
+				if err != nil {
+					return "", nil, err
+				}
+				err = doltSession.SetWorkingSet(ctx, dbName, ws.WithCherryPickQueue(queue, origHead))
+				if err != nil {
+					return "", nil, err
+				}
+			}
+			return "", mergeResult, nil
+		}
+
+		if newCommit != "" {
+			lastCommit = newCommit
+		}
+	}
+
+	return lastCommit, nil, nil
+}
+
+// ContinueCherryPick commits the resolved changes from a cherry-pick that stopped because of conflicts or constraint
+// violations, using the message from the commit being cherry-picked, and then cherry-picks any commits that remained
+// in the sequence. The return values are the same as for CherryPickCommits. All changes must be resolved and staged
+// before a cherry-pick can be continued.
+func ContinueCherryPick(ctx *sql.Context, dbName string, options CherryPickOptions) (string, *merge.Result, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+
+	ws, err := validateCherryPickCanContinue(ctx, dbName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	queue := ws.MergeState().CherryPickQueue()
+	origHead := ws.MergeState().CherryPickOrigHead()
+
+	commitProps, err := CreateCommitStagedPropsFromCherryPickOptions(ctx, options)
+	if err != nil {
+		return "", nil, err
+	}
+	cherryCommitMeta, err := ws.MergeState().Commit().GetCommitMeta(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	if commitProps.Message == "" {
+		commitProps.Message = cherryCommitMeta.Description
+	}
+	PreserveAuthorship(commitProps, cherryCommitMeta)
+
+	roots, ok := doltSession.GetRoots(ctx, dbName)
+	if !ok {
+		return "", nil, fmt.Errorf("failed to get roots for current session")
+	}
+
+	pendingCommit, err := doltSession.NewPendingCommit(ctx, dbName, roots, *commitProps)
+	if err != nil {
+		return "", nil, err
+	}
+	if pendingCommit == nil {
+		return "", nil, errors.New("nothing to commit")
+	}
+
+	if doltSession.GetTransaction() == nil {
+		if _, err = doltSession.StartTransaction(ctx, sql.ReadWrite); err != nil {
+			return "", nil, err
+		}
+	}
+	newCommit, err := doltSession.DoltCommit(ctx, dbName, doltSession.GetTransaction(), pendingCommit)
+	if err != nil {
+		return "", nil, err
+	}
+	h, err := newCommit.HashOf()
+	if err != nil {
+		return "", nil, err
+	}
+
+	lastCommit, mergeResult, err := cherryPickQueue(ctx, queue, origHead, options)
+	if err != nil || mergeResult != nil {
+		return "", mergeResult, err
+	}
+	if lastCommit == "" {
+		lastCommit = h.String()
+	}
+	return lastCommit, nil, nil
+}
+
+// SkipCherryPick discards the changes from the commit that a cherry-pick stopped on because of conflicts or
+// constraint violations, and then cherry-picks any commits that remained in the sequence. The return values are the
+// same as for CherryPickCommits; if no commits remained, no commit is created and the returned hash is empty.
+func SkipCherryPick(ctx *sql.Context, dbName string, options CherryPickOptions) (string, *merge.Result, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+
+	ws, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return "", nil, fmt.Errorf("fatal: unable to load working set: %v", err)
+	}
+	if !ws.MergeActive() || !ws.MergeState().IsCherryPick() {
+		return "", nil, ErrNoCherryPickInProgress
+	}
+	queue := ws.MergeState().CherryPickQueue()
+	origHead := ws.MergeState().CherryPickOrigHead()
+
+	// Only the current commit is dropped, the commits already applied earlier in the sequence are kept
+	if err = abortCherryPickMerge(ctx, doltSession, dbName, ws); err != nil {
+		return "", nil, err
+	}
+
+	return cherryPickQueue(ctx, queue, origHead, options)
+}
+
+// cherryPickQueue cherry-picks the commits in |queue|, which remained in a sequence of commits being cherry-picked
+// that started at |origHead|.
+func cherryPickQueue(ctx *sql.Context, queue []hash.Hash, origHead hash.Hash, options CherryPickOptions) (string, *merge.Result, error) {
+	if len(queue) == 0 {
+		return "", nil, nil
+	}
+
+	commits := make([]string, len(queue))
+	for i, h := range queue {
+		commits[i] = h.String()
+	}
+	return cherryPickCommits(ctx, commits, options, origHead)
+}
+
+// ValidateCherryPickCanContinue returns an error if there is no cherry-pick in progress for |dbName|, or if the
+// cherry-pick in progress still has unresolved conflicts or constraint violations.
+func ValidateCherryPickCanContinue(ctx *sql.Context, dbName string) error {
+	_, err := validateCherryPickCanContinue(ctx, dbName)
+	return err
+}
+
+func validateCherryPickCanContinue(ctx *sql.Context, dbName string) (*doltdb.WorkingSet, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+
+	ws, err := doltSession.WorkingSet(ctx, dbName)
+	if err != nil {
+		return nil, fmt.Errorf("fatal: unable to load working set: %v", err)
+	}
+	if !ws.MergeActive() || !ws.MergeState().IsCherryPick() {
+		return nil, ErrNoCherryPickInProgress
+	}
+
+	if err = validateNoCherryPickArtifacts(ctx, ws); err != nil {
+		return nil, err
+	}
+	return ws, nil
+}
+
+// validateNoCherryPickArtifacts returns an error if the working set |ws| still has any data conflicts, schema
+// conflicts, or constraint violations from the in-progress cherry-pick.
+func validateNoCherryPickArtifacts(ctx *sql.Context, ws *doltdb.WorkingSet) error {
+	tablesWithConflicts, err := doltdb.TablesWithDataConflicts(ctx, ws.WorkingRoot())
+	if err != nil {
+		return err
+	}
+	tablesWithViolations, err := doltdb.TablesWithConstraintViolations(ctx, ws.WorkingRoot())
+	if err != nil {
+		return err
+	}
+
+	unresolved := append(tablesWithConflicts, tablesWithViolations...)
+	unresolved = append(unresolved, ws.MergeState().TablesWithSchemaConflicts()...)
+	if len(unresolved) > 0 {
+		return fmt.Errorf("error: cannot continue cherry-pick with unresolved conflicts or constraint violations in tables: %s",
+			doltdb.TableNamesAsString(unresolved))
+	}
+	return nil
+}
+
 // CreateCommitStagedPropsFromCherryPickOptions converts the specified cherry-pick |options| into a CommitStagedProps
 // instance that can be used to create a pending commit.
 func CreateCommitStagedPropsFromCherryPickOptions(ctx *sql.Context, options CherryPickOptions) (*actions.CommitStagedProps, error) {
@@ -180,22 +529,33 @@ func CreateCommitStagedPropsFromCherryPickOptions(ctx *sql.Context, options Cher
 	return &commitProps, nil
 }
 
-func previousCommitMessage(ctx *sql.Context) (string, error) {
+// PreserveAuthorship updates |commitProps| so that the new commit keeps the author, authored date and trailers from
+// |meta|, the metadata of the commit whose changes are being applied. The identity already set in |commitProps|, the
+// current SQL user, is recorded as the committer instead.
+func PreserveAuthorship(commitProps *actions.CommitStagedProps, meta *datas.CommitMeta) {
+	commitProps.CommitterName = commitProps.Name
+	commitProps.CommitterEmail = commitProps.Email
+	commitProps.Name = meta.Name
+	commitProps.Email = meta.Email
+	commitProps.Date = meta.Time()
+	commitProps.Trailers = meta.Trailers
+}
+
+// previousCommitMeta returns the metadata of the commit at HEAD.
+func previousCommitMeta(ctx *sql.Context) (*datas.CommitMeta, error) {
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	headCommit, err := doltSession.GetHeadCommit(ctx, ctx.GetCurrentDatabase())
 	if err != nil {
-		return "", err
-	}
-	headCommitMeta, err := headCommit.GetCommitMeta(ctx)
-	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return headCommitMeta.Description, nil
+	return headCommit.GetCommitMeta(ctx)
 }
 
-// AbortCherryPick aborts a cherry-pick merge, if one is in progress. If unable to abort for any reason
-// (e.g. if there is not cherry-pick merge in progress), an error is returned.
+// AbortCherryPick aborts a cherry-pick merge, if one is in progress. When the cherry-pick is part of a sequence of
+// commits, the commits that were already applied are undone as well, by resetting HEAD to where it was before the
+// sequence started. If unable to abort for any reason (e.g. if there is not cherry-pick merge in progress), an error
+// is returned.
 func AbortCherryPick(ctx *sql.Context, dbName string) error {
 	doltSession := dsess.DSessFromSess(ctx.Session)
 
@@ -208,6 +568,47 @@ func AbortCherryPick(ctx *sql.Context, dbName string) error {
 		return fmt.Errorf("error: There is no cherry-pick merge to abort")
 	}
 
+	origHead := ws.MergeState().CherryPickOrigHead()
+	if origHead.IsEmpty() {
+		return abortCherryPickMerge(ctx, doltSession, dbName, ws)
+	}
+
+	roots, ok := doltSession.GetRoots(ctx, dbName)
+	if !ok {
+		return fmt.Errorf("fatal: unable to load roots for %s", dbName)
+	}
+	newWs, err := merge.AbortMerge(ctx, ws, roots)
+	if err != nil {
+		return fmt.Errorf("fatal: unable to abort merge: %v", err)
+	}
+
+	dbData, ok := doltSession.GetDbData(ctx, dbName)
+	if !ok {
+		return fmt.Errorf("failed to get dbData")
+	}
+	roots.Working, roots.Staged = newWs.WorkingRoot(), newWs.StagedRoot()
+	newHead, roots, err := actions.ResetHardTables(ctx, dbData, origHead.String(), roots)
+	if err != nil {
+		return fmt.Errorf("fatal: unable to reset to %s: %v", origHead.String(), err)
+	}
+
+	headRef, err := dbData.Rsr.CWBHeadRef()
+	if err != nil {
+		return err
+	}
+	if err = dbData.Ddb.SetHeadToCommit(ctx, headRef, newHead); err != nil {
+		return err
+	}
+
+	if err = doltSession.SetWorkingSet(ctx, dbName, newWs.WithWorkingRoot(roots.Working).WithStagedRoot(roots.Staged)); err != nil {
+		return err
+	}
+	return doltSession.ResetGlobals(ctx, dbName, roots.Working)
+}
+
+// abortCherryPickMerge aborts the cherry-pick merge in progress in |ws|, without undoing any commits that were
+// applied earlier in the same sequence.
+func abortCherryPickMerge(ctx *sql.Context, doltSession *dsess.DoltSession, dbName string, ws *doltdb.WorkingSet) error {
 	roots, ok := doltSession.GetRoots(ctx, dbName)
 	if !ok {
 		return fmt.Errorf("fatal: unable to load roots for %s", dbName)
@@ -222,106 +623,109 @@ func AbortCherryPick(ctx *sql.Context, dbName string) error {
 }
 
 // cherryPick checks that the current working set is clean, verifies the cherry-pick commit is not a merge commit
-// or a commit without parent commit, performs merge and returns the new working set root value and
-// the commit message of cherry-picked commit as the commit message of the new commit created during this command.
-func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots, dbName, cherryStr string, emptyCommitHandling doltdb.EmptyCommitHandling) (*merge.Result, string, error) {
+// (unless a |mainline| parent is specified) or a commit without parent commit, performs merge and returns the new
+// working set root value and the metadata of the cherry-picked commit, whose message, author and trailers are used
+// for the new commit created during this command.
+func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots, dbName, cherryStr string, emptyCommitHandling doltdb.EmptyCommitHandling, mainline int) (*merge.Result, *datas.CommitMeta, error) {
 	// check for clean working set
 	wsOnlyHasIgnoredTables, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 	if !wsOnlyHasIgnoredTables {
-		return nil, "", ErrCherryPickUncommittedChanges
+		return nil, nil, ErrCherryPickUncommittedChanges
 	}
 
 	headRootHash, err := roots.Head.HashOf()
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	workingRootHash, err := roots.Working.HashOf()
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	doltDB, ok := dSess.GetDoltDB(ctx, dbName)
 	if !ok {
-		return nil, "", fmt.Errorf("failed to get DoltDB")
+		return nil, nil, fmt.Errorf("failed to get DoltDB")
 	}
 
 	dbData, ok := dSess.GetDbData(ctx, dbName)
 	if !ok {
-		return nil, "", fmt.Errorf("failed to get dbData")
+		return nil, nil, fmt.Errorf("failed to get dbData")
 	}
 
 	cherryCommitSpec, err := doltdb.NewCommitSpec(cherryStr)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 	headRef, err := dbData.Rsr.CWBHeadRef()
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 	optCmt, err := doltDB.Resolve(ctx, cherryCommitSpec, headRef)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 	cherryCommit, ok := optCmt.ToCommit()
 	if !ok {
-		return nil, "", doltdb.ErrGhostCommitEncountered
+		return nil, nil, doltdb.ErrGhostCommitEncountered
 	}
 
-	if len(cherryCommit.DatasParents()) > 1 {
-		return nil, "", fmt.Errorf("cherry-picking a merge commit is not supported")
+	if len(cherryCommit.DatasParents()) > 1 && mainline == 0 {
+		return nil, nil, fmt.Errorf("cherry-picking a merge commit is not supported without specifying a parent number with -m")
 	}
 	if len(cherryCommit.DatasParents()) == 0 {
-		return nil, "", fmt.Errorf("cherry-picking a commit without parents is not supported")
+		return nil, nil, fmt.Errorf("cherry-picking a commit without parents is not supported")
+	}
+	// As in git, the mainline parent only applies to merge commits, so that a range with both merge commits and
+	// regular commits can be cherry-picked with -m
+	if len(cherryCommit.DatasParents()) == 1 {
+		mainline = 0
 	}
 
 	cherryRoot, err := cherryCommit.GetRootValue(ctx)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	// When cherry-picking, we need to use the parent of the cherry-picked commit as the ancestor. This
-	// ensures that only the delta from the cherry-pick commit is applied.
-	optCmt, err = doltDB.ResolveParent(ctx, cherryCommit, 0)
+	// ensures that only the delta from the cherry-pick commit is applied. For merge commits, the mainline
+	// parent is used, so that the changes the merge brought in relative to that parent are applied.
+	parentCommit, err := merge.ResolveMainlineParent(ctx, doltDB, cherryCommit, mainline)
 	if err != nil {
-		return nil, "", err
-	}
-	parentCommit, ok := optCmt.ToCommit()
-	if !ok {
-		return nil, "", doltdb.ErrGhostCommitEncountered
+		return nil, nil, err
 	}
 
 	parentRoot, err := parentCommit.GetRootValue(ctx)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	isEmptyCommit, err := rootsEqual(cherryRoot, parentRoot)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 	if isEmptyCommit {
 		switch emptyCommitHandling {
 		case doltdb.KeepEmptyCommit:
 			// No action; keep processing the empty commit
 		case doltdb.DropEmptyCommit:
-			return nil, "", nil
+			return nil, nil, nil
 		case doltdb.ErrorOnEmptyCommit:
-			return nil, "", fmt.Errorf("The previous cherry-pick commit is empty. " +
+			return nil, nil, fmt.Errorf("The previous cherry-pick commit is empty. " +
 				"Use --allow-empty to cherry-pick empty commits.")
 		default:
-			return nil, "", fmt.Errorf("Unsupported empty commit handling options: %v", emptyCommitHandling)
+			return nil, nil, fmt.Errorf("Unsupported empty commit handling options: %v", emptyCommitHandling)
 		}
 	}
 
 	dbState, ok, err := dSess.LookupDbState(ctx, dbName)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	} else if !ok {
-		return nil, "", sql.ErrDatabaseNotFound.New(dbName)
+		return nil, nil, sql.ErrDatabaseNotFound.New(dbName)
 	}
 
 	mo := merge.MergeOpts{
@@ -330,28 +734,28 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 	}
 	result, err := merge.MergeRoots(ctx, roots.Working, cherryRoot, parentRoot, cherryCommit, parentCommit, dbState.EditOpts(), mo)
 	if err != nil {
-		return result, "", err
+		return result, nil, err
 	}
 
 	workingRootHash, err = result.Root.HashOf()
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	// If the cherry-pick modifies a deleted table, we don't have a good way to surface that. Abort.
 	for _, schConflict := range result.SchemaConflicts {
 		if schConflict.ModifyDeleteConflict {
-			return nil, "", schConflict
+			return nil, nil, schConflict
 		}
 	}
 
 	if headRootHash.Equal(workingRootHash) && !isEmptyCommit {
-		return nil, "", fmt.Errorf("no changes were made, nothing to commit")
+		return nil, nil, fmt.Errorf("no changes were made, nothing to commit")
 	}
 
 	cherryCommitMeta, err := cherryCommit.GetCommitMeta(ctx)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, err
 	}
 
 	// If any of the merge stats show a data or schema conflict or a constraint
@@ -360,19 +764,19 @@ func cherryPick(ctx *sql.Context, dSess *dsess.DoltSession, roots doltdb.Roots,
 		if stats.HasArtifacts() {
 			ws, err := dSess.WorkingSet(ctx, dbName)
 			if err != nil {
-				return nil, "", err
+				return nil, nil, err
 			}
 			newWorkingSet := ws.StartCherryPick(cherryCommit, cherryStr)
 			err = dSess.SetWorkingSet(ctx, dbName, newWorkingSet)
 			if err != nil {
-				return nil, "", err
+				return nil, nil, err
 			}
 
 			break
 		}
 	}
 
-	return result, cherryCommitMeta.Description, nil
+	return result, cherryCommitMeta, nil
 }
 
 func rootsEqual(root1, root2 doltdb.RootValue) (bool, error) {
@@ -0,0 +1,93 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+// CommitCheck is a SQL assertion from the dolt_commit_checks table. A commit is rejected if its query returns any rows.
+type CommitCheck struct {
+	Name  string
+	Query string
+}
+
+// GetCommitChecks returns the checks in the dolt_commit_checks table of |root|, ordered by name. The checks are read
+// from the table directly, so that committing doesn't require the privilege to read dolt_commit_checks.
+func GetCommitChecks(ctx context.Context, root RootValue) ([]CommitCheck, error) {
+	table, found, err := root.GetTable(ctx, TableName{Name: CommitChecksTableName})
+	if err != nil {
+		return nil, err
+	}
+	if !found || table.Format() == types.Format_LD_1 {
+		// dolt_commit_checks is not supported for the legacy storage format.
+		return nil, nil
+	}
+
+	index, err := table.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := table.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyDesc, valueDesc := sch.GetMapDescriptors()
+	if keyDesc.Count() != 1 || valueDesc.Count() != 1 {
+		return nil, fmt.Errorf("dolt_commit_checks had unexpected schema, this should never happen")
+	}
+
+	ns := table.NodeStore()
+	iter, err := durable.ProllyMapFromIndex(index).IterAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var checks []CommitCheck
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name, err := tree.GetField(ctx, keyDesc, 0, k, ns)
+		if err != nil {
+			return nil, err
+		}
+		query, err := tree.GetField(ctx, valueDesc, 0, v, ns)
+		if err != nil {
+			return nil, err
+		}
+		nameStr, ok := name.(string)
+		if !ok {
+			return nil, fmt.Errorf("could not read commit check name")
+		}
+		queryStr, ok := query.(string)
+		if !ok {
+			return nil, fmt.Errorf("could not read query of commit check '%s'", nameStr)
+		}
+		checks = append(checks, CommitCheck{Name: nameStr, Query: queryStr})
+	}
+	return checks, nil
+}
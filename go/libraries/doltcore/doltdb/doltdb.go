@@ -1901,6 +1901,32 @@ func (ddb *DoltDB) TableFileStoreHasJournal(ctx context.Context) (bool, error) {
 	return false, nil
 }
 
+// StoreSizes returns the size, in bytes, of the chunk journal of this DoltDB and of the table files in the old
+// generation of its chunk store. Both are zero for databases which are not stored in a local generational store.
+func (ddb *DoltDB) StoreSizes(ctx context.Context) (journalSize uint64, oldGenSize uint64, err error) {
+	gs, ok := datas.ChunkStoreFromDatabase(ddb.db).(*nbs.GenerationalNBS)
+	if !ok {
+		return 0, 0, nil
+	}
+
+	if journal := ddb.ChunkJournal(); journal != nil {
+		info, err := os.Stat(filepath.Join(journal.Path(), chunks.JournalFileID))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return 0, 0, err
+		} else if err == nil {
+			journalSize = uint64(info.Size())
+		}
+	}
+
+	if oldGen, ok := gs.OldGen().(*nbs.NomsBlockStore); ok {
+		oldGenSize, err = oldGen.Size(ctx)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return journalSize, oldGenSize, nil
+}
+
 // DatasetsByRootHash returns the DatasetsMap for the specified root |hashof|.
 func (ddb *DoltDB) DatasetsByRootHash(ctx context.Context, hashof hash.Hash) (datas.DatasetsMap, error) {
 	return ddb.db.DatasetsByRootHash(ctx, hashof)
@@ -1964,6 +1990,24 @@ func (ddb *DoltDB) GetBranchesByRootHash(ctx context.Context, rootHash hash.Hash
 	return refs, nil
 }
 
+// GetDatasetsByRootHash returns the address of every dataset in the root with the given hash, keyed by dataset ID.
+func (ddb *DoltDB) GetDatasetsByRootHash(ctx context.Context, rootHash hash.Hash) (map[string]hash.Hash, error) {
+	dss, err := ddb.db.DatasetsByRootHash(ctx, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	datasets := make(map[string]hash.Hash)
+	err = dss.IterAll(ctx, func(key string, addr hash.Hash) error {
+		datasets[key] = addr
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return datasets, nil
+}
+
 // AddStash takes current branch head commit, stash root value and stash metadata to create a new stash.
 // It stores the new stash object in stash list Dataset, which can be created if it does not exist.
 // Otherwise, it updates the stash list Dataset as there can only be one stashes Dataset.
@@ -0,0 +1,294 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doltdb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+	"github.com/dolthub/dolt/go/store/nbs"
+)
+
+// HistoryPrunedTupleKey is the key of the tuple recording the boundary of the last history pruning.
+const HistoryPrunedTupleKey = "history_pruned"
+
+var ErrPruneHistoryUnsupported = errors.New("this database does not support pruning history")
+
+// PruneCommitFunc returns whether the commit given should be pruned from the history of a database.
+type PruneCommitFunc func(ctx context.Context, cm *Commit) (bool, error)
+
+// CommittedBefore returns a PruneCommitFunc which prunes commits made before |t|.
+func CommittedBefore(t time.Time) PruneCommitFunc {
+	return func(ctx context.Context, cm *Commit) (bool, error) {
+		meta, err := cm.GetCommitMeta(ctx)
+		if err != nil {
+			return false, err
+		}
+		return meta.CommitterTime().Before(t), nil
+	}
+}
+
+// AncestorsOf returns a PruneCommitFunc which prunes every ancestor of |cm|, but not |cm| itself.
+func AncestorsOf(ctx context.Context, cm *Commit) (PruneCommitFunc, error) {
+	ancestors := hash.NewHashSet()
+	toVisit := []*Commit{cm}
+	for len(toVisit) > 0 {
+		next := toVisit[len(toVisit)-1]
+		toVisit = toVisit[:len(toVisit)-1]
+
+		parents, err := next.ParentHashes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i, h := range parents {
+			if ancestors.Has(h) {
+				continue
+			}
+			ancestors.Insert(h)
+
+			optCmt, err := next.GetParent(ctx, i)
+			if err != nil {
+				return nil, err
+			}
+			if parent, ok := optCmt.ToCommit(); ok {
+				toVisit = append(toVisit, parent)
+			}
+		}
+	}
+
+	return func(ctx context.Context, cm *Commit) (bool, error) {
+		h, err := cm.HashOf()
+		if err != nil {
+			return false, err
+		}
+		return ancestors.Has(h), nil
+	}, nil
+}
+
+// PruneHistory removes the commits matched by |prune| from the history of the database, along with all of their
+// ancestors, and returns the number of commits which were pruned. Commits which are pointed to directly by a branch,
+// tag, remote tracking branch, stash, in-progress merge or rebase, or queued cherry-pick are never pruned.
+//
+// Pruned commits are recorded as ghost commits, the same way commits missing from a shallow clone are. The commits
+// which are kept retain their hashes, so they can still be pushed to and pulled from remotes which have the full
+// history, and the log and merges keep working as long as they do not need a pruned commit. Every ancestor of a kept
+// commit is referenced by its commit closure, so every pruned commit is recorded as a ghost, and not just the parents
+// of the oldest kept commits.
+//
+// Because the kept commits still reference the pruned ones, a database with pruned history can't be pushed to a
+// remote which doesn't already have that history, and can only be cloned with a depth.
+//
+// The storage used by the pruned commits is only reclaimed by the next full GC. PruneHistory records |boundary| in
+// the HistoryPrunedTupleKey tuple, which also ensures that GC is not skipped as having nothing to collect.
+func (ddb *DoltDB) PruneHistory(ctx context.Context, prune PruneCommitFunc, boundary string) (int, error) {
+	gs, ok := datas.ChunkStoreFromDatabase(ddb.db).(*nbs.GenerationalNBS)
+	if !ok {
+		return 0, ErrPruneHistoryUnsupported
+	}
+	ghostGen, ok := gs.GhostGen().(*nbs.GhostBlockStore)
+	if !ok || ghostGen == nil {
+		return 0, ErrPruneHistoryUnsupported
+	}
+
+	heads, err := ddb.historyHeads(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// First find the commits to keep, which are the heads and every commit reachable from them through commits
+	// which are not pruned. The first pruned commits on each path are where the pruned history begins.
+	kept := hash.NewHashSet()
+	var toKeep []*Commit
+	for h, cm := range heads {
+		kept.Insert(h)
+		toKeep = append(toKeep, cm)
+	}
+	pruned := hash.NewHashSet()
+	var toPrune []*Commit
+	for len(toKeep) > 0 {
+		cm := toKeep[len(toKeep)-1]
+		toKeep = toKeep[:len(toKeep)-1]
+
+		parents, err := cm.ParentHashes(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for i, h := range parents {
+			if kept.Has(h) || pruned.Has(h) {
+				continue
+			}
+			optCmt, err := cm.GetParent(ctx, i)
+			if err != nil {
+				return 0, err
+			}
+			parent, ok := optCmt.ToCommit()
+			if !ok {
+				// Already a ghost.
+				continue
+			}
+			shouldPrune, err := prune(ctx, parent)
+			if err != nil {
+				return 0, err
+			}
+			if shouldPrune {
+				pruned.Insert(h)
+				toPrune = append(toPrune, parent)
+			} else {
+				kept.Insert(h)
+				toKeep = append(toKeep, parent)
+			}
+		}
+	}
+
+	// Then prune every ancestor of the pruned commits which is not kept.
+	for len(toPrune) > 0 {
+		cm := toPrune[len(toPrune)-1]
+		toPrune = toPrune[:len(toPrune)-1]
+
+		parents, err := cm.ParentHashes(ctx)
+		if err != nil {
+			return 0, err
+		}
+		for i, h := range parents {
+			if kept.Has(h) || pruned.Has(h) {
+				continue
+			}
+			optCmt, err := cm.GetParent(ctx, i)
+			if err != nil {
+				return 0, err
+			}
+			parent, ok := optCmt.ToCommit()
+			if !ok {
+				continue
+			}
+			pruned.Insert(h)
+			toPrune = append(toPrune, parent)
+		}
+	}
+
+	if pruned.Size() == 0 {
+		return 0, nil
+	}
+
+	ghosts := ghostGen.Hashes()
+	ghosts.InsertAll(pruned)
+	err = ddb.PersistGhostCommits(ctx, ghosts)
+	if err != nil {
+		return 0, err
+	}
+
+	err = ddb.SetTuple(ctx, HistoryPrunedTupleKey, []byte(boundary))
+	if err != nil {
+		return 0, err
+	}
+
+	return pruned.Size(), nil
+}
+
+// historyHeads returns the commits which PruneHistory must keep, keyed by their hashes.
+func (ddb *DoltDB) historyHeads(ctx context.Context) (map[hash.Hash]*Commit, error) {
+	heads := make(map[hash.Hash]*Commit)
+	addHead := func(cm *Commit) error {
+		if cm == nil {
+			return nil
+		}
+		h, err := cm.HashOf()
+		if err != nil {
+			return err
+		}
+		heads[h] = cm
+		return nil
+	}
+
+	refs, err := ddb.GetHeadRefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range refs {
+		var cm *Commit
+		if tagRef, ok := r.(ref.TagRef); ok {
+			tag, err := ddb.ResolveTag(ctx, tagRef)
+			if err != nil {
+				return nil, err
+			}
+			cm = tag.Commit
+		} else {
+			cm, err = ddb.ResolveCommitRef(ctx, r)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if err = addHead(cm); err != nil {
+			return nil, err
+		}
+
+		if r.GetType() != ref.BranchRefType {
+			continue
+		}
+		wsRef, err := ref.WorkingSetRefForHead(r)
+		if err != nil {
+			return nil, err
+		}
+		ws, err := ddb.ResolveWorkingSet(ctx, wsRef)
+		if errors.Is(err, ErrWorkingSetNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		if ws.MergeState() != nil {
+			if err = addHead(ws.MergeState().Commit()); err != nil {
+				return nil, err
+			}
+			// The commits which remain to be cherry-picked once the current one is resolved, and the commit that an
+			// abort of the sequence resets to.
+			sequence := ws.MergeState().CherryPickQueue()
+			if origHead := ws.MergeState().CherryPickOrigHead(); !origHead.IsEmpty() {
+				sequence = append(sequence[:len(sequence):len(sequence)], origHead)
+			}
+			for _, h := range sequence {
+				optCmt, err := ddb.ReadCommit(ctx, h)
+				if err != nil {
+					return nil, err
+				}
+				if cm, ok := optCmt.ToCommit(); ok {
+					if err = addHead(cm); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		if ws.RebaseState() != nil {
+			if err = addHead(ws.RebaseState().OntoCommit()); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	stashes, err := ddb.GetStashes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, stash := range stashes {
+		if err = addHead(stash.HeadCommit); err != nil {
+			return nil, err
+		}
+	}
+
+	return heads, nil
+}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/dolthub/dolt/go/store/datas"
 	"github.com/dolthub/dolt/go/store/hash"
@@ -25,13 +27,41 @@ import (
 	"github.com/dolthub/dolt/go/store/types"
 )
 
+var ErrStashNotSupportedForOldFormat = errors.New("stash is not supported for old storage format")
+
 type Stash struct {
 	Name        string
 	BranchName  string
 	Description string
+	Message     string
 	HeadCommit  *Commit
 }
 
+// Summary returns the one line description of the stash entry shown when listing stashes, which is the message given
+// when the entry was made if there was one, or the head commit the entry was based on otherwise.
+func (s *Stash) Summary() (string, error) {
+	if s.Message != "" {
+		return fmt.Sprintf("On %s: %s", s.BranchName, s.Message), nil
+	}
+
+	commitHash, err := s.HeadCommit.HashOf()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("WIP on %s: %s %s", s.BranchName, commitHash.String(), s.Description), nil
+}
+
+// ParseStashIndex returns the index in the stash list of the stash entry referenced by |name|, which may be given
+// either as a stash reference such as stash@{1}, or as just the index.
+func ParseStashIndex(name string) (int, error) {
+	idxStr := strings.TrimSuffix(strings.TrimPrefix(name, "stash@{"), "}")
+	idx, err := strconv.Atoi(idxStr)
+	if err != nil || idx < 0 {
+		return 0, fmt.Errorf("error: %s is not a valid reference", idxStr)
+	}
+	return idx, nil
+}
+
 // getStashList returns array of Stash objects containing all stash entries in the stash list map.
 func getStashList(ctx context.Context, ds datas.Dataset, vrw types.ValueReadWriter, ns tree.NodeStore) ([]*Stash, error) {
 	v, ok := ds.MaybeHead()
@@ -75,6 +105,7 @@ func getStashList(ctx context.Context, ds datas.Dataset, vrw types.ValueReadWrit
 		s.HeadCommit = headCommit
 		s.BranchName = meta.BranchName
 		s.Description = meta.Description
+		s.Message = meta.Message
 
 		sl[i] = &s
 	}
@@ -191,6 +191,7 @@ var getWriteableSystemTables = func() []string {
 		SchemasTableName,
 		ProceduresTableName,
 		IgnoreTableName,
+		CommitChecksTableName,
 		GetRebaseTableName(),
 
 		// TODO: find way to make these writable by the dolt process
@@ -270,6 +271,14 @@ const (
 	QueryCatalogDescriptionCol = "description"
 )
 
+const (
+	// CommitChecksNameCol is the name of the primary key column of the commit checks table
+	CommitChecksNameCol = "name"
+	// CommitChecksQueryCol is the name of the column containing the assertion query of a commit check. A commit is
+	// rejected if the query returns any rows.
+	CommitChecksQueryCol = "query"
+)
+
 const (
 	// SchemasTableName is the name of the dolt schema fragment table
 	SchemasTableName = "dolt_schemas"
@@ -375,6 +384,11 @@ var GetStatusTableName = func() string {
 	return StatusTableName
 }
 
+// GetStashesTableName returns the stashes system table name
+var GetStashesTableName = func() string {
+	return StashesTableName
+}
+
 // GetTagsTableName returns the tags table name
 var GetTagsTableName = func() string {
 	return TagsTableName
@@ -420,12 +434,22 @@ const (
 	// MergeStatusTableName is the merge status system table name.
 	MergeStatusTableName = "dolt_merge_status"
 
+	// StashesTableName is the stashes system table name
+	StashesTableName = "dolt_stashes"
+
+	// GCStatusTableName is the name of the generated system table that reports the state of automatic garbage
+	// collection for a database
+	GCStatusTableName = "dolt_gc_status"
+
 	// TagsTableName is the tags table name
 	TagsTableName = "dolt_tags"
 
 	// IgnoreTableName is the ignore table name
 	IgnoreTableName = "dolt_ignore"
 
+	// CommitChecksTableName is the name of the table holding the SQL assertions that are run before each commit
+	CommitChecksTableName = "dolt_commit_checks"
+
 	// RebaseTableName is the rebase system table name.
 	RebaseTableName = "dolt_rebase"
 
@@ -568,6 +592,9 @@ const (
 
 	// WorkflowSavedQueryStepExpectedRowColumnResultsUpdatedAtColName is the name of the updated at column on the workflow saved query step expected row column results table
 	WorkflowSavedQueryStepExpectedRowColumnResultsUpdatedAtColName = "updated_at"
+
+	// WorkflowRunsTableName is the name of the generated system table that records the history of workflow runs
+	WorkflowRunsTableName = "dolt_ci_workflow_runs"
 )
 
 const (
@@ -322,9 +322,25 @@ func (t *Table) getProllyConflictSchemas(ctx context.Context, tblName TableName)
 		return nil, nil, nil, err
 	}
 
+	// If the merge changed the primary key of the table, the rows of the other
+	// sides are re-keyed into our primary key, and so are their schemas.
+	if baseSch, err = rekeyConflictSchema(t.Format(), baseSch, ourSch); err != nil {
+		return nil, nil, nil, err
+	}
+	if theirSch, err = rekeyConflictSchema(t.Format(), theirSch, ourSch); err != nil {
+		return nil, nil, nil, err
+	}
+
 	return baseSch, ourSch, theirSch, nil
 }
 
+func rekeyConflictSchema(format *types.NomsBinFormat, sch, ourSch schema.Schema) (schema.Schema, error) {
+	if schema.ArePrimaryKeySetsDiffable(format, sch, ourSch) {
+		return sch, nil
+	}
+	return schema.RekeySchema(sch, ourSch)
+}
+
 func tableFromRootIsh(ctx context.Context, vrw types.ValueReadWriter, ns tree.NodeStore, h hash.Hash, tblName TableName) (*Table, bool, error) {
 	rv, err := LoadRootValueFromRootIshAddr(ctx, vrw, ns, h)
 	if err != nil {
@@ -131,6 +131,13 @@ type MergeState struct {
 	// isCherryPick is set to true when the in-progress merge is a cherry-pick. This is needed so that
 	// commit knows to NOT create a commit with multiple parents when creating a commit for a cherry-pick.
 	isCherryPick bool
+	// cherryPickQueue holds the addresses of the commits that remain to be cherry-picked, in order, when a
+	// cherry-pick of several commits stopped on |commit| because of conflicts.
+	cherryPickQueue []hash.Hash
+	// cherryPickOrigHead is the address of the commit that HEAD pointed to before a cherry-pick of several commits
+	// started, so that aborting the cherry-pick can undo the commits that were already applied. It is empty when the
+	// cherry-pick is not part of a sequence.
+	cherryPickOrigHead hash.Hash
 }
 
 // todo(andy): this might make more sense in pkg merge
@@ -177,6 +184,18 @@ func (m MergeState) IsCherryPick() bool {
 	return m.isCherryPick
 }
 
+// CherryPickQueue returns the addresses of the commits that remain to be cherry-picked once the conflicts from the
+// current cherry-pick have been resolved.
+func (m MergeState) CherryPickQueue() []hash.Hash {
+	return m.cherryPickQueue
+}
+
+// CherryPickOrigHead returns the address of the commit that HEAD pointed to before the sequence of commits being
+// cherry-picked started, or an empty hash if the current cherry-pick is not part of a sequence.
+func (m MergeState) CherryPickOrigHead() hash.Hash {
+	return m.cherryPickOrigHead
+}
+
 func (m MergeState) PreMergeWorkingRoot() RootValue {
 	return m.preMergeWorking
 }
@@ -355,6 +374,17 @@ func (ws WorkingSet) StartCherryPick(commit *Commit, commitSpecStr string) *Work
 	return &ws
 }
 
+// WithCherryPickQueue returns a copy of |ws| recording |queue| as the commits that remain to be cherry-picked after
+// the in-progress cherry-pick, and |origHead| as the commit that HEAD pointed to before the sequence started. The
+// working set must have an in-progress cherry-pick.
+func (ws WorkingSet) WithCherryPickQueue(queue []hash.Hash, origHead hash.Hash) *WorkingSet {
+	ms := *ws.mergeState
+	ms.cherryPickQueue = queue
+	ms.cherryPickOrigHead = origHead
+	ws.mergeState = &ms
+	return &ws
+}
+
 func (ws WorkingSet) AbortMerge() *WorkingSet {
 	ws.workingRoot = ws.mergeState.PreMergeWorkingRoot()
 	ws.stagedRoot = ws.workingRoot
@@ -502,14 +532,26 @@ func newWorkingSet(ctx context.Context, name string, vrw types.ValueReadWriter,
 			return nil, err
 		}
 
+		cherryPickQueue, err := dsws.MergeState.CherryPickQueue(ctx, vrw)
+		if err != nil {
+			return nil, err
+		}
+
+		cherryPickOrigHead, err := dsws.MergeState.CherryPickOrigHead(ctx, vrw)
+		if err != nil {
+			return nil, err
+		}
+
 		unmergableTableNames := ToTableNames(unmergableTables, DefaultSchemaName)
 
 		mergeState = &MergeState{
-			commit:           commit,
-			commitSpecStr:    commitSpec,
-			preMergeWorking:  preMergeWorkingRoot,
-			unmergableTables: unmergableTableNames,
-			isCherryPick:     isCherryPick,
+			commit:             commit,
+			commitSpecStr:      commitSpec,
+			preMergeWorking:    preMergeWorkingRoot,
+			unmergableTables:   unmergableTableNames,
+			isCherryPick:       isCherryPick,
+			cherryPickQueue:    cherryPickQueue,
+			cherryPickOrigHead: cherryPickOrigHead,
 		}
 	}
 
@@ -621,7 +663,7 @@ func (ws *WorkingSet) writeValues(ctx context.Context, db *DoltDB, meta *datas.W
 		}
 
 		// TODO: Serialize the full TableName
-		mergeState, err = datas.NewMergeState(ctx, db.vrw, preMergeWorking, dCommit, ws.mergeState.commitSpecStr, FlattenTableNames(ws.mergeState.unmergableTables), ws.mergeState.isCherryPick)
+		mergeState, err = datas.NewMergeState(ctx, db.vrw, preMergeWorking, dCommit, ws.mergeState.commitSpecStr, FlattenTableNames(ws.mergeState.unmergableTables), ws.mergeState.isCherryPick, ws.mergeState.cherryPickQueue, ws.mergeState.cherryPickOrigHead)
 		if err != nil {
 			return nil, err
 		}
@@ -55,6 +55,7 @@ var ErrFailedToDeleteBranch = errors.New("could not delete local branch after cl
 var ErrUserNotFound = errors.New("could not determine user name. run dolt config --global --add user.name")
 var ErrEmailNotFound = errors.New("could not determine email. run dolt config --global --add user.email")
 var ErrCloneFailed = errors.New("clone failed")
+var ErrClonePrunedHistory = errors.New("the history of the remote database has been pruned, so it can only be cloned with --depth")
 
 // EnvForClone creates a new DoltEnv and configures it with repo state from the specified remote. The returned DoltEnv is ready for content to be cloned into it. The directory used for the new DoltEnv is determined by resolving the specified dir against the specified Filesys.
 func EnvForClone(ctx context.Context, nbf *types.NomsBinFormat, r env.Remote, dir string, fs filesys.Filesys, version string, homeProvider env.HomeDirProvider) (*env.DoltEnv, error) {
@@ -180,6 +181,18 @@ func CloneRemote(ctx context.Context, srcDB *doltdb.DoltDB, remoteName, branch s
 		remoteName = "origin"
 	}
 
+	if depth <= 0 {
+		// A full clone copies the table files of the remote, which no longer contain the commits pruned from its
+		// history, without recording them as ghost commits.
+		_, pruned, err := srcDB.GetTuple(ctx, doltdb.HistoryPrunedTupleKey)
+		if err != nil {
+			return fmt.Errorf("%w; %s", ErrCloneFailed, err.Error())
+		}
+		if pruned {
+			return fmt.Errorf("%w; %s", ErrCloneFailed, ErrClonePrunedHistory.Error())
+		}
+	}
+
 	var checkedOutCommit *doltdb.Commit
 
 	// Step 1) Pull the remote information we care about to a local disk.
@@ -16,6 +16,7 @@ package actions
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
@@ -32,6 +33,12 @@ type CommitStagedProps struct {
 	Force      bool
 	Name       string
 	Email      string
+
+	// CommitterName and CommitterEmail identify who is creating the commit, when that is not the author identified
+	// by Name and Email. When empty, the author is recorded as the committer.
+	CommitterName  string
+	CommitterEmail string
+	Trailers       []datas.CommitTrailer
 }
 
 // GetCommitStaged returns a new pending commit with the roots and commit properties given.
@@ -111,6 +118,9 @@ func GetCommitStaged(
 	if err != nil {
 		return nil, err
 	}
+	meta.CommitterName = strings.TrimSpace(props.CommitterName)
+	meta.CommitterEmail = strings.TrimSpace(props.CommitterEmail)
+	meta.Trailers = props.Trailers
 
 	return db.NewPendingCommit(ctx, roots, mergeParents, meta)
 }
@@ -66,12 +66,13 @@ type queryFunc func(ctx *sql.Context, query string) (sql.Schema, sql.RowIter, *s
 func HasDoltCITables(ctx *sql.Context) (bool, error) {
 	dbName := ctx.GetCurrentDatabase()
 	dSess := dsess.DSessFromSess(ctx.Session)
-	ws, err := dSess.WorkingSet(ctx, dbName)
-	if err != nil {
-		return false, err
+	// Roots are used rather than the working set so that read-only revision databases, such as a commit, are supported
+	roots, ok := dSess.GetRoots(ctx, dbName)
+	if !ok {
+		return false, sql.ErrDatabaseNotFound.New(dbName)
 	}
 
-	root := ws.WorkingRoot()
+	root := roots.Working
 	activeOnly := ExpectedDoltCITablesOrdered.ActiveTableNames()
 
 	exists := 0
@@ -48,6 +48,8 @@ type WorkflowManager interface {
 	GetWorkflowConfig(ctx *sql.Context, db sqle.Database, workflowName string) (*WorkflowConfig, error)
 	// StoreAndCommit creates or updates a workflow and creates a Dolt commit
 	StoreAndCommit(ctx *sql.Context, db sqle.Database, config *WorkflowConfig) error
+	// RunWorkflow executes the saved query steps of a workflow against a branch and returns the results.
+	RunWorkflow(ctx *sql.Context, db sqle.Database, workflowName, branch string) (*WorkflowRunResult, error)
 }
 
 type doltWorkflowManager struct {
@@ -0,0 +1,257 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+)
+
+var ErrSavedQueryNotFound = errors.New("saved query not found")
+
+// WorkflowRunResult is the result of running every job in a workflow against a single branch.
+type WorkflowRunResult struct {
+	WorkflowName string
+	Branch       string
+	StartedAt    time.Time
+	CompletedAt  time.Time
+	Jobs         []*JobRunResult
+}
+
+// Passed returns true if every step of every job in the workflow run passed.
+func (r *WorkflowRunResult) Passed() bool {
+	for _, job := range r.Jobs {
+		if !job.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// JobRunResult is the result of running the steps of a single workflow job.
+type JobRunResult struct {
+	JobName string
+	Steps   []*StepRunResult
+}
+
+// Passed returns true if every step of the job passed.
+func (r *JobRunResult) Passed() bool {
+	for _, step := range r.Steps {
+		if !step.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// StepRunResult is the result of running a single saved query step. Err is set if the saved query could not be
+// executed, and Failures holds a description of each expected result assertion that did not hold.
+type StepRunResult struct {
+	StepName       string
+	SavedQueryName string
+	Query          string
+	RowCount       int64
+	ColumnCount    int64
+	Failures       []string
+	Err            error
+}
+
+// Passed returns true if the step's query executed and all of its assertions held.
+func (r *StepRunResult) Passed() bool {
+	return r.Err == nil && len(r.Failures) == 0
+}
+
+func (d *doltWorkflowManager) selectQueryFromQueryCatalogByNameQuery(savedQueryName string) string {
+	return fmt.Sprintf("select `%s` from %s where `%s` = '%s' limit 1;", doltdb.QueryCatalogQueryCol, doltdb.DoltQueryCatalogTableName, doltdb.QueryCatalogNameCol, savedQueryName)
+}
+
+func (d *doltWorkflowManager) useDatabaseQuery(dbName string) string {
+	return fmt.Sprintf("use `%s`;", dbName)
+}
+
+func (d *doltWorkflowManager) getSavedQuery(ctx *sql.Context, savedQueryName string) (string, error) {
+	_, rowIter, _, err := d.queryFunc(ctx, d.selectQueryFromQueryCatalogByNameQuery(savedQueryName))
+	if err != nil {
+		return "", err
+	}
+
+	rows, err := sql.RowIterToRows(ctx, rowIter)
+	if err != nil {
+		return "", err
+	}
+	if len(rows) < 1 {
+		return "", fmt.Errorf("%w: %s", ErrSavedQueryNotFound, savedQueryName)
+	}
+
+	query, ok := rows[0][0].(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected type for saved query %s: %T", savedQueryName, rows[0][0])
+	}
+	return query, nil
+}
+
+func (d *doltWorkflowManager) runSavedQueryStep(ctx *sql.Context, step Step) *StepRunResult {
+	result := &StepRunResult{
+		StepName:       step.Name.Value,
+		SavedQueryName: step.SavedQueryName.Value,
+	}
+
+	query, err := d.getSavedQuery(ctx, step.SavedQueryName.Value)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.Query = query
+
+	sch, rowIter, _, err := d.queryFunc(ctx, query)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	rows, err := sql.RowIterToRows(ctx, rowIter)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	result.ColumnCount = int64(len(sch))
+	result.RowCount = int64(len(rows))
+
+	if step.ExpectedColumns.Value != "" {
+		failure, err := d.assertSavedQueryExpectedResult("column", step.ExpectedColumns.Value, result.ColumnCount)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		if failure != "" {
+			result.Failures = append(result.Failures, failure)
+		}
+	}
+
+	if step.ExpectedRows.Value != "" {
+		failure, err := d.assertSavedQueryExpectedResult("row", step.ExpectedRows.Value, result.RowCount)
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		if failure != "" {
+			result.Failures = append(result.Failures, failure)
+		}
+	}
+
+	return result
+}
+
+// assertSavedQueryExpectedResult checks |actual| against the |expected| comparison string, returning a description of
+// the failure if the assertion does not hold, or an empty string if it does.
+func (d *doltWorkflowManager) assertSavedQueryExpectedResult(kind, expected string, actual int64) (string, error) {
+	comparisonType, count, err := d.parseSavedQueryExpectedResultString(expected)
+	if err != nil {
+		return "", err
+	}
+
+	ok, err := compareSavedQueryExpectedResult(comparisonType, actual, count)
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return "", nil
+	}
+
+	expectedStr, err := d.toSavedQueryExpectedResultString(comparisonType, count)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("expected %s count %s, got %d", kind, expectedStr, actual), nil
+}
+
+func compareSavedQueryExpectedResult(comparisonType WorkflowSavedQueryExpectedRowColumnComparisonType, actual, expected int64) (bool, error) {
+	switch comparisonType {
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeUnspecified:
+		return true, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeEquals:
+		return actual == expected, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeNotEquals:
+		return actual != expected, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeLessThan:
+		return actual < expected, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeGreaterThan:
+		return actual > expected, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeLessThanOrEqual:
+		return actual <= expected, nil
+	case WorkflowSavedQueryExpectedRowColumnComparisonTypeGreaterThanOrEqual:
+		return actual >= expected, nil
+	default:
+		return false, ErrUnknownWorkflowSavedQueryExpectedRowColumnComparisonType
+	}
+}
+
+func (d *doltWorkflowManager) runWorkflowConfig(ctx *sql.Context, config *WorkflowConfig, branch string) (result *WorkflowRunResult, err error) {
+	result = &WorkflowRunResult{
+		WorkflowName: config.Name.Value,
+		Branch:       branch,
+		StartedAt:    time.Now(),
+	}
+
+	if branch != "" {
+		currentDb := ctx.GetCurrentDatabase()
+		baseName, _ := dsess.SplitRevisionDbName(currentDb)
+		err = sqlWriteQuery(ctx, d.queryFunc, d.useDatabaseQuery(fmt.Sprintf("%s/%s", baseName, branch)))
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			rerr := sqlWriteQuery(ctx, d.queryFunc, d.useDatabaseQuery(currentDb))
+			if err == nil {
+				err = rerr
+			}
+		}()
+	}
+
+	for _, job := range config.Jobs {
+		jobResult := &JobRunResult{JobName: job.Name.Value}
+		for _, step := range job.Steps {
+			jobResult.Steps = append(jobResult.Steps, d.runSavedQueryStep(ctx, step))
+		}
+		result.Jobs = append(result.Jobs, jobResult)
+	}
+
+	result.CompletedAt = time.Now()
+	return result, nil
+}
+
+// RunWorkflow executes the saved query steps of every job in the named workflow against |branch|, or against the
+// current branch if |branch| is empty, and returns the result of each step's expected result assertions.
+func (d *doltWorkflowManager) RunWorkflow(ctx *sql.Context, db sqle.Database, workflowName, branch string) (*WorkflowRunResult, error) {
+	if err := dsess.CheckAccessForDb(ctx, db, branch_control.Permissions_Read); err != nil {
+		return nil, err
+	}
+
+	config, err := d.getWorkflowConfig(ctx, workflowName)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.runWorkflowConfig(ctx, config, branch)
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssertSavedQueryExpectedResult(t *testing.T) {
+	d := &doltWorkflowManager{}
+
+	tests := []struct {
+		expected string
+		actual   int64
+		failure  string
+	}{
+		{expected: "2", actual: 2},
+		{expected: "2", actual: 3, failure: "expected row count == 2, got 3"},
+		{expected: "== 2", actual: 2},
+		{expected: "!= 2", actual: 3},
+		{expected: "!= 2", actual: 2, failure: "expected row count != 2, got 2"},
+		{expected: "> 2", actual: 3},
+		{expected: "> 2", actual: 2, failure: "expected row count > 2, got 2"},
+		{expected: ">= 2", actual: 2},
+		{expected: "< 2", actual: 1},
+		{expected: "< 2", actual: 2, failure: "expected row count < 2, got 2"},
+		{expected: "<= 2", actual: 2},
+		{expected: "<= 2", actual: 3, failure: "expected row count <= 2, got 3"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expected, func(t *testing.T) {
+			failure, err := d.assertSavedQueryExpectedResult("row", test.expected, test.actual)
+			require.NoError(t, err)
+			require.Equal(t, test.failure, failure)
+		})
+	}
+
+	_, err := d.assertSavedQueryExpectedResult("row", "~ 2", 2)
+	require.Error(t, err)
+}
+
+func TestWorkflowRunResultPassed(t *testing.T) {
+	result := &WorkflowRunResult{
+		Jobs: []*JobRunResult{
+			{Steps: []*StepRunResult{{StepName: "a"}, {StepName: "b"}}},
+		},
+	}
+	require.True(t, result.Passed())
+
+	result.Jobs[0].Steps[1].Failures = []string{"expected row count == 1, got 0"}
+	require.False(t, result.Passed())
+}
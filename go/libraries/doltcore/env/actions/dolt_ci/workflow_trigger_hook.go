@@ -0,0 +1,300 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/google/uuid"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+	"github.com/dolthub/dolt/go/store/datas"
+)
+
+const (
+	workflowTriggerBufferSize = 256
+	workflowTriggerThreadName = "dolt_ci_workflow_triggers"
+
+	// WorkflowRunEventPush is the event recorded for workflow runs triggered by an update to a branch head
+	WorkflowRunEventPush = "push"
+)
+
+type workflowTrigger struct {
+	branch     string
+	commitHash string
+	// client is the client of the session that updated the branch
+	client sql.Client
+}
+
+// WorkflowContextFactory creates the sql.Context a triggered workflow is run with, for a new session of |client|.
+type WorkflowContextFactory func(ctx context.Context, client sql.Client) (*sql.Context, error)
+
+// WorkflowTriggerHook is a doltdb.CommitHook that runs every workflow with a push event trigger matching a branch
+// whenever the head of that branch is updated, for example by a commit, a merge or a reset. Workflows are run
+// asynchronously on a background thread so that they do not block the commit, against the commit the branch was
+// updated to rather than the branch's current head, which may have moved again before they run. The result of each
+// run is recorded in a dtables.WorkflowRunHistory.
+//
+// Workflows are run with the client, and so the privileges, of the session that updated the branch, in a read-only
+// transaction. Branch updates that aren't made by a SQL session don't trigger any workflows.
+type WorkflowTriggerHook struct {
+	dbName     string
+	ctxFactory WorkflowContextFactory
+	queryFunc  queryFunc
+	history    *dtables.WorkflowRunHistory
+	ch         chan workflowTrigger
+	out        io.Writer
+}
+
+var _ doltdb.CommitHook = (*WorkflowTriggerHook)(nil)
+
+// NewWorkflowTriggerHook creates a WorkflowTriggerHook for the database named |dbName| and starts the background
+// thread that runs triggered workflows. |ctxFactory| is used to create the sql.Context each workflow is run with, and
+// |queryFunc| to execute the workflow's queries.
+func NewWorkflowTriggerHook(bThreads *sql.BackgroundThreads, dbName string, ctxFactory WorkflowContextFactory, queryFunc queryFunc, history *dtables.WorkflowRunHistory) (*WorkflowTriggerHook, error) {
+	h := &WorkflowTriggerHook{
+		dbName:     dbName,
+		ctxFactory: ctxFactory,
+		queryFunc:  queryFunc,
+		history:    history,
+		ch:         make(chan workflowTrigger, workflowTriggerBufferSize),
+	}
+
+	err := bThreads.Add(fmt.Sprintf("%s_%s", workflowTriggerThreadName, dbName), func(ctx context.Context) {
+		for {
+			select {
+			case t := <-h.ch:
+				h.runTriggeredWorkflows(ctx, t)
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// Execute implements doltdb.CommitHook. Updates to branch heads are queued to be checked for matching workflows. If
+// too many updates are already queued, the update is dropped rather than blocking the commit.
+func (h *WorkflowTriggerHook) Execute(ctx context.Context, ds datas.Dataset, _ datas.Database) (func(context.Context) error, error) {
+	addr, ok := ds.MaybeHeadAddr()
+	if !ok {
+		return nil, nil
+	}
+
+	if !ref.IsRef(ds.ID()) {
+		return nil, nil
+	}
+	dref, err := ref.Parse(ds.ID())
+	if err != nil {
+		return nil, err
+	}
+	if dref.GetType() != ref.BranchRefType {
+		return nil, nil
+	}
+
+	// Workflows run with the privileges of the user that updated the branch, so there must be one
+	sqlCtx, ok := ctx.(*sql.Context)
+	if !ok || sqlCtx.Session == nil {
+		return nil, nil
+	}
+
+	select {
+	case h.ch <- workflowTrigger{branch: dref.GetPath(), commitHash: addr.String(), client: sqlCtx.Session.Client()}:
+	default:
+		h.logf("too many pending workflow triggers for database %s, dropping update of branch %s\n", h.dbName, dref.GetPath())
+	}
+	return nil, nil
+}
+
+// HandleError implements doltdb.CommitHook
+func (h *WorkflowTriggerHook) HandleError(ctx context.Context, err error) error {
+	if h.out != nil {
+		h.out.Write([]byte(fmt.Sprintf("error queuing workflows of database %s: %s\n", h.dbName, err.Error())))
+	}
+	return nil
+}
+
+// SetLogger implements doltdb.CommitHook
+func (h *WorkflowTriggerHook) SetLogger(ctx context.Context, wr io.Writer) error {
+	h.out = wr
+	return nil
+}
+
+// ExecuteForWorkingSets implements doltdb.CommitHook
+func (*WorkflowTriggerHook) ExecuteForWorkingSets() bool {
+	return false
+}
+
+func (h *WorkflowTriggerHook) logf(format string, args ...interface{}) {
+	if h.out != nil {
+		h.out.Write([]byte(fmt.Sprintf(format, args...)))
+	}
+}
+
+func (h *WorkflowTriggerHook) runTriggeredWorkflows(ctx context.Context, t workflowTrigger) {
+	sqlCtx, err := h.ctxFactory(ctx, t.client)
+	if err != nil {
+		h.logf("error running workflows for branch %s: %s\n", t.branch, err.Error())
+		return
+	}
+
+	// Workflows read the commit the branch was updated to, using a read-only revision database, and can't write to
+	// any other database either
+	for _, query := range []string{fmt.Sprintf("use `%s/%s`;", h.dbName, t.commitHash), "start transaction read only;"} {
+		if err = sqlWriteQuery(sqlCtx, h.queryFunc, query); err != nil {
+			h.logf("error running workflows for branch %s: %s\n", t.branch, err.Error())
+			return
+		}
+	}
+
+	hasTables, err := HasDoltCITables(sqlCtx)
+	if err != nil || !hasTables {
+		return
+	}
+
+	wm := NewWorkflowManager("", "", h.queryFunc)
+	workflows, err := wm.listWorkflows(sqlCtx)
+	if err != nil {
+		h.logf("error listing workflows for branch %s: %s\n", t.branch, err.Error())
+		return
+	}
+
+	for _, workflow := range workflows {
+		config, err := wm.getWorkflowConfig(sqlCtx, string(*workflow.Name))
+		if err != nil {
+			h.recordError(string(*workflow.Name), t, time.Now(), err)
+			continue
+		}
+		if !pushTriggerMatchesBranch(config, t.branch) {
+			continue
+		}
+
+		startedAt := time.Now()
+		result, err := wm.runWorkflowConfig(sqlCtx, config, "")
+		if err != nil {
+			h.recordError(config.Name.Value, t, startedAt, err)
+			continue
+		}
+		result.Branch = t.branch
+		h.addRun(newWorkflowRun(h.dbName, t, result))
+	}
+}
+
+func (h *WorkflowTriggerHook) addRun(run dtables.WorkflowRun) {
+	if err := h.history.Add(run); err != nil {
+		h.logf("error recording run of workflow %s for branch %s: %s\n", run.WorkflowName, run.Branch, err.Error())
+	}
+}
+
+func (h *WorkflowTriggerHook) recordError(workflowName string, t workflowTrigger, startedAt time.Time, err error) {
+	h.addRun(dtables.WorkflowRun{
+		ID:           uuid.NewString(),
+		Database:     h.dbName,
+		WorkflowName: workflowName,
+		Event:        WorkflowRunEventPush,
+		Branch:       t.branch,
+		CommitHash:   t.commitHash,
+		Status:       dtables.WorkflowRunStatusError,
+		Error:        err.Error(),
+		StartedAt:    startedAt,
+		CompletedAt:  time.Now(),
+	})
+}
+
+func newWorkflowRun(dbName string, t workflowTrigger, result *WorkflowRunResult) dtables.WorkflowRun {
+	status := dtables.WorkflowRunStatusPassed
+	if !result.Passed() {
+		status = dtables.WorkflowRunStatusFailed
+	}
+
+	steps := make([]dtables.WorkflowRunStepResult, 0)
+	for _, job := range result.Jobs {
+		for _, step := range job.Steps {
+			sr := dtables.WorkflowRunStepResult{
+				Job:         job.JobName,
+				Step:        step.StepName,
+				SavedQuery:  step.SavedQueryName,
+				Passed:      step.Passed(),
+				RowCount:    step.RowCount,
+				ColumnCount: step.ColumnCount,
+				Failures:    step.Failures,
+			}
+			if step.Err != nil {
+				sr.Error = step.Err.Error()
+			}
+			steps = append(steps, sr)
+		}
+	}
+
+	return dtables.WorkflowRun{
+		ID:           uuid.NewString(),
+		Database:     dbName,
+		WorkflowName: result.WorkflowName,
+		Event:        WorkflowRunEventPush,
+		Branch:       t.branch,
+		CommitHash:   t.commitHash,
+		Status:       status,
+		Steps:        steps,
+		StartedAt:    result.StartedAt,
+		CompletedAt:  result.CompletedAt,
+	}
+}
+
+// pushTriggerMatchesBranch returns whether |config| has a push event trigger for |branch|. A push trigger without any
+// branches matches every branch, and branch filters may be glob patterns, such as "release/*".
+func pushTriggerMatchesBranch(config *WorkflowConfig, branch string) bool {
+	if config.On.Push == nil {
+		return false
+	}
+	if len(config.On.Push.Branches) == 0 {
+		return true
+	}
+	for _, b := range config.On.Push.Branches {
+		if b.Value == branch {
+			return true
+		}
+		if matched, err := path.Match(b.Value, branch); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// NewWorkflowTriggerInitDatabaseHook returns a sqle.InitDatabaseHook that installs a WorkflowTriggerHook on each
+// database created after the server has started.
+func NewWorkflowTriggerInitDatabaseHook(bThreads *sql.BackgroundThreads, ctxFactory WorkflowContextFactory, queryFunc queryFunc, history *dtables.WorkflowRunHistory) sqle.InitDatabaseHook {
+	return func(ctx *sql.Context, _ *sqle.DoltDatabaseProvider, name string, denv *env.DoltEnv, _ dsess.SqlDatabase) error {
+		hook, err := NewWorkflowTriggerHook(bThreads, name, ctxFactory, queryFunc, history)
+		if err != nil {
+			return err
+		}
+		denv.DoltDB.PrependCommitHook(ctx, hook)
+		return nil
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dolt_ci
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+func TestPushTriggerMatchesBranch(t *testing.T) {
+	parse := func(yml string) *WorkflowConfig {
+		config, err := ParseWorkflowConfig(strings.NewReader(yml))
+		require.NoError(t, err)
+		return config
+	}
+
+	allBranches := parse(`name: wf
+on:
+  push: {}
+jobs:
+  - name: job
+    steps:
+      - name: step
+        saved_query_name: sq
+`)
+	require.True(t, pushTriggerMatchesBranch(allBranches, "main"))
+	require.True(t, pushTriggerMatchesBranch(allBranches, "feature"))
+
+	someBranches := parse(`name: wf
+on:
+  push:
+    branches:
+      - main
+      - release/*
+jobs:
+  - name: job
+    steps:
+      - name: step
+        saved_query_name: sq
+`)
+	require.True(t, pushTriggerMatchesBranch(someBranches, "main"))
+	require.True(t, pushTriggerMatchesBranch(someBranches, "release/1.0"))
+	require.False(t, pushTriggerMatchesBranch(someBranches, "feature"))
+	require.False(t, pushTriggerMatchesBranch(someBranches, "release/1.0/hotfix"))
+
+	dispatchOnly := parse(`name: wf
+on:
+  workflow_dispatch: {}
+jobs:
+  - name: job
+    steps:
+      - name: step
+        saved_query_name: sq
+`)
+	require.False(t, pushTriggerMatchesBranch(dispatchOnly, "main"))
+}
+
+func TestWorkflowTriggerHookExecute(t *testing.T) {
+	ctx := context.Background()
+	ddb, err := doltdb.LoadDoltDB(ctx, types.Format_Default, doltdb.InMemDoltDB, filesys.EmptyInMemFS("/"))
+	require.NoError(t, err)
+	require.NoError(t, ddb.WriteEmptyRepo(ctx, "main", "Bats Tests", "bats@email.fake"))
+	ds, err := doltdb.HackDatasDatabaseFromDoltDB(ddb).GetDataset(ctx, "refs/heads/main")
+	require.NoError(t, err)
+
+	h := &WorkflowTriggerHook{dbName: "db", ch: make(chan workflowTrigger, 1)}
+
+	// branch updates without a SQL session have no user to run workflows as
+	_, err = h.Execute(ctx, ds, nil)
+	require.NoError(t, err)
+	require.Len(t, h.ch, 0)
+
+	sess := sql.NewBaseSession()
+	sess.SetClient(sql.Client{User: "alice", Address: "localhost"})
+	_, err = h.Execute(sql.NewContext(ctx, sql.WithSession(sess)), ds, nil)
+	require.NoError(t, err)
+	require.Len(t, h.ch, 1)
+	trigger := <-h.ch
+	require.Equal(t, "main", trigger.branch)
+	require.Equal(t, "alice", trigger.client.User)
+
+	// a full queue drops the update instead of blocking the commit
+	h.ch <- trigger
+	_, err = h.Execute(sql.NewContext(ctx, sql.WithSession(sess)), ds, nil)
+	require.NoError(t, err)
+	require.Len(t, h.ch, 1)
+}
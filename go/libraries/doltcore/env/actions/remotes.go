@@ -43,6 +43,8 @@ var ErrFailedToDeleteRemote = errors.New("failed to delete remote")
 var ErrFailedToGetRemoteDb = errors.New("failed to get remote db")
 var ErrUnknownPushErr = errors.New("unknown push error")
 var ErrShallowPushImpossible = errors.New("shallow repository missing chunks to complete push")
+var ErrPrunedHistoryPushImpossible = errors.New("repository with pruned history missing chunks to complete push; " +
+	"only remotes which already have the pruned history can be pushed to")
 
 type ProgStarter func(ctx context.Context) (*sync.WaitGroup, chan pull.Stats)
 type ProgStopper func(cancel context.CancelFunc, wg *sync.WaitGroup, statsCh chan pull.Stats)
@@ -73,6 +75,11 @@ func Push(ctx context.Context, tempTableDir string, mode ref.UpdateMode, destRef
 
 	if errors.Is(err, nbs.ErrGhostChunkRequested) {
 		err = ErrShallowPushImpossible
+		if _, pruned, tupErr := srcDB.GetTuple(ctx, doltdb.HistoryPrunedTupleKey); tupErr != nil {
+			err = tupErr
+		} else if pruned {
+			err = ErrPrunedHistoryPushImpossible
+		}
 	}
 
 	if err != nil {
@@ -247,7 +254,7 @@ func PushToRemoteBranch(ctx context.Context, rsr env.RepoStateReader, tempTableD
 	case nil:
 		cli.Println()
 		return nil
-	case doltdb.ErrUpToDate, doltdb.ErrIsAhead, ErrCantFF, datas.ErrMergeNeeded, datas.ErrDirtyWorkspace, ErrShallowPushImpossible:
+	case doltdb.ErrUpToDate, doltdb.ErrIsAhead, ErrCantFF, datas.ErrMergeNeeded, datas.ErrDirtyWorkspace, ErrShallowPushImpossible, ErrPrunedHistoryPushImpossible:
 		return err
 	default:
 		return fmt.Errorf("%w; %s", ErrUnknownPushErr, err.Error())
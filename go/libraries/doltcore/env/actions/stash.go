@@ -0,0 +1,230 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// HasStashableChanges returns whether |roots| contain any changes that would be saved by a stash. Untracked tables
+// are only considered when |includeUntracked| is set, and ignored tables only when |all| is set.
+func HasStashableChanges(ctx context.Context, roots doltdb.Roots, includeUntracked, all bool) (bool, error) {
+	headHash, err := roots.Head.HashOf()
+	if err != nil {
+		return false, err
+	}
+	workingHash, err := roots.Working.HashOf()
+	if err != nil {
+		return false, err
+	}
+	stagedHash, err := roots.Staged.HashOf()
+	if err != nil {
+		return false, err
+	}
+
+	// Are there staged changes? If so, stash them.
+	if !headHash.Equal(stagedHash) {
+		return true, nil
+	}
+
+	// No staged changes, but are there any unstaged changes? If not, no work is needed.
+	if headHash.Equal(workingHash) {
+		return false, nil
+	}
+
+	// There are unstaged changes, is --all set? If so, nothing else matters. Stash them.
+	if all {
+		return true, nil
+	}
+
+	// --all was not set, so we can ignore tables. Is every table ignored?
+	allIgnored, err := diff.WorkingSetContainsOnlyIgnoredTables(ctx, roots)
+	if err != nil {
+		return false, err
+	}
+
+	if allIgnored {
+		return false, nil
+	}
+
+	// There are unignored, unstaged tables. Is --include-untracked set. If so, nothing else matters. Stash them.
+	if includeUntracked {
+		return true, nil
+	}
+
+	// --include-untracked was not set, so we can skip untracked tables. Is every table untracked?
+	allUntracked, err := workingSetContainsOnlyUntrackedTables(ctx, roots)
+	if err != nil {
+		return false, err
+	}
+
+	if allUntracked {
+		return false, nil
+	}
+
+	// There are changes to tracked tables. Stash them.
+	return true, nil
+}
+
+// StashChanges stages the changes in |roots| that should be stashed and returns the root value to save in the stash
+// entry, the names of the tables that need to be staged again when the entry is applied, and |roots| with the stashed
+// changes removed from the working set and the staging area. Callers should check HasStashableChanges first.
+func StashChanges(ctx context.Context, roots doltdb.Roots, includeUntracked, all bool) (doltdb.RootValue, []doltdb.TableName, doltdb.Roots, error) {
+	roots, err := StageModifiedAndDeletedTables(ctx, roots)
+	if err != nil {
+		return nil, nil, doltdb.Roots{}, err
+	}
+
+	// all tables with changes that are going to be stashed are staged at this point
+
+	allTblsToBeStashed, addedTblsToStage, err := stashedTableSets(ctx, roots)
+	if err != nil {
+		return nil, nil, doltdb.Roots{}, err
+	}
+
+	// stage untracked files to include them in the stash,
+	// but do not include them in added table set,
+	// because they should not be staged when popped.
+	if includeUntracked || all {
+		allTblsToBeStashed, err = doltdb.UnionTableNames(ctx, roots.Staged, roots.Working)
+		if err != nil {
+			return nil, nil, doltdb.Roots{}, err
+		}
+
+		roots, err = StageTables(ctx, roots, allTblsToBeStashed, !all)
+		if err != nil {
+			return nil, nil, doltdb.Roots{}, err
+		}
+	}
+
+	stashRoot := roots.Staged
+
+	// setting STAGED to current HEAD RootValue resets staged set of changed, so
+	// these changes are now in working set of changes, which needs to be checked out
+	roots.Staged = roots.Head
+	roots, err = MoveTablesFromHeadToWorking(ctx, roots, allTblsToBeStashed)
+	if err != nil {
+		return nil, nil, doltdb.Roots{}, err
+	}
+
+	return stashRoot, addedTblsToStage, roots, nil
+}
+
+// HasStashableTableChanges returns whether any of |tbls| differ between the head, staged and working roots in |roots|.
+func HasStashableTableChanges(ctx context.Context, roots doltdb.Roots, tbls []doltdb.TableName) (bool, error) {
+	for _, tbl := range tbls {
+		headHash, inHead, err := roots.Head.GetTableHash(ctx, tbl)
+		if err != nil {
+			return false, err
+		}
+		for _, root := range []doltdb.RootValue{roots.Staged, roots.Working} {
+			h, ok, err := root.GetTableHash(ctx, tbl)
+			if err != nil {
+				return false, err
+			}
+			if ok != inHead || !h.Equal(headHash) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// StashTables is like StashChanges, but only the changes to |tbls| are stashed and the changes to every other table
+// are left in place. Named tables are stashed even when they are untracked, and it is an error for any of |tbls| to
+// not exist in any of |roots|.
+func StashTables(ctx context.Context, roots doltdb.Roots, tbls []doltdb.TableName) (doltdb.RootValue, []doltdb.TableName, doltdb.Roots, error) {
+	err := ValidateTables(ctx, tbls, roots.Head, roots.Staged, roots.Working)
+	if err != nil {
+		return nil, nil, doltdb.Roots{}, err
+	}
+
+	// tables that were added and staged need to be staged again when the stash is applied
+	var addedTblsToStage []doltdb.TableName
+	for _, tbl := range tbls {
+		inStaged, err := roots.Staged.HasTable(ctx, tbl)
+		if err != nil {
+			return nil, nil, doltdb.Roots{}, err
+		}
+		inHead, err := roots.Head.HasTable(ctx, tbl)
+		if err != nil {
+			return nil, nil, doltdb.Roots{}, err
+		}
+		if inStaged && !inHead {
+			addedTblsToStage = append(addedTblsToStage, tbl)
+		}
+	}
+
+	stashRoot, err := MoveTablesBetweenRoots(ctx, tbls, roots.Working, roots.Head)
+	if err != nil {
+		return nil, nil, doltdb.Roots{}, err
+	}
+
+	roots.Staged, err = MoveTablesBetweenRoots(ctx, tbls, roots.Head, roots.Staged)
+	if err != nil {
+		return nil, nil, doltdb.Roots{}, err
+	}
+	roots.Working, err = MoveTablesBetweenRoots(ctx, tbls, roots.Head, roots.Working)
+	if err != nil {
+		return nil, nil, doltdb.Roots{}, err
+	}
+
+	return stashRoot, addedTblsToStage, roots, nil
+}
+
+// workingSetContainsOnlyUntrackedTables returns true if all changes in working set are untracked files/added tables.
+// Untracked files are part of working set changes, but should not be stashed unless staged or --include-untracked flag is used.
+func workingSetContainsOnlyUntrackedTables(ctx context.Context, roots doltdb.Roots) (bool, error) {
+	_, unstaged, err := diff.GetStagedUnstagedTableDeltas(ctx, roots)
+	if err != nil {
+		return false, err
+	}
+
+	// All ignored files are also untracked files
+	for _, tableDelta := range unstaged {
+		if !tableDelta.IsAdd() {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// stashedTableSets returns array of table names for all tables that are being stashed and added tables in staged.
+// These table names are determined from all tables in the staged set of changes as they are being stashed only.
+func stashedTableSets(ctx context.Context, roots doltdb.Roots) ([]doltdb.TableName, []doltdb.TableName, error) {
+	var addedTblsInStaged []doltdb.TableName
+	var allTbls []doltdb.TableName
+	staged, _, err := diff.GetStagedUnstagedTableDeltas(ctx, roots)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, tableDelta := range staged {
+		tblName := tableDelta.ToName
+		if tableDelta.IsAdd() {
+			addedTblsInStaged = append(addedTblsInStaged, tableDelta.ToName)
+		}
+		if tableDelta.IsDrop() {
+			tblName = tableDelta.FromName
+		}
+		allTbls = append(allTbls, tblName)
+	}
+
+	return allTbls, addedTblsInStaged, nil
+}
@@ -282,6 +282,12 @@ func mergeProllyTableData(ctx *sql.Context, tm *TableMerger, finalSch schema.Sch
 		}
 	}
 
+	cnt, err := uniq.recordRekeyCollisions(ctx, finalSch)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.ConstraintViolations += cnt
+
 	// After we've resolved all the diffs, it's safe for us to update the schema on the table
 	mergeTbl, err = tm.leftTbl.UpdateSchema(ctx, finalSch)
 	if err != nil {
@@ -0,0 +1,395 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package merge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor/creation"
+	"github.com/dolthub/dolt/go/store/pool"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// rekeyCollision is a row that was dropped from one side of a merge because, after re-keying that side into a new
+// primary key, another row of the same side had the same key.
+type rekeyCollision struct {
+	key, value val.Tuple
+	right      bool
+}
+
+// rekeyForPrimaryKeyChange handles merges in which one side changed the primary key of the table. The ancestor, and
+// the side that kept the ancestor's primary key, are re-keyed into the new primary key so that all three tables can be
+// diffed and merged as usual. Rows of a side that collide on their new key are dropped from that side and kept in
+// |tm.rekeyCollisions| to be recorded as unique key violations. If the primary keys cannot be reconciled the tables
+// are left as they are, and SchemaMerge reports the difference. If rows of the ancestor, or of the side that kept its
+// primary key, have NULL values in a column of the new primary key, the tables are left as they are and the returned
+// SchemaConflict reports it.
+func (tm *TableMerger) rekeyForPrimaryKeyChange(ctx *sql.Context) (SchemaConflict, error) {
+	if tm.leftTbl == nil || tm.rightTbl == nil || tm.ancTbl == nil {
+		return SchemaConflict{}, nil
+	}
+
+	format := tm.vrw.Format()
+	leftChanged := !schema.ArePrimaryKeySetsDiffable(format, tm.ancSch, tm.leftSch)
+	rightChanged := !schema.ArePrimaryKeySetsDiffable(format, tm.ancSch, tm.rightSch)
+	if !leftChanged && !rightChanged {
+		return SchemaConflict{}, nil
+	}
+	if leftChanged && rightChanged && !schema.ArePrimaryKeySetsDiffable(format, tm.leftSch, tm.rightSch) {
+		return SchemaConflict{}, nil
+	}
+
+	target := tm.leftSch
+	if !leftChanged {
+		target = tm.rightSch
+	}
+
+	ok, err := tm.canRekey(ctx, target, !leftChanged, !rightChanged)
+	if err != nil || !ok {
+		return SchemaConflict{}, err
+	}
+
+	// nothing is changed until every table has been re-keyed, so that the tables are left as they are on a conflict
+	ancTbl, ancSch, _, err := rekeyTable(ctx, tm.name, tm.ancTbl, tm.ancSch, target)
+	leftTbl, leftSch, rightTbl, rightSch := tm.leftTbl, tm.leftSch, tm.rightTbl, tm.rightSch
+	var leftCollisions, rightCollisions []rekeyCollision
+	if err == nil && !leftChanged {
+		leftTbl, leftSch, leftCollisions, err = rekeyTable(ctx, tm.name, tm.leftTbl, tm.leftSch, target)
+	}
+	if err == nil && !rightChanged {
+		rightTbl, rightSch, rightCollisions, err = rekeyTable(ctx, tm.name, tm.rightTbl, tm.rightSch, target)
+	}
+	var nullErr errNullRekeyKey
+	if errors.As(err, &nullErr) {
+		return SchemaConflict{TableName: tm.name, NullPrimaryKeyColumn: nullErr.column}, nil
+	} else if err != nil {
+		return SchemaConflict{}, err
+	}
+
+	tm.ancTbl, tm.ancSch = ancTbl, ancSch
+	tm.leftTbl, tm.leftSch = leftTbl, leftSch
+	tm.rightTbl, tm.rightSch = rightTbl, rightSch
+	tm.rekeyCollisions = append(tm.rekeyCollisions, leftCollisions...)
+	for _, c := range rightCollisions {
+		c.right = true
+		tm.rekeyCollisions = append(tm.rekeyCollisions, c)
+	}
+
+	return SchemaConflict{}, nil
+}
+
+// canRekey returns whether the ancestor, and the sides of the merge selected by |rekeyLeft| and |rekeyRight|, can be
+// re-keyed into the primary key of |target|.
+func (tm *TableMerger) canRekey(ctx context.Context, target schema.Schema, rekeyLeft, rekeyRight bool) (bool, error) {
+	tables := []*doltdb.Table{tm.ancTbl}
+	schemas := []schema.Schema{tm.ancSch}
+	if rekeyLeft {
+		tables, schemas = append(tables, tm.leftTbl), append(schemas, tm.leftSch)
+	}
+	if rekeyRight {
+		tables, schemas = append(tables, tm.rightTbl), append(schemas, tm.rightSch)
+	}
+
+	for i, tbl := range tables {
+		sch := schemas[i]
+		if sch.Indexes().ContainsFullTextIndex() {
+			return false, nil
+		}
+		rekeyed, err := schema.RekeySchema(sch, target)
+		if err != nil {
+			return false, nil
+		}
+		if _, err = newRowRekeyer(sch, rekeyed, nil); err != nil {
+			return false, nil
+		}
+
+		// artifacts are keyed by the old primary key
+		arts, err := tbl.GetArtifacts(ctx)
+		if err != nil {
+			return false, err
+		}
+		if n, err := arts.Count(); err != nil {
+			return false, err
+		} else if n > 0 {
+			return false, nil
+		}
+	}
+	return !target.Indexes().ContainsFullTextIndex(), nil
+}
+
+// rekeyTable returns |tbl| with its primary key changed to the primary key of |target|, rebuilding its primary and
+// secondary indexes. Rows that collide on their new key are dropped and returned.
+func rekeyTable(ctx *sql.Context, tblName doltdb.TableName, tbl *doltdb.Table, sch, target schema.Schema) (*doltdb.Table, schema.Schema, []rekeyCollision, error) {
+	rekeyed, err := schema.RekeySchema(sch, target)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	var dropped []rekeyCollision
+	rows, err := rekeyRows(ctx, tblName, durable.ProllyMapFromIndex(idx), sch, rekeyed, func(k, v val.Tuple) {
+		dropped = append(dropped, rekeyCollision{key: k, value: v})
+	})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tbl, err = tbl.UpdateSchema(ctx, rekeyed)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	tbl, err = tbl.UpdateRows(ctx, durable.IndexFromProllyMap(rows))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	indexes, err := durable.NewIndexSet(ctx, tbl.ValueReadWriter(), tbl.NodeStore())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, def := range rekeyed.Indexes().AllIndexes() {
+		secondary, err := creation.BuildSecondaryProllyIndex(ctx, tbl.ValueReadWriter(), tbl.NodeStore(), rekeyed, tblName.Name, def, rows)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		indexes, err = indexes.PutIndex(ctx, def.Name(), secondary)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	tbl, err = tbl.SetIndexSet(ctx, indexes)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return tbl, rekeyed, dropped, nil
+}
+
+// RekeyedRowData returns the row data of |tbl| keyed by the primary key of |sch|. If the primary key of |tbl| already
+// matches the one of |sch|, its row data is returned as it is. Otherwise, the rows are re-keyed the same way they are
+// when merging a table whose primary key was changed, keeping the first of any rows that collide on their new key.
+func RekeyedRowData(ctx context.Context, tblName doltdb.TableName, tbl *doltdb.Table, sch schema.Schema) (prolly.Map, error) {
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	rows := durable.ProllyMapFromIndex(idx)
+
+	tblSch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	if schema.ArePrimaryKeySetsDiffable(tbl.Format(), tblSch, sch) {
+		return rows, nil
+	}
+
+	rekeyed, err := schema.RekeySchema(tblSch, sch)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	return rekeyRows(ctx, tblName, rows, tblSch, rekeyed, nil)
+}
+
+// rekeyRows projects each row of |rows| from |fromSch| into |toSch|, which has the same columns but a different
+// primary key. When two rows have the same new key, the first one is kept and |dropped| is called with the other one.
+func rekeyRows(ctx context.Context, tblName doltdb.TableName, rows prolly.Map, fromSch, toSch schema.Schema, dropped func(k, v val.Tuple)) (prolly.Map, error) {
+	rk, err := newRowRekeyer(fromSch, toSch, rows.Pool())
+	if err != nil {
+		return prolly.Map{}, err
+	}
+
+	kd, vd := toSch.GetMapDescriptors()
+	empty, err := prolly.NewMapFromTuples(ctx, rows.NodeStore(), kd, vd)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	mut := empty.Mutate()
+
+	iter, err := rows.IterAll(ctx)
+	if err != nil {
+		return prolly.Map{}, err
+	}
+	for {
+		k, v, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return prolly.Map{}, err
+		}
+
+		newKey, newValue, err := rk.rekey(k, v)
+		if err != nil {
+			return prolly.Map{}, fmt.Errorf("cannot change the primary key of table %s: %w", tblName, err)
+		}
+
+		ok, err := mut.Has(ctx, newKey)
+		if err != nil {
+			return prolly.Map{}, err
+		}
+		if ok {
+			if dropped != nil {
+				dropped(newKey, newValue)
+			}
+			continue
+		}
+
+		err = mut.Put(ctx, newKey, newValue)
+		if err != nil {
+			return prolly.Map{}, err
+		}
+	}
+
+	return mut.Map(ctx)
+}
+
+// errNullRekeyKey is returned when a row can't be re-keyed because it has a NULL value in |column|, which is part of
+// the new primary key.
+type errNullRekeyKey struct {
+	column string
+}
+
+func (e errNullRekeyKey) Error() string {
+	return fmt.Sprintf("a row has a NULL value in primary key column %s", e.column)
+}
+
+// rowRekeyer builds the key and value tuples of a row in a schema from the key and value tuples of the same row in a
+// schema with the same columns but a different primary key.
+type rowRekeyer struct {
+	fromKD, fromVD val.TupleDesc
+	kb, vb         *val.TupleBuilder
+	keySrc, valSrc []rekeyField
+	keyNames       []string
+	pool           pool.BuffPool
+}
+
+// rekeyField is the position of a field in the key tuple, or in the value tuple, of a row.
+type rekeyField struct {
+	fromKey bool
+	idx     int
+}
+
+func newRowRekeyer(fromSch, toSch schema.Schema, pool pool.BuffPool) (rowRekeyer, error) {
+	if schema.IsKeyless(fromSch) || schema.IsKeyless(toSch) {
+		return rowRekeyer{}, fmt.Errorf("cannot change the primary key of a keyless table")
+	}
+
+	rk := rowRekeyer{pool: pool}
+	rk.fromKD, rk.fromVD = fromSch.GetMapDescriptors()
+	toKD, toVD := toSch.GetMapDescriptors()
+
+	fieldFor := func(col schema.Column, enc val.Encoding) (rekeyField, error) {
+		var f rekeyField
+		var fromEnc val.Encoding
+		if i, ok := fromSch.GetPKCols().TagToIdx[col.Tag]; ok {
+			f, fromEnc = rekeyField{fromKey: true, idx: i}, rk.fromKD.Types[i].Enc
+		} else if i, ok := fromSch.GetNonPKCols().StoredIndexByTag(col.Tag); ok {
+			f, fromEnc = rekeyField{idx: i}, rk.fromVD.Types[i].Enc
+		} else {
+			return f, fmt.Errorf("column %s does not exist", col.Name)
+		}
+		if fromEnc != enc {
+			return f, fmt.Errorf("column %s cannot be moved into or out of the primary key", col.Name)
+		}
+		return f, nil
+	}
+
+	for i, col := range toSch.GetPKCols().GetColumns() {
+		f, err := fieldFor(col, toKD.Types[i].Enc)
+		if err != nil {
+			return rowRekeyer{}, err
+		}
+		rk.keySrc = append(rk.keySrc, f)
+		rk.keyNames = append(rk.keyNames, col.Name)
+	}
+	for _, col := range toSch.GetNonPKCols().GetColumns() {
+		if col.Virtual {
+			continue
+		}
+		f, err := fieldFor(col, toVD.Types[len(rk.valSrc)].Enc)
+		if err != nil {
+			return rowRekeyer{}, err
+		}
+		rk.valSrc = append(rk.valSrc, f)
+	}
+
+	rk.kb = val.NewTupleBuilder(toKD)
+	rk.vb = val.NewTupleBuilder(toVD)
+	return rk, nil
+}
+
+// rekey returns the key and value tuples of the row made up of |k| and |v| in the new schema.
+func (rk rowRekeyer) rekey(k, v val.Tuple) (val.Tuple, val.Tuple, error) {
+	field := func(f rekeyField) []byte {
+		if f.fromKey {
+			return rk.fromKD.GetField(f.idx, k)
+		}
+		return rk.fromVD.GetField(f.idx, v)
+	}
+
+	for i, f := range rk.keySrc {
+		buf := field(f)
+		if buf == nil {
+			rk.kb.Recycle()
+			return nil, nil, errNullRekeyKey{column: rk.keyNames[i]}
+		}
+		rk.kb.PutRaw(i, buf)
+	}
+	for i, f := range rk.valSrc {
+		rk.vb.PutRaw(i, field(f))
+	}
+	return rk.kb.Build(rk.pool), rk.vb.Build(rk.pool), nil
+}
+
+// recordRekeyCollisions records the rows dropped while re-keying the sides of the merge as violations of the primary
+// key of |finalSch|, and returns the number of violations recorded.
+func (uv uniqValidator) recordRekeyCollisions(ctx context.Context, finalSch schema.Schema) (int, error) {
+	if !uv.tm.recordViolations || len(uv.tm.rekeyCollisions) == 0 {
+		return 0, nil
+	}
+
+	meta := UniqCVMeta{
+		Columns: finalSch.GetPKCols().GetColumnNames(),
+		Name:    "PRIMARY",
+	}
+
+	for _, c := range uv.tm.rekeyCollisions {
+		value := c.value
+		mapping, desc := uv.valueMerger.leftMapping, uv.tm.leftSch.GetValueDescriptor()
+		if c.right {
+			mapping, desc = uv.valueMerger.rightMapping, uv.tm.rightSch.GetValueDescriptor()
+		}
+		if !mapping.IsIdentityMapping() {
+			value = val.NewTuple(uv.valueMerger.syncPool, remapTuple(value, desc, mapping)...)
+		}
+
+		err := uv.insertArtifact(ctx, c.key, value, meta)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return len(uv.tm.rekeyCollisions), nil
+}
@@ -76,6 +76,10 @@ type TableMerger struct {
 	// exception is for the dolt_verify_constraints() stored procedure, which allows callers to
 	// only record constraint violations for a specified subset of tables.
 	recordViolations bool
+
+	// rekeyCollisions holds the rows dropped from a side of the merge when it was re-keyed into the primary key
+	// that the other side changed the table to. See rekeyForPrimaryKeyChange.
+	rekeyCollisions []rekeyCollision
 }
 
 func (tm TableMerger) tableHashes() (left, right, anc hash.Hash, err error) {
@@ -163,25 +167,23 @@ func (rm *RootMerger) MergeTable(
 		return &MergedTable{table: finished}, stats, err
 	}
 
+	if types.IsFormat_DOLT(tm.vrw.Format()) {
+		rekeyConflict, err := tm.rekeyForPrimaryKeyChange(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if rekeyConflict.Count() > 0 {
+			return schemaConflictResult(tm, rekeyConflict, mergeOpts)
+		}
+	}
+
 	// Calculate a merge of the schemas, but don't apply it yet
 	mergeSch, schConflicts, mergeInfo, diffInfo, err := SchemaMerge(ctx, tm.vrw.Format(), tm.leftSch, tm.rightSch, tm.ancSch, tblName)
 	if err != nil {
 		return nil, nil, err
 	}
 	if schConflicts.Count() > 0 {
-		if !mergeOpts.KeepSchemaConflicts {
-			return nil, nil, schConflicts
-		}
-		// handle schema conflicts above
-		mt := &MergedTable{
-			table:    tm.leftTbl,
-			conflict: schConflicts,
-		}
-		stats = &MergeStats{
-			Operation:       TableModified,
-			SchemaConflicts: schConflicts.Count(),
-		}
-		return mt, stats, nil
+		return schemaConflictResult(tm, schConflicts, mergeOpts)
 	}
 
 	var tbl *doltdb.Table
@@ -196,6 +198,24 @@ func (rm *RootMerger) MergeTable(
 	return &MergedTable{table: tbl}, stats, nil
 }
 
+// schemaConflictResult returns the result of merging a table with the schema conflicts |schConflicts|, which is an
+// error unless |mergeOpts| keeps schema conflicts.
+func schemaConflictResult(tm *TableMerger, schConflicts SchemaConflict, mergeOpts MergeOpts) (*MergedTable, *MergeStats, error) {
+	if !mergeOpts.KeepSchemaConflicts {
+		return nil, nil, schConflicts
+	}
+	// handle schema conflicts above
+	mt := &MergedTable{
+		table:    tm.leftTbl,
+		conflict: schConflicts,
+	}
+	stats := &MergeStats{
+		Operation:       TableModified,
+		SchemaConflicts: schConflicts.Count(),
+	}
+	return mt, stats, nil
+}
+
 func (rm *RootMerger) makeTableMerger(ctx context.Context, tblName doltdb.TableName, mergeOpts MergeOpts) (*TableMerger, error) {
 	recordViolations := true
 	if mergeOpts.RecordViolationsForTables != nil {
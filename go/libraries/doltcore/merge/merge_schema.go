@@ -53,6 +53,9 @@ type SchemaConflict struct {
 	IdxConflicts         []IdxConflict
 	ChkConflicts         []ChkConflict
 	ModifyDeleteConflict bool
+	// NullPrimaryKeyColumn is the column of the primary key that one side of the merge changed the table to which has
+	// NULL values in rows of the other side, or of the ancestor, so that they can't be re-keyed.
+	NullPrimaryKeyColumn string
 }
 
 var _ error = SchemaConflict{}
@@ -60,7 +63,10 @@ var _ error = SchemaConflict{}
 func (sc SchemaConflict) Count() int {
 	count := len(sc.ColConflicts) + len(sc.IdxConflicts) + len(sc.ChkConflicts)
 	if sc.ModifyDeleteConflict {
-		return count + 1
+		count++
+	}
+	if sc.NullPrimaryKeyColumn != "" {
+		count++
 	}
 	return count
 }
@@ -98,9 +104,21 @@ func (sc SchemaConflict) messages() (mm []string) {
 	if sc.ModifyDeleteConflict {
 		mm = append(mm, "table was modified in one branch and deleted in the other")
 	}
+	if sc.NullPrimaryKeyColumn != "" {
+		mm = append(mm, NullPrimaryKeyConflictDescription(sc.NullPrimaryKeyColumn))
+	}
 	return
 }
 
+// NullPrimaryKeyConflictDescription describes the schema conflict of a merge in which one side changed the primary
+// key of a table to include |column|, and rows of the other side have NULL values in it.
+func NullPrimaryKeyConflictDescription(column string) string {
+	if column == "" {
+		return "the primary key was changed in one branch, and rows in the other branch have NULL values in its columns"
+	}
+	return fmt.Sprintf("the primary key was changed in one branch to include column '%s', and rows in the other branch have NULL values in it", column)
+}
+
 type ColConflict struct {
 	Kind         conflictKind
 	Ours, Theirs schema.Column
@@ -175,7 +193,8 @@ func SchemaMerge(
 		TableName: tblName,
 	}
 
-	// TODO: We'll remove this once it's possible to get diff and merge on different primary key sets
+	// When only one side changed the primary key, the other side and the ancestor have already been re-keyed
+	// by TableMerger.rekeyForPrimaryKeyChange, so any difference left here can't be merged.
 	// TODO: decide how to merge different orders of PKS
 	if !schema.ArePrimaryKeySetsDiffable(format, ourSch, theirSch) {
 		return nil, SchemaConflict{}, mergeInfo, diffInfo, ErrMergeWithDifferentPks.New(tblName)
@@ -15,6 +15,7 @@
 package merge
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/dolthub/go-mysql-server/sql"
@@ -32,8 +33,10 @@ import (
 // Theirs: HEAD~2
 //
 // The root is updated with the merged result, and this process is repeated for each commit given, in the order given.
-// Currently, we error on conflicts or constraint violations generated by the merge.
-func Revert(ctx *sql.Context, ddb *doltdb.DoltDB, root doltdb.RootValue, commits []*doltdb.Commit, opts editor.Options) (doltdb.RootValue, string, error) {
+// For merge commits, |mainline| selects the parent (starting from 1) that the changes are reverted relative to. If
+// |mainline| is 0, the first parent is used. Currently, we error on conflicts or constraint violations generated by
+// the merge.
+func Revert(ctx *sql.Context, ddb *doltdb.DoltDB, root doltdb.RootValue, commits []*doltdb.Commit, mainline int, opts editor.Options) (doltdb.RootValue, string, error) {
 	revertMessage := "Revert"
 
 	for _, cm := range commits {
@@ -60,14 +63,10 @@ func Revert(ctx *sql.Context, ddb *doltdb.DoltDB, root doltdb.RootValue, commits
 		}
 		revertMessage = fmt.Sprintf(`%s "%s"`, revertMessage, baseMeta.Description)
 
-		optCmt, err := ddb.ResolveParent(ctx, baseCommit, 0)
+		parentCM, err := ResolveMainlineParent(ctx, ddb, baseCommit, mainline)
 		if err != nil {
 			return nil, "", err
 		}
-		parentCM, ok := optCmt.ToCommit()
-		if !ok {
-			return nil, "", doltdb.ErrGhostCommitEncountered
-		}
 
 		theirRoot, err := parentCM.GetRootValue(ctx)
 		if err != nil {
@@ -95,3 +94,35 @@ func Revert(ctx *sql.Context, ddb *doltdb.DoltDB, root doltdb.RootValue, commits
 
 	return root, revertMessage, nil
 }
+
+// ResolveMainlineParent returns the parent of |commit| selected by |mainline|, which numbers parents starting from 1,
+// as used by git's -m option for cherry-pick and revert. If |mainline| is 0, the first parent is returned. Otherwise,
+// |commit| must be a merge commit with at least |mainline| parents.
+func ResolveMainlineParent(ctx context.Context, ddb *doltdb.DoltDB, commit *doltdb.Commit, mainline int) (*doltdb.Commit, error) {
+	parentIdx := 0
+	if mainline != 0 {
+		h, err := commit.HashOf()
+		if err != nil {
+			return nil, err
+		}
+		numParents := len(commit.DatasParents())
+		if mainline < 0 {
+			return nil, fmt.Errorf("invalid parent number %d, parents are numbered starting from 1", mainline)
+		} else if numParents < 2 {
+			return nil, fmt.Errorf("mainline was specified but commit %s is not a merge", h.String())
+		} else if mainline > numParents {
+			return nil, fmt.Errorf("commit %s does not have parent %d", h.String(), mainline)
+		}
+		parentIdx = mainline - 1
+	}
+
+	optCmt, err := ddb.ResolveParent(ctx, commit, parentIdx)
+	if err != nil {
+		return nil, err
+	}
+	parent, ok := optCmt.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	return parent, nil
+}
@@ -16,10 +16,14 @@ package merge
 
 import (
 	"context"
+	"sort"
+
+	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/conflict"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table/editor"
 	"github.com/dolthub/dolt/go/store/types"
 )
 
@@ -180,3 +184,34 @@ func setConflictData(ctx context.Context, tbl *doltdb.Table, data *conflictData)
 
 	return tbl, nil
 }
+
+// ApplyStash merges the changes saved in a stash entry with the root value |stashRoot|, which was created on top of
+// |stashHead|, into |workingRoot|. If the stashed changes conflict with |workingRoot|, the root returned is nil and the
+// names of the conflicting tables are returned instead.
+func ApplyStash(ctx *sql.Context, workingRoot, stashRoot doltdb.RootValue, stashHead *doltdb.Commit, opts editor.Options) (doltdb.RootValue, []doltdb.TableName, error) {
+	parentRoot, err := stashHead.GetRootValue(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, err := MergeRoots(ctx, workingRoot, stashRoot, parentRoot, stashRoot, stashHead, opts, MergeOpts{IsCherryPick: false})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tablesWithConflict []doltdb.TableName
+	for tbl, stats := range result.Stats {
+		if stats.HasConflicts() {
+			tablesWithConflict = append(tablesWithConflict, tbl)
+		}
+	}
+
+	if len(tablesWithConflict) > 0 {
+		sort.Slice(tablesWithConflict, func(i, j int) bool {
+			return tablesWithConflict[i].Less(tablesWithConflict[j])
+		})
+		return nil, tablesWithConflict, nil
+	}
+
+	return result.Root, nil, nil
+}
@@ -102,10 +102,20 @@ func GetForeignKeyViolations(ctx context.Context, newRoot, baseRoot doltdb.RootV
 		}
 
 		preParent, _, err := newConstraintViolationsLoadedTable(ctx, foreignKey.ReferencedTableName, foreignKey.ReferencedTableIndex, baseRoot)
-		if err != nil {
-			if err != doltdb.ErrTableNotFound {
-				return err
-			}
+		if err != nil && err != doltdb.ErrTableNotFound {
+			return err
+		}
+		preChild, _, err := newConstraintViolationsLoadedTable(ctx, foreignKey.TableName, foreignKey.TableIndex, baseRoot)
+		if err != nil && err != doltdb.ErrTableNotFound {
+			return err
+		}
+		// If the primary key of either table changed, the ancestor's rows can't be diffed against the new ones, so
+		// every row is checked as if neither table existed in the ancestor.
+		if primaryKeyChanged(preParent, postParent) || primaryKeyChanged(preChild, postChild) {
+			preParent, preChild = nil, nil
+		}
+
+		if preParent == nil {
 			// Parent does not exist in the ancestor so we use an empty map
 			emptyIdx, err := durable.NewEmptyPrimaryIndex(ctx, postParent.Table.ValueReadWriter(), postParent.Table.NodeStore(), postParent.Schema)
 			if err != nil {
@@ -123,11 +133,7 @@ func GetForeignKeyViolations(ctx context.Context, newRoot, baseRoot doltdb.RootV
 			}
 		}
 
-		preChild, _, err := newConstraintViolationsLoadedTable(ctx, foreignKey.TableName, foreignKey.TableIndex, baseRoot)
-		if err != nil {
-			if err != doltdb.ErrTableNotFound {
-				return err
-			}
+		if preChild == nil {
 			// Child does not exist in the ancestor so we use an empty map
 			emptyIdx, err := durable.NewEmptyPrimaryIndex(ctx, postChild.Table.ValueReadWriter(), postChild.Table.NodeStore(), postChild.Schema)
 			if err != nil {
@@ -153,6 +159,15 @@ func GetForeignKeyViolations(ctx context.Context, newRoot, baseRoot doltdb.RootV
 	return nil
 }
 
+// primaryKeyChanged returns whether the primary key of a table loaded from the ancestor, |pre|, differs from the
+// primary key of the same table loaded from the new root, |post|. A nil |pre| never has a changed primary key.
+func primaryKeyChanged(pre, post *constraintViolationsLoadedTable) bool {
+	if pre == nil {
+		return false
+	}
+	return !schema.ArePrimaryKeySetsDiffable(pre.Table.Format(), pre.Schema, post.Schema)
+}
+
 // AddForeignKeyViolations adds foreign key constraint violations to each table.
 // todo(andy): pass doltdb.Rootish
 func AddForeignKeyViolations(ctx context.Context, newRoot, baseRoot doltdb.RootValue, tables *doltdb.TableNameSet, theirRootIsh hash.Hash) (doltdb.RootValue, *doltdb.TableNameSet, error) {
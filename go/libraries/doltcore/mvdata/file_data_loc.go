@@ -47,6 +47,8 @@ func DFFromString(dfStr string) DataFormat {
 		return XlsxFile
 	case "json", ".json":
 		return JsonFile
+	case "jsonl", ".jsonl", "ndjson", ".ndjson":
+		return JsonlFile
 	case "sql", ".sql":
 		return SqlFile
 	case "parquet", ".parquet":
@@ -117,35 +119,16 @@ func (dl FileDataLocation) NewReader(ctx context.Context, dEnv *env.DoltEnv, opt
 		rd, err := xlsx.OpenXLSXReader(ctx, root.VRW(), dl.Path, fs, &xlsx.XLSXFileInfo{SheetName: xlsxOpts.SheetName})
 		return rd, false, err
 
-	case JsonFile:
-		var sch schema.Schema
-		jsonOpts, _ := opts.(JSONOptions)
-		if jsonOpts.SchFile != "" {
-			tn, s, err := SchAndTableNameFromFile(ctx, jsonOpts.SchFile, dEnv)
-			if err != nil {
-				return nil, false, err
-			}
-			if tn != jsonOpts.TableName {
-				return nil, false, fmt.Errorf("table name '%s' from schema file %s does not match table arg '%s'", tn, jsonOpts.SchFile, jsonOpts.TableName)
-			}
-			sch = s
-		} else {
-			if opts == nil {
-				return nil, false, errors.New("Unable to determine table name on JSON import")
-			}
-			tbl, exists, err := root.GetTable(context.TODO(), doltdb.TableName{Name: jsonOpts.TableName})
-			if !exists {
-				return nil, false, fmt.Errorf("The following table could not be found:\n%v", jsonOpts.TableName)
-			}
-			if err != nil {
-				return nil, false, fmt.Errorf("An error occurred attempting to read the table:\n%v", err.Error())
-			}
-			sch, err = tbl.GetSchema(context.TODO())
-			if err != nil {
-				return nil, false, fmt.Errorf("An error occurred attempting to read the table schema:\n%v", err.Error())
-			}
+	case JsonFile, JsonlFile:
+		sch, err := jsonImportSchema(ctx, dEnv, root, opts)
+		if err != nil {
+			return nil, false, err
 		}
 
+		if dl.Format == JsonlFile {
+			rd, err := json.OpenJSONLReader(root.VRW(), dl.Path, fs, sch)
+			return rd, false, err
+		}
 		rd, err := json.OpenJSONReader(root.VRW(), dl.Path, fs, sch)
 		return rd, false, err
 
@@ -184,6 +167,38 @@ func (dl FileDataLocation) NewReader(ctx context.Context, dEnv *env.DoltEnv, opt
 	return nil, false, errors.New("unsupported format")
 }
 
+// jsonImportSchema returns the schema of the rows being imported from a json or jsonl source, which comes from the
+// schema file given in |opts| if there is one, and from the existing table otherwise.
+func jsonImportSchema(ctx context.Context, dEnv *env.DoltEnv, root doltdb.RootValue, opts interface{}) (schema.Schema, error) {
+	jsonOpts, _ := opts.(JSONOptions)
+	if jsonOpts.SchFile != "" {
+		tn, sch, err := SchAndTableNameFromFile(ctx, jsonOpts.SchFile, dEnv)
+		if err != nil {
+			return nil, err
+		}
+		if tn != jsonOpts.TableName {
+			return nil, fmt.Errorf("table name '%s' from schema file %s does not match table arg '%s'", tn, jsonOpts.SchFile, jsonOpts.TableName)
+		}
+		return sch, nil
+	}
+
+	if opts == nil {
+		return nil, errors.New("Unable to determine table name on JSON import")
+	}
+	tbl, exists, err := root.GetTable(context.TODO(), doltdb.TableName{Name: jsonOpts.TableName})
+	if !exists {
+		return nil, fmt.Errorf("The following table could not be found:\n%v", jsonOpts.TableName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("An error occurred attempting to read the table:\n%v", err.Error())
+	}
+	sch, err := tbl.GetSchema(context.TODO())
+	if err != nil {
+		return nil, fmt.Errorf("An error occurred attempting to read the table schema:\n%v", err.Error())
+	}
+	return sch, nil
+}
+
 // NewCreatingWriter will create a TableWriteCloser for a DataLocation that will create a new table, or overwrite
 // an existing table.
 func (dl FileDataLocation) NewCreatingWriter(ctx context.Context, mvOpts DataMoverOptions, root doltdb.RootValue, outSch schema.Schema, opts editor.Options, wr io.WriteCloser) (table.SqlRowWriter, error) {
@@ -193,9 +208,11 @@ func (dl FileDataLocation) NewCreatingWriter(ctx context.Context, mvOpts DataMov
 	case PsvFile:
 		return csv.NewCSVWriter(wr, outSch, csv.NewCSVInfo().SetDelim("|"))
 	case XlsxFile:
-		panic("writing to xlsx files is not supported yet")
+		return xlsx.NewXLSXWriter(wr, outSch, xlsx.NewXLSXInfo(mvOpts.SrcName()))
 	case JsonFile:
 		return json.NewJSONWriter(wr, outSch)
+	case JsonlFile:
+		return json.NewJSONLWriter(wr, outSch)
 	case SqlFile:
 		if mvOpts.IsBatched() {
 			return sqlexport.OpenBatchedSQLExportWriter(ctx, wr, root, mvOpts.SrcName(), mvOpts.IsAutocommitOff(), outSch, opts)
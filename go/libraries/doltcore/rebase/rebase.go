@@ -33,11 +33,13 @@ const (
 	RebaseActionFixup  = "fixup"
 	RebaseActionDrop   = "drop"
 	RebaseActionReword = "reword"
+	RebaseActionEdit   = "edit"
+	RebaseActionBreak  = "break"
 )
 
 // ErrInvalidRebasePlanSquashFixupWithoutPick is returned when a rebase plan attempts to squash or
-// fixup a commit without first picking or rewording a commit.
-var ErrInvalidRebasePlanSquashFixupWithoutPick = fmt.Errorf("invalid rebase plan: squash and fixup actions must appear after a pick or reword action")
+// fixup a commit without first picking, rewording, or editing a commit.
+var ErrInvalidRebasePlanSquashFixupWithoutPick = fmt.Errorf("invalid rebase plan: squash and fixup actions must appear after a pick, reword, or edit action")
 
 // RebasePlanDatabase is a database that can save and load a rebase plan.
 type RebasePlanDatabase interface {
@@ -54,7 +56,8 @@ type RebasePlan struct {
 }
 
 // RebasePlanStep describes a single step in a rebase plan, such as dropping a
-// commit, squashing a commit into the previous commit, etc.
+// commit, squashing a commit into the previous commit, etc. Break steps pause
+// the rebase and don't apply a commit, so their CommitHash and CommitMsg are empty.
 type RebasePlanStep struct {
 	RebaseOrder decimal.Decimal
 	Action      string
@@ -108,7 +111,7 @@ func CreateDefaultRebasePlan(ctx *sql.Context, startCommit, upstreamCommit *dolt
 }
 
 // ValidateRebasePlan returns a validation error for invalid states in a rebase plan, such as
-// squash or fixup actions appearing in the plan before a pick or reword action.
+// squash or fixup actions appearing in the plan before a pick, reword, or edit action.
 func ValidateRebasePlan(ctx *sql.Context, plan *RebasePlan) error {
 	seenPick := false
 	seenReword := false
@@ -120,7 +123,7 @@ func ValidateRebasePlan(ctx *sql.Context, plan *RebasePlan) error {
 		}
 
 		switch step.Action {
-		case RebaseActionPick:
+		case RebaseActionPick, RebaseActionEdit:
 			seenPick = true
 
 		case RebaseActionReword:
@@ -130,6 +133,10 @@ func ValidateRebasePlan(ctx *sql.Context, plan *RebasePlan) error {
 			if !seenPick && !seenReword {
 				return ErrInvalidRebasePlanSquashFixupWithoutPick
 			}
+
+		case RebaseActionBreak:
+			// break steps don't reference a commit, so there's nothing else to validate
+			continue
 		}
 
 		if err := validateCommit(ctx, step.CommitHash); err != nil {
@@ -49,6 +49,9 @@ type RemoteChunkStore struct {
 
 	concurrencyControl remotesapi.PushConcurrencyControl
 
+	// commitAccessControl, if set, authorizes the ref updates of every Commit request
+	commitAccessControl CommitAccessControl
+
 	csCache DBCache
 	bucket  string
 	fs      filesys.Filesys
@@ -501,6 +504,21 @@ func (rs *RemoteChunkStore) Commit(ctx context.Context, req *remotesapi.CommitRe
 	currHash := hash.New(req.Current)
 	lastHash := hash.New(req.Last)
 
+	if rs.commitAccessControl != nil {
+		authorized, err := rs.commitAccessControl.ApiAuthorizeCommit(ctx, repoPath, lastHash, currHash)
+		var denied CommitDeniedError
+		if errors.As(err, &denied) {
+			logger.WithError(err).Warn("commit authorization failed")
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		} else if err != nil {
+			logger.WithError(err).Error("error authorizing commit")
+			return nil, status.Errorf(codes.Internal, "error authorizing commit: %v", err)
+		} else if !authorized {
+			logger.Warn("commit authorization failed")
+			return nil, status.Error(codes.PermissionDenied, "API Authorization Failure: commit not authorized")
+		}
+	}
+
 	var ok bool
 	ok, err = cs.Commit(ctx, currHash, lastHash)
 	if err != nil {
@@ -22,6 +22,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/dolthub/dolt/go/store/hash"
 )
 
 type RequestCredentials struct {
@@ -64,6 +66,38 @@ type AccessControl interface {
 	ApiAuthorize(ctx context.Context, superUserReq bool) (bool, error)
 }
 
+// RepoAccessControl is an optional interface that an AccessControl may implement to make authorization decisions for
+// the individual repository that a request targets.
+type RepoAccessControl interface {
+	// ApiAuthorizeRepoRead checks that the authenticated user may read the repository at the given path. This is called
+	// for every read operation, in addition to ApiAuthorize. As the chunks that a read request returns are not tied to
+	// any particular ref, implementations should only allow access when the user may read everything in the repository.
+	ApiAuthorizeRepoRead(ctx context.Context, repoPath string) (bool, error)
+}
+
+// CommitAccessControl is an optional interface that an AccessControl may implement to authorize the ref updates that
+// are made by a Commit request.
+type CommitAccessControl interface {
+	// ApiAuthorizeCommit checks that the authenticated user may move the root of the repository at the given path from
+	// |last| to |current|. This is called after ApiAuthorize, once the table files of the push have been added to the
+	// repository, so the chunks of |current| may be read. A denial is returned as false with a CommitDeniedError naming
+	// the ref that could not be updated. Any other error means that the commit could not be checked.
+	ApiAuthorizeCommit(ctx context.Context, repoPath string, last, current hash.Hash) (bool, error)
+}
+
+// CommitDeniedError is the error returned by a CommitAccessControl that does not authorize a commit.
+type CommitDeniedError struct {
+	Reason error
+}
+
+func (e CommitDeniedError) Error() string {
+	return "API Authorization Failure: " + e.Reason.Error()
+}
+
+func (e CommitDeniedError) Unwrap() error {
+	return e.Reason
+}
+
 func (si *ServerInterceptor) Stream() grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 		needSuperUser, err := requireSuperUser(info.FullMethod)
@@ -71,10 +105,17 @@ func (si *ServerInterceptor) Stream() grpc.StreamServerInterceptor {
 			return err
 		}
 
-		if err := si.authenticate(ss.Context(), needSuperUser); err != nil {
+		ctx, err := si.authenticate(ss.Context(), needSuperUser)
+		if err != nil {
 			return err
 		}
 
+		if !needSuperUser {
+			if _, ok := si.AccessController.(RepoAccessControl); ok {
+				ss = &repoAuthorizingStream{ServerStream: ss, si: si, ctx: ctx}
+			}
+		}
+
 		return handler(srv, ss)
 	}
 }
@@ -86,11 +127,18 @@ func (si *ServerInterceptor) Unary() grpc.UnaryServerInterceptor {
 			return nil, err
 		}
 
-		if err := si.authenticate(ctx, needSuperUser); err != nil {
+		authCtx, err := si.authenticate(ctx, needSuperUser)
+		if err != nil {
 			return nil, err
 		}
 
-		return handler(ctx, req)
+		if !needSuperUser {
+			if err := si.authorizeRepoRead(authCtx, req); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(authCtx, req)
 	}
 }
 
@@ -114,20 +162,58 @@ func requireSuperUser(path string) (bool, error) {
 }
 
 // authenticate checks the incoming request for authentication credentials and validates them.  If the user is
-// legitimate, an authorization check is performed. If no error is returned, the user should be allowed to proceed.
-func (si *ServerInterceptor) authenticate(ctx context.Context, needsSuperUser bool) error {
+// legitimate, an authorization check is performed. If no error is returned, the user should be allowed to proceed, and
+// the returned context holds the authenticated user.
+func (si *ServerInterceptor) authenticate(ctx context.Context, needsSuperUser bool) (context.Context, error) {
 	ctx, err := si.AccessController.ApiAuthenticate(ctx)
 	if err != nil {
 		si.Lgr.Warnf("authentication failed: %s", err.Error())
-		return status.Error(codes.Unauthenticated, err.Error())
+		return nil, status.Error(codes.Unauthenticated, err.Error())
 	}
 
 	// Have a valid user in the context.  Check authorization.
 	if authorized, err := si.AccessController.ApiAuthorize(ctx, needsSuperUser); !authorized {
 		si.Lgr.Warnf("authorization failed: %s", err.Error())
-		return status.Error(codes.PermissionDenied, err.Error())
+		return nil, status.Error(codes.PermissionDenied, err.Error())
 	}
 
 	// Access Granted.
+	return ctx, nil
+}
+
+// authorizeRepoRead checks that the authenticated user in |ctx| may read the repository targeted by |req|. This is a
+// no-op if the access controller does not implement RepoAccessControl, or if the request does not target a repository.
+func (si *ServerInterceptor) authorizeRepoRead(ctx context.Context, req interface{}) error {
+	rac, ok := si.AccessController.(RepoAccessControl)
+	if !ok {
+		return nil
+	}
+	rr, ok := req.(repoRequest)
+	if !ok || (rr.GetRepoPath() == "" && rr.GetRepoId() == nil) {
+		return nil
+	}
+
+	if authorized, err := rac.ApiAuthorizeRepoRead(ctx, getRepoPath(rr)); !authorized {
+		if err == nil {
+			err = fmt.Errorf("API Authorization Failure: read access denied for %s", getRepoPath(rr))
+		}
+		si.Lgr.Warnf("authorization failed: %s", err.Error())
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
 	return nil
 }
+
+// repoAuthorizingStream wraps a grpc.ServerStream so that every received request is checked with authorizeRepoRead
+// before it reaches the handler, as the repository may differ between the messages of a single stream.
+type repoAuthorizingStream struct {
+	grpc.ServerStream
+	si  *ServerInterceptor
+	ctx context.Context
+}
+
+func (s *repoAuthorizingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.si.authorizeRepoRead(s.ctx, m)
+}
@@ -69,6 +69,10 @@ type ServerArgs struct {
 
 	HttpInterceptor func(http.Handler) http.Handler
 
+	// If supplied, every Commit request must be authorized by
+	// CommitAccessControl before the repository's root is updated.
+	CommitAccessControl CommitAccessControl
+
 	// If supplied, the listener(s) returned from Listeners() will be TLS
 	// listeners. The scheme used in the URLs returned from the gRPC server
 	// will be https.
@@ -105,7 +109,9 @@ func NewServer(args ServerArgs) (*Server, error) {
 	s.wg.Add(2)
 	s.grpcListenAddr = args.GrpcListenAddr
 	s.grpcSrv = grpc.NewServer(append([]grpc.ServerOption{grpc.MaxRecvMsgSize(128 * 1024 * 1024)}, args.Options...)...)
-	var chnkSt remotesapi.ChunkStoreServiceServer = NewHttpFSBackedChunkStore(args.Logger, args.HttpHost, args.DBCache, args.FS, scheme, args.ConcurrencyControl, sealer)
+	rcs := NewHttpFSBackedChunkStore(args.Logger, args.HttpHost, args.DBCache, args.FS, scheme, args.ConcurrencyControl, sealer)
+	rcs.commitAccessControl = args.CommitAccessControl
+	var chnkSt remotesapi.ChunkStoreServiceServer = rcs
 
 	if args.ReadOnly {
 		chnkSt = ReadOnlyChunkStore{chnkSt}
@@ -385,6 +385,51 @@ func CopyIndexes(from, to Schema) Schema {
 	return toSch
 }
 
+// RekeySchema returns a copy of |sch| whose primary key is made up of the columns in the primary key of |target|, in
+// the same order. Columns are matched by tag, and an error is returned if |sch| is missing one of the primary key
+// columns of |target| or has it with a different type.
+func RekeySchema(sch, target Schema) (Schema, error) {
+	pkTags := target.GetPKCols().Tags
+	if len(pkTags) == 0 || IsKeyless(sch) {
+		return nil, fmt.Errorf("cannot change the primary key of a keyless table")
+	}
+
+	isPk := make(map[uint64]bool, len(pkTags))
+	for _, tag := range pkTags {
+		targetCol := target.GetPKCols().TagToCol[tag]
+		col, ok := sch.GetAllCols().GetByTag(tag)
+		if !ok {
+			return nil, fmt.Errorf("primary key column %s does not exist", targetCol.Name)
+		}
+		if !col.TypeInfo.ToSqlType().Equals(targetCol.TypeInfo.ToSqlType()) {
+			return nil, fmt.Errorf("primary key column %s has a different type", targetCol.Name)
+		}
+		isPk[tag] = true
+	}
+
+	cols := make([]Column, 0, sch.GetAllCols().Size())
+	for _, col := range sch.GetAllCols().GetColumns() {
+		col.IsPartOfPK = isPk[col.Tag]
+		if col.IsPartOfPK && col.IsNullable() {
+			col.Constraints = append(append([]ColConstraint{}, col.Constraints...), NotNullConstraint{})
+		}
+		cols = append(cols, col)
+	}
+	allCols := NewColCollection(cols...)
+
+	pkOrdinals := make([]int, len(pkTags))
+	for i, tag := range pkTags {
+		pkOrdinals[i] = allCols.TagToIdx[tag]
+	}
+
+	rekeyed, err := NewSchema(allCols, pkOrdinals, sch.GetCollation(), nil, sch.Checks())
+	if err != nil {
+		return nil, err
+	}
+	rekeyed.Indexes().AddIndex(sch.Indexes().AllIndexes()...)
+	return rekeyed, nil
+}
+
 // GetKeyColumnTags returns a set.Uint64Set containing the column tags
 // of every key column of every primary and secondary index in |sch|.
 func GetKeyColumnTags(sch Schema) *set.Uint64Set {
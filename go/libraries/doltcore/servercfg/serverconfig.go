@@ -19,9 +19,12 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
+	"path"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 )
 
 var DefaultUnixSocketFilePath = DefaultMySQLUnixSocketFilePath
@@ -60,6 +63,22 @@ const (
 	DefaultMySQLUnixSocketFilePath = "/tmp/mysql.sock"
 	DefaultMaxLoggedQueryLen       = 0
 	DefaultEncodeLoggedQuery       = false
+	DefaultWebhookMaxRetries       = 3
+	DefaultAutoGCEnable            = false
+	DefaultAutoGCCheckIntervalMs   = 60 * 1000 // 1 minute
+	DefaultAutoGCJournalSizeMB     = 256
+	DefaultAutoGCOldGenGrowthMB    = 0
+	DefaultAutoGCKillConnections   = false
+	DefaultCDCName                 = "dolt"
+	DefaultCDCMaxFileSizeMB        = 100
+	DefaultCDCMaxFiles             = 0
+	DefaultCDCMaxRetries           = 3
+)
+
+const (
+	CDCSinkFile   = "file"
+	CDCSinkStdout = "stdout"
+	CDCSinkHTTP   = "http"
 )
 
 func ptr[T any](t T) *T {
@@ -117,6 +136,64 @@ type JwksConfig struct {
 	FieldsToLog []string          `yaml:"fields_to_log"`
 }
 
+// WebhookConfig is the configuration for a webhook which is notified when the head of a branch is updated.
+type WebhookConfig interface {
+	// Name identifies the webhook in logs.
+	Name() string
+	// URL is the endpoint which branch update notifications are POSTed to.
+	URL() string
+	// Branches are glob patterns of the branches to notify about. All branches match if it is empty.
+	Branches() []string
+	// Secret is the key used to sign the payload with HMAC-SHA256. Payloads are not signed if it is empty.
+	Secret() string
+	// MaxRetries is the number of times a failed notification is retried before it is dropped.
+	MaxRetries() int
+}
+
+// AutoGCConfig is the configuration for garbage collection run automatically in the background by the server.
+type AutoGCConfig interface {
+	// Enable is true if the server should run garbage collection automatically.
+	Enable() bool
+	// CheckInterval is how often the sizes of the chunk journal and old generation of each database are checked.
+	CheckInterval() time.Duration
+	// JournalSizeThreshold is the number of bytes a database's chunk journal may grow by since its last automatic GC
+	// before another one is run. This runs a default GC, which only collects the new generation, if KillConnections is
+	// true, and a shallow GC otherwise. A value of 0 disables it.
+	JournalSizeThreshold() uint64
+	// OldGenGrowthThreshold is the number of bytes a database's old generation may grow by since its last full GC
+	// before another full GC is run. A value of 0 disables it. This requires KillConnections.
+	OldGenGrowthThreshold() uint64
+	// KillConnections is true if automatic GC may run default and full GCs. Like dolt_gc(), these kill every
+	// connection to the server when they complete, so they must be opted into. Otherwise, only shallow GCs are run,
+	// which remove unreferenced table files without interrupting any connections.
+	KillConnections() bool
+}
+
+// CDCConfig is the configuration for the change data capture stream, which emits a Debezium-style JSON event for
+// every row changed by a commit to a branch.
+type CDCConfig interface {
+	// Name is the logical name of the server, used as the source name of every event.
+	Name() string
+	// Sink is where events are written to, one of CDCSinkFile, CDCSinkStdout or CDCSinkHTTP.
+	Sink() string
+	// Path is the file events are appended to for the file sink.
+	Path() string
+	// MaxFileSize is the size, in bytes, at which the file of the file sink is rotated. A value of 0 disables rotation.
+	MaxFileSize() uint64
+	// MaxFiles is the number of rotated files kept by the file sink. A value of 0 keeps all of them.
+	MaxFiles() int
+	// URL is the endpoint which events are POSTed to for the http sink.
+	URL() string
+	// MaxRetries is the number of times a failed delivery to the http sink is retried before it is given up on until
+	// the next branch update.
+	MaxRetries() int
+	// Branches are glob patterns of the branches to capture changes from. All branches match if it is empty.
+	Branches() []string
+	// PositionFile is the file the position of the stream is stored in, so that it resumes where it left off when the
+	// server is restarted. If it is empty, the position is stored in the server's cfg_dir.
+	PositionFile() string
+}
+
 // ServerConfig contains all of the configurable options for the MySQL-compatible server.
 type ServerConfig interface {
 	// Host returns the domain that the server will run on. Accepts an IPv4 or IPv6 address, in addition to localhost.
@@ -181,6 +258,8 @@ type ServerConfig interface {
 	SystemVars() map[string]interface{}
 	// JwksConfig is an array containing jwks config
 	JwksConfig() []JwksConfig
+	// Webhooks are the webhooks notified when branch heads are updated.
+	Webhooks() []WebhookConfig
 	// AllowCleartextPasswords is true if the server should accept cleartext passwords.
 	AllowCleartextPasswords() bool
 	// Socket is a path to the unix socket file
@@ -194,6 +273,10 @@ type ServerConfig interface {
 	RemotesapiReadOnly() *bool
 	// ClusterConfig is the configuration for clustering in this sql-server.
 	ClusterConfig() ClusterConfig
+	// AutoGCConfig is the configuration for automatic garbage collection in this sql-server.
+	AutoGCConfig() AutoGCConfig
+	// CDCConfig is the configuration for the change data capture stream of this sql-server, or nil if it is disabled.
+	CDCConfig() CDCConfig
 	// EventSchedulerStatus is the configuration for enabling or disabling the event scheduler in this server.
 	EventSchedulerStatus() string
 	// ValueSet returns whether the value string provided was explicitly set in the config
@@ -267,9 +350,101 @@ func ValidateConfig(config ServerConfig) error {
 	if config.RequireSecureTransport() && config.TLSCert() == "" && config.TLSKey() == "" {
 		return fmt.Errorf("require_secure_transport can only be `true` when a tls_key and tls_cert are provided.")
 	}
+	if err := ValidateWebhooks(config.Webhooks()); err != nil {
+		return err
+	}
+	if err := ValidateAutoGCConfig(config.AutoGCConfig()); err != nil {
+		return err
+	}
+	if err := ValidateCDCConfig(config.CDCConfig()); err != nil {
+		return err
+	}
 	return ValidateClusterConfig(config.ClusterConfig())
 }
 
+// ValidateAutoGCConfig returns an `error` if automatic garbage collection is enabled without a check interval or any
+// threshold which would trigger it.
+func ValidateAutoGCConfig(config AutoGCConfig) error {
+	if config == nil || !config.Enable() {
+		return nil
+	}
+	if config.CheckInterval() <= 0 {
+		return fmt.Errorf("auto_gc: check_interval_millis must be positive")
+	}
+	if config.JournalSizeThreshold() == 0 && config.OldGenGrowthThreshold() == 0 {
+		return fmt.Errorf("auto_gc: at least one of journal_size_threshold_mb and oldgen_growth_threshold_mb must be set")
+	}
+	if config.OldGenGrowthThreshold() > 0 && !config.KillConnections() {
+		return fmt.Errorf("auto_gc: oldgen_growth_threshold_mb requires kill_connections, since a full GC kills every connection to the server")
+	}
+	return nil
+}
+
+// ValidateCDCConfig returns an `error` if the change data capture stream has an unknown sink, is missing the settings
+// its sink needs, or has an invalid branch pattern.
+func ValidateCDCConfig(config CDCConfig) error {
+	if config == nil {
+		return nil
+	}
+	switch config.Sink() {
+	case CDCSinkFile:
+		if config.Path() == "" {
+			return fmt.Errorf("cdc: path is required for the %s sink", CDCSinkFile)
+		}
+	case CDCSinkStdout:
+	case CDCSinkHTTP:
+		u, err := url.Parse(config.URL())
+		if err != nil {
+			return fmt.Errorf("cdc: url is invalid: %w", err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("cdc: url must be an http or https url, got '%s'", config.URL())
+		}
+	default:
+		return fmt.Errorf("cdc: sink must be one of %s, %s or %s, got '%s'", CDCSinkFile, CDCSinkStdout, CDCSinkHTTP, config.Sink())
+	}
+	for _, pattern := range config.Branches() {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("cdc: branch pattern '%s' is invalid: %w", pattern, err)
+		}
+	}
+	if config.MaxFiles() < 0 {
+		return fmt.Errorf("cdc: max_files must be non-negative")
+	}
+	if config.MaxRetries() < 0 {
+		return fmt.Errorf("cdc: max_retries must be non-negative")
+	}
+	return nil
+}
+
+// ValidateWebhooks returns an `error` if any of the webhooks is missing a valid URL, has an invalid branch pattern or
+// has the same name as another webhook. A webhook without a name is named by its URL.
+func ValidateWebhooks(webhooks []WebhookConfig) error {
+	names := make(map[string]struct{}, len(webhooks))
+	for _, hook := range webhooks {
+		if _, ok := names[hook.Name()]; ok {
+			return fmt.Errorf("webhook '%s': name must be unique", hook.Name())
+		}
+		names[hook.Name()] = struct{}{}
+		u, err := url.Parse(hook.URL())
+		if err != nil {
+			return fmt.Errorf("webhook '%s': url is invalid: %w", hook.Name(), err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("webhook '%s': url must be an http or https url, got '%s'", hook.Name(), hook.URL())
+		}
+		for _, pattern := range hook.Branches() {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("webhook '%s': branch pattern '%s' is invalid: %w", hook.Name(), pattern, err)
+			}
+		}
+		if hook.MaxRetries() < 0 {
+			return fmt.Errorf("webhook '%s': max_retries must be non-negative", hook.Name())
+		}
+	}
+	return nil
+}
+
 const (
 	HostKey                         = "host"
 	PortKey                         = "port"
@@ -298,11 +473,14 @@ const (
 	UserVarsKey                     = "user_vars"
 	SystemVarsKey                   = "system_vars"
 	JwksConfigKey                   = "jwks_config"
+	WebhooksKey                     = "webhooks"
 	AllowCleartextPasswordsKey      = "allow_cleartext_passwords"
 	SocketKey                       = "socket"
 	RemotesapiPortKey               = "remotesapi_port"
 	RemotesapiReadOnlyKey           = "remotesapi_read_only"
 	ClusterConfigKey                = "cluster_config"
+	AutoGCConfigKey                 = "auto_gc_config"
+	CDCConfigKey                    = "cdc_config"
 	EventSchedulerKey               = "event_scheduler"
 )
 
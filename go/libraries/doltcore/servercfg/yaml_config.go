@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 	"unicode"
 	"unicode/utf8"
 
@@ -143,6 +144,9 @@ type YAMLConfig struct {
 	GoldenMysqlConn *string                `yaml:"golden_mysql_conn,omitempty"`
 	MetricsConfig   MetricsYAMLConfig      `yaml:"metrics,omitempty"`
 	ClusterCfg      *ClusterYAMLConfig     `yaml:"cluster,omitempty"`
+	Webhooks_       []WebhookYAMLConfig    `yaml:"webhooks,omitempty" minver:"TBD"`
+	AutoGC_         *AutoGCYAMLConfig      `yaml:"auto_gc,omitempty" minver:"TBD"`
+	CDC_            *CDCYAMLConfig         `yaml:"cdc,omitempty" minver:"TBD"`
 }
 
 var _ ServerConfig = YAMLConfig{}
@@ -220,9 +224,62 @@ func ServerConfigAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 		SystemVars_:       systemVars,
 		Vars:              cfg.UserVars(),
 		Jwks:              cfg.JwksConfig(),
+		Webhooks_:         webhooksAsYAMLConfig(cfg.Webhooks()),
+		AutoGC_:           autoGCConfigAsYAMLConfig(cfg.AutoGCConfig()),
+		CDC_:              cdcConfigAsYAMLConfig(cfg.CDCConfig()),
 	}
 }
 
+func autoGCConfigAsYAMLConfig(config AutoGCConfig) *AutoGCYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &AutoGCYAMLConfig{
+		Enable_:                  ptr(config.Enable()),
+		CheckIntervalMillis_:     ptr(uint64(config.CheckInterval().Milliseconds())),
+		JournalSizeThresholdMB_:  ptr(config.JournalSizeThreshold() / bytesPerMB),
+		OldGenGrowthThresholdMB_: ptr(config.OldGenGrowthThreshold() / bytesPerMB),
+		KillConnections_:         ptr(config.KillConnections()),
+	}
+}
+
+func cdcConfigAsYAMLConfig(config CDCConfig) *CDCYAMLConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &CDCYAMLConfig{
+		Name_:          ptr(config.Name()),
+		Sink_:          ptr(config.Sink()),
+		Path_:          nillableStrPtr(config.Path()),
+		MaxFileSizeMB_: ptr(config.MaxFileSize() / bytesPerMB),
+		MaxFiles_:      ptr(config.MaxFiles()),
+		URL_:           nillableStrPtr(config.URL()),
+		MaxRetries_:    ptr(config.MaxRetries()),
+		Branches_:      config.Branches(),
+		PositionFile_:  nillableStrPtr(config.PositionFile()),
+	}
+}
+
+func webhooksAsYAMLConfig(webhooks []WebhookConfig) []WebhookYAMLConfig {
+	if webhooks == nil {
+		return nil
+	}
+
+	ret := make([]WebhookYAMLConfig, len(webhooks))
+	for i, hook := range webhooks {
+		ret[i] = WebhookYAMLConfig{
+			Name_:       ptr(hook.Name()),
+			URL_:        ptr(hook.URL()),
+			Branches_:   hook.Branches(),
+			Secret_:     nillableStrPtr(hook.Secret()),
+			MaxRetries_: ptr(hook.MaxRetries()),
+		}
+	}
+	return ret
+}
+
 func clusterConfigAsYAMLConfig(config ClusterConfig) *ClusterYAMLConfig {
 	if config == nil {
 		return nil
@@ -293,6 +350,9 @@ func ServerConfigSetValuesAsYAMLConfig(cfg ServerConfig) *YAMLConfig {
 		SystemVars_:       zeroIf(systemVars, !cfg.ValueSet(SystemVarsKey)),
 		Vars:              zeroIf(cfg.UserVars(), !cfg.ValueSet(UserVarsKey)),
 		Jwks:              zeroIf(cfg.JwksConfig(), !cfg.ValueSet(JwksConfigKey)),
+		Webhooks_:         zeroIf(webhooksAsYAMLConfig(cfg.Webhooks()), !cfg.ValueSet(WebhooksKey)),
+		AutoGC_:           zeroIf(autoGCConfigAsYAMLConfig(cfg.AutoGCConfig()), !cfg.ValueSet(AutoGCConfigKey)),
+		CDC_:              zeroIf(cdcConfigAsYAMLConfig(cfg.CDCConfig()), !cfg.ValueSet(CDCConfigKey)),
 	}
 }
 
@@ -445,6 +505,39 @@ func (cfg YAMLConfig) withPlaceholdersFilledIn() YAMLConfig {
 		withPlaceholders.Jwks = []JwksConfig{}
 	}
 
+	if withPlaceholders.Webhooks_ == nil {
+		withPlaceholders.Webhooks_ = []WebhookYAMLConfig{
+			{
+				Name_:       ptr("ci"),
+				URL_:        ptr("https://ci.example.com/hooks/dolt"),
+				Branches_:   []string{"main", "release/*"},
+				Secret_:     ptr("webhook_secret"),
+				MaxRetries_: ptr(DefaultWebhookMaxRetries),
+			},
+		}
+	}
+
+	if withPlaceholders.AutoGC_ == nil {
+		withPlaceholders.AutoGC_ = &AutoGCYAMLConfig{
+			Enable_:                  ptr(true),
+			CheckIntervalMillis_:     ptr(uint64(DefaultAutoGCCheckIntervalMs)),
+			JournalSizeThresholdMB_:  ptr(uint64(DefaultAutoGCJournalSizeMB)),
+			OldGenGrowthThresholdMB_: ptr(uint64(4096)),
+			KillConnections_:         ptr(true),
+		}
+	}
+
+	if withPlaceholders.CDC_ == nil {
+		withPlaceholders.CDC_ = &CDCYAMLConfig{
+			Name_:          ptr(DefaultCDCName),
+			Sink_:          ptr(CDCSinkFile),
+			Path_:          ptr("cdc/changes.jsonl"),
+			MaxFileSizeMB_: ptr(uint64(DefaultCDCMaxFileSizeMB)),
+			MaxFiles_:      ptr(10),
+			Branches_:      []string{"main"},
+		}
+	}
+
 	return withPlaceholders
 }
 
@@ -740,6 +833,19 @@ func (cfg YAMLConfig) JwksConfig() []JwksConfig {
 	return nil
 }
 
+// Webhooks are the webhooks notified when branch heads are updated.
+func (cfg YAMLConfig) Webhooks() []WebhookConfig {
+	if cfg.Webhooks_ == nil {
+		return nil
+	}
+
+	ret := make([]WebhookConfig, len(cfg.Webhooks_))
+	for i := range cfg.Webhooks_ {
+		ret[i] = cfg.Webhooks_[i]
+	}
+	return ret
+}
+
 func (cfg YAMLConfig) AllowCleartextPasswords() bool {
 	if cfg.ListenerConfig.AllowCleartextPasswords == nil {
 		return DefaultAllowCleartextPasswords
@@ -832,6 +938,22 @@ func (cfg YAMLConfig) ClusterConfig() ClusterConfig {
 	return cfg.ClusterCfg
 }
 
+// AutoGCConfig is the configuration for automatic garbage collection in this sql-server.
+func (cfg YAMLConfig) AutoGCConfig() AutoGCConfig {
+	if cfg.AutoGC_ == nil {
+		return nil
+	}
+	return cfg.AutoGC_
+}
+
+// CDCConfig is the configuration for the change data capture stream of this sql-server, or nil if it is disabled.
+func (cfg YAMLConfig) CDCConfig() CDCConfig {
+	if cfg.CDC_ == nil {
+		return nil
+	}
+	return cfg.CDC_
+}
+
 func (cfg YAMLConfig) EventSchedulerStatus() string {
 	if cfg.BehaviorConfig.EventSchedulerStatus == nil {
 		return "ON"
@@ -937,3 +1059,160 @@ func (cfg YAMLConfig) ValueSet(value string) bool {
 	}
 	return false
 }
+
+type WebhookYAMLConfig struct {
+	Name_       *string  `yaml:"name,omitempty" minver:"TBD"`
+	URL_        *string  `yaml:"url,omitempty" minver:"TBD"`
+	Branches_   []string `yaml:"branches,omitempty" minver:"TBD"`
+	Secret_     *string  `yaml:"secret,omitempty" minver:"TBD"`
+	MaxRetries_ *int     `yaml:"max_retries,omitempty" minver:"TBD"`
+}
+
+func (c WebhookYAMLConfig) Name() string {
+	if c.Name_ == nil {
+		return c.URL()
+	}
+	return *c.Name_
+}
+
+func (c WebhookYAMLConfig) URL() string {
+	if c.URL_ == nil {
+		return ""
+	}
+	return *c.URL_
+}
+
+func (c WebhookYAMLConfig) Branches() []string {
+	return c.Branches_
+}
+
+func (c WebhookYAMLConfig) Secret() string {
+	if c.Secret_ == nil {
+		return ""
+	}
+	return *c.Secret_
+}
+
+func (c WebhookYAMLConfig) MaxRetries() int {
+	if c.MaxRetries_ == nil {
+		return DefaultWebhookMaxRetries
+	}
+	return *c.MaxRetries_
+}
+
+const bytesPerMB = 1024 * 1024
+
+type AutoGCYAMLConfig struct {
+	Enable_                  *bool   `yaml:"enable,omitempty" minver:"TBD"`
+	CheckIntervalMillis_     *uint64 `yaml:"check_interval_millis,omitempty" minver:"TBD"`
+	JournalSizeThresholdMB_  *uint64 `yaml:"journal_size_threshold_mb,omitempty" minver:"TBD"`
+	OldGenGrowthThresholdMB_ *uint64 `yaml:"oldgen_growth_threshold_mb,omitempty" minver:"TBD"`
+	KillConnections_         *bool   `yaml:"kill_connections,omitempty" minver:"TBD"`
+}
+
+func (c *AutoGCYAMLConfig) Enable() bool {
+	if c.Enable_ == nil {
+		return DefaultAutoGCEnable
+	}
+	return *c.Enable_
+}
+
+func (c *AutoGCYAMLConfig) CheckInterval() time.Duration {
+	if c.CheckIntervalMillis_ == nil {
+		return DefaultAutoGCCheckIntervalMs * time.Millisecond
+	}
+	return time.Duration(*c.CheckIntervalMillis_) * time.Millisecond
+}
+
+func (c *AutoGCYAMLConfig) JournalSizeThreshold() uint64 {
+	if c.JournalSizeThresholdMB_ == nil {
+		return DefaultAutoGCJournalSizeMB * bytesPerMB
+	}
+	return *c.JournalSizeThresholdMB_ * bytesPerMB
+}
+
+func (c *AutoGCYAMLConfig) OldGenGrowthThreshold() uint64 {
+	if c.OldGenGrowthThresholdMB_ == nil {
+		return DefaultAutoGCOldGenGrowthMB * bytesPerMB
+	}
+	return *c.OldGenGrowthThresholdMB_ * bytesPerMB
+}
+
+func (c *AutoGCYAMLConfig) KillConnections() bool {
+	if c.KillConnections_ == nil {
+		return DefaultAutoGCKillConnections
+	}
+	return *c.KillConnections_
+}
+
+type CDCYAMLConfig struct {
+	Name_          *string  `yaml:"name,omitempty" minver:"TBD"`
+	Sink_          *string  `yaml:"sink,omitempty" minver:"TBD"`
+	Path_          *string  `yaml:"path,omitempty" minver:"TBD"`
+	MaxFileSizeMB_ *uint64  `yaml:"max_file_size_mb,omitempty" minver:"TBD"`
+	MaxFiles_      *int     `yaml:"max_files,omitempty" minver:"TBD"`
+	URL_           *string  `yaml:"url,omitempty" minver:"TBD"`
+	MaxRetries_    *int     `yaml:"max_retries,omitempty" minver:"TBD"`
+	Branches_      []string `yaml:"branches,omitempty" minver:"TBD"`
+	PositionFile_  *string  `yaml:"position_file,omitempty" minver:"TBD"`
+}
+
+func (c *CDCYAMLConfig) Name() string {
+	if c.Name_ == nil {
+		return DefaultCDCName
+	}
+	return *c.Name_
+}
+
+func (c *CDCYAMLConfig) Sink() string {
+	if c.Sink_ == nil {
+		return CDCSinkFile
+	}
+	return *c.Sink_
+}
+
+func (c *CDCYAMLConfig) Path() string {
+	if c.Path_ == nil {
+		return ""
+	}
+	return *c.Path_
+}
+
+func (c *CDCYAMLConfig) MaxFileSize() uint64 {
+	if c.MaxFileSizeMB_ == nil {
+		return DefaultCDCMaxFileSizeMB * bytesPerMB
+	}
+	return *c.MaxFileSizeMB_ * bytesPerMB
+}
+
+func (c *CDCYAMLConfig) MaxFiles() int {
+	if c.MaxFiles_ == nil {
+		return DefaultCDCMaxFiles
+	}
+	return *c.MaxFiles_
+}
+
+func (c *CDCYAMLConfig) URL() string {
+	if c.URL_ == nil {
+		return ""
+	}
+	return *c.URL_
+}
+
+func (c *CDCYAMLConfig) MaxRetries() int {
+	if c.MaxRetries_ == nil {
+		return DefaultCDCMaxRetries
+	}
+	return *c.MaxRetries_
+}
+
+func (c *CDCYAMLConfig) Branches() []string {
+	return c.Branches_
+}
+
+func (c *CDCYAMLConfig) PositionFile() string {
+	if c.PositionFile_ == nil {
+		return ""
+	}
+	return *c.PositionFile_
+}
@@ -16,6 +16,7 @@ package servercfg
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -287,6 +288,325 @@ cluster:
 	}
 }
 
+func TestUnmarshallWebhooks(t *testing.T) {
+	testStr := `
+webhooks:
+- name: ci
+  url: https://ci.example.com/hooks/dolt
+  branches:
+  - main
+  - release/*
+  secret: shh
+  max_retries: 5
+- url: http://localhost:8080/notify
+`
+	config, err := NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	hooks := config.Webhooks()
+	require.Len(t, hooks, 2)
+	require.Equal(t, "ci", hooks[0].Name())
+	require.Equal(t, "https://ci.example.com/hooks/dolt", hooks[0].URL())
+	require.Equal(t, []string{"main", "release/*"}, hooks[0].Branches())
+	require.Equal(t, "shh", hooks[0].Secret())
+	require.Equal(t, 5, hooks[0].MaxRetries())
+	require.Equal(t, "http://localhost:8080/notify", hooks[1].Name())
+	require.Empty(t, hooks[1].Branches())
+	require.Equal(t, "", hooks[1].Secret())
+	require.Equal(t, DefaultWebhookMaxRetries, hooks[1].MaxRetries())
+	require.NoError(t, ValidateWebhooks(hooks))
+}
+
+func TestValidateWebhooks(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Config string
+		Error  bool
+	}{
+		{
+			Name:   "no webhooks",
+			Config: "",
+			Error:  false,
+		},
+		{
+			Name: "missing url",
+			Config: `
+webhooks:
+- name: ci
+`,
+			Error: true,
+		},
+		{
+			Name: "unsupported url scheme",
+			Config: `
+webhooks:
+- url: ftp://example.com/hook
+`,
+			Error: true,
+		},
+		{
+			Name: "bad branch pattern",
+			Config: `
+webhooks:
+- url: https://example.com/hook
+  branches:
+  - "release/["
+`,
+			Error: true,
+		},
+		{
+			Name: "negative max_retries",
+			Config: `
+webhooks:
+- url: https://example.com/hook
+  max_retries: -1
+`,
+			Error: true,
+		},
+		{
+			Name: "duplicate names",
+			Config: `
+webhooks:
+- name: ci
+  url: https://example.com/hook
+- name: ci
+  url: https://example.com/other
+`,
+			Error: true,
+		},
+		{
+			Name: "duplicate urls without names",
+			Config: `
+webhooks:
+- url: https://example.com/hook
+- url: https://example.com/hook
+`,
+			Error: true,
+		},
+		{
+			Name: "same url with different names",
+			Config: `
+webhooks:
+- name: ci
+  url: https://example.com/hook
+- name: deploy
+  url: https://example.com/hook
+`,
+			Error: false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			cfg, err := NewYamlConfig([]byte(c.Config))
+			require.NoError(t, err)
+			if c.Error {
+				require.Error(t, ValidateWebhooks(cfg.Webhooks()))
+			} else {
+				require.NoError(t, ValidateWebhooks(cfg.Webhooks()))
+			}
+		})
+	}
+}
+
+func TestUnmarshallAutoGC(t *testing.T) {
+	config, err := NewYamlConfig([]byte(""))
+	require.NoError(t, err)
+	require.Nil(t, config.AutoGCConfig())
+
+	testStr := `
+auto_gc:
+  enable: true
+  check_interval_millis: 5000
+  oldgen_growth_threshold_mb: 1024
+  kill_connections: true
+`
+	config, err = NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	autoGC := config.AutoGCConfig()
+	require.NotNil(t, autoGC)
+	require.True(t, autoGC.Enable())
+	require.Equal(t, 5*time.Second, autoGC.CheckInterval())
+	require.Equal(t, uint64(DefaultAutoGCJournalSizeMB*1024*1024), autoGC.JournalSizeThreshold())
+	require.Equal(t, uint64(1024*1024*1024), autoGC.OldGenGrowthThreshold())
+	require.True(t, autoGC.KillConnections())
+	require.NoError(t, ValidateAutoGCConfig(autoGC))
+}
+
+func TestValidateAutoGCConfig(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Config string
+		Error  bool
+	}{
+		{
+			Name: "disabled",
+			Config: `
+auto_gc:
+  enable: false
+  check_interval_millis: 0
+`,
+			Error: false,
+		},
+		{
+			Name: "zero check interval",
+			Config: `
+auto_gc:
+  enable: true
+  check_interval_millis: 0
+`,
+			Error: true,
+		},
+		{
+			Name: "no thresholds",
+			Config: `
+auto_gc:
+  enable: true
+  journal_size_threshold_mb: 0
+`,
+			Error: true,
+		},
+		{
+			Name: "full gc without killing connections",
+			Config: `
+auto_gc:
+  enable: true
+  oldgen_growth_threshold_mb: 1024
+`,
+			Error: true,
+		},
+		{
+			Name: "journal threshold without killing connections",
+			Config: `
+auto_gc:
+  enable: true
+  journal_size_threshold_mb: 128
+`,
+			Error: false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			cfg, err := NewYamlConfig([]byte(c.Config))
+			require.NoError(t, err)
+			if c.Error {
+				require.Error(t, ValidateAutoGCConfig(cfg.AutoGCConfig()))
+			} else {
+				require.NoError(t, ValidateAutoGCConfig(cfg.AutoGCConfig()))
+			}
+		})
+	}
+}
+
+func TestUnmarshallCDC(t *testing.T) {
+	config, err := NewYamlConfig([]byte(""))
+	require.NoError(t, err)
+	require.Nil(t, config.CDCConfig())
+
+	testStr := `
+cdc:
+  name: inventory
+  path: cdc/changes.jsonl
+  max_file_size_mb: 10
+  max_files: 5
+  branches:
+    - main
+    - release/*
+`
+	config, err = NewYamlConfig([]byte(testStr))
+	require.NoError(t, err)
+	cdc := config.CDCConfig()
+	require.NotNil(t, cdc)
+	require.Equal(t, "inventory", cdc.Name())
+	require.Equal(t, CDCSinkFile, cdc.Sink())
+	require.Equal(t, "cdc/changes.jsonl", cdc.Path())
+	require.Equal(t, uint64(10*1024*1024), cdc.MaxFileSize())
+	require.Equal(t, 5, cdc.MaxFiles())
+	require.Equal(t, DefaultCDCMaxRetries, cdc.MaxRetries())
+	require.Equal(t, []string{"main", "release/*"}, cdc.Branches())
+	require.Equal(t, "", cdc.PositionFile())
+	require.NoError(t, ValidateCDCConfig(cdc))
+}
+
+func TestValidateCDCConfig(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Config string
+		Error  bool
+	}{
+		{
+			Name: "stdout",
+			Config: `
+cdc:
+  sink: stdout
+`,
+			Error: false,
+		},
+		{
+			Name: "http",
+			Config: `
+cdc:
+  sink: http
+  url: https://example.com/events
+`,
+			Error: false,
+		},
+		{
+			Name: "unknown sink",
+			Config: `
+cdc:
+  sink: kafka
+`,
+			Error: true,
+		},
+		{
+			Name: "file sink without path",
+			Config: `
+cdc:
+  sink: file
+`,
+			Error: true,
+		},
+		{
+			Name: "http sink without url",
+			Config: `
+cdc:
+  sink: http
+`,
+			Error: true,
+		},
+		{
+			Name: "invalid branch pattern",
+			Config: `
+cdc:
+  sink: stdout
+  branches:
+    - "release/["
+`,
+			Error: true,
+		},
+		{
+			Name: "negative max retries",
+			Config: `
+cdc:
+  sink: http
+  url: https://example.com/events
+  max_retries: -1
+`,
+			Error: true,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			cfg, err := NewYamlConfig([]byte(c.Config))
+			require.NoError(t, err)
+			if c.Error {
+				require.Error(t, ValidateCDCConfig(cfg.CDCConfig()))
+			} else {
+				require.NoError(t, ValidateCDCConfig(cfg.CDCConfig()))
+			}
+		})
+	}
+}
+
 // Tests that a common YAML error (incorrect indentation) throws an error
 func TestUnmarshallError(t *testing.T) {
 	testStr := `
@@ -113,14 +113,15 @@ func parseBinlogFilename(filename string) (branch string, sequence int, err erro
 
 	filename = strings.TrimPrefix(filename, "binlog-")
 
-	splits := strings.Split(filename, ".")
-	if len(splits) != 2 {
+	// Branch names may contain '.', so the sequence number follows the last '.'
+	i := strings.LastIndex(filename, ".")
+	if i <= 0 {
 		return "", 0, fmt.Errorf(
 			"unable to parse binlog filename: %s; expected format 'binlog-branch.sequence'", filename)
 	}
 
-	branch = splits[0]
-	sequenceString := splits[1]
+	branch = filename[:i]
+	sequenceString := filename[i+1:]
 
 	sequence, err = strconv.Atoi(sequenceString)
 	if err != nil {
@@ -15,9 +15,11 @@
 package binlogreplication
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
@@ -32,6 +34,22 @@ const replicationRunningStateDirectory = ".doltcfg"
 // replicaRunningFilename holds the name of the file that indicates replication was running on a replica server.
 const replicaRunningFilename = "replica-running"
 
+// channelConfigurationFilename holds the name of the file in the .doltcfg directory that stores the configuration of
+// replication channels that can't be stored in the "mysql" database.
+const channelConfigurationFilename = "replica-channels.json"
+
+// channelConfigurationMutex guards reading and writing the channel configuration file.
+var channelConfigurationMutex = &sync.Mutex{}
+
+// channelConfiguration is the persisted configuration of a single replication channel. The source options and running
+// state of the default channel are stored in the "mysql" database and the "replica-running" file, so only the Branch
+// field is used for the default channel.
+type channelConfiguration struct {
+	Source  *mysql_db.ReplicaSourceInfo `json:"source,omitempty"`
+	Branch  string                      `json:"branch,omitempty"`
+	Running bool                        `json:"running,omitempty"`
+}
+
 // replicaRunningState indicates if a replica was actively running replication.
 type replicaRunningState int
 
@@ -140,6 +158,77 @@ func persistSourceUuid(ctx *sql.Context, sourceUuid string, mysqlDb *mysql_db.My
 	return persistReplicationConfiguration(ctx, replicaSourceInfo, mysqlDb)
 }
 
+// loadChannelConfigurations loads the configuration of all replication channels stored in the .doltcfg directory,
+// keyed by channel name. If no configuration has been stored, an empty map is returned.
+func loadChannelConfigurations(ctx *sql.Context) (map[string]channelConfiguration, error) {
+	channelConfigurationMutex.Lock()
+	defer channelConfigurationMutex.Unlock()
+	return readChannelConfigurations(ctx)
+}
+
+// loadChannelConfiguration loads the stored configuration of the replication channel named |channel|. The returned
+// boolean is false if no configuration is stored for the channel.
+func loadChannelConfiguration(ctx *sql.Context, channel string) (channelConfiguration, bool, error) {
+	configs, err := loadChannelConfigurations(ctx)
+	if err != nil {
+		return channelConfiguration{}, false, err
+	}
+	config, ok := configs[channel]
+	return config, ok, nil
+}
+
+// updateChannelConfiguration calls |f| with the stored configuration of the replication channel named |channel|, and
+// then stores the updated configuration. If |f| returns false, the channel's configuration is removed instead.
+func updateChannelConfiguration(ctx *sql.Context, channel string, f func(config *channelConfiguration) bool) error {
+	channelConfigurationMutex.Lock()
+	defer channelConfigurationMutex.Unlock()
+
+	configs, err := readChannelConfigurations(ctx)
+	if err != nil {
+		return err
+	}
+
+	config := configs[channel]
+	if f(&config) {
+		configs[channel] = config
+	} else {
+		delete(configs, channel)
+	}
+
+	filesys := dsess.DSessFromSess(ctx.Session).Provider().FileSystem()
+	if err = createDoltCfgDir(filesys); err != nil {
+		return err
+	}
+	configFilepath, err := filesys.Abs(filepath.Join(replicationRunningStateDirectory, channelConfigurationFilename))
+	if err != nil {
+		return err
+	}
+	bytes, err := json.Marshal(configs)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(configFilepath, bytes, 0600)
+}
+
+// readChannelConfigurations reads the channel configuration file. Callers must hold channelConfigurationMutex.
+func readChannelConfigurations(ctx *sql.Context) (map[string]channelConfiguration, error) {
+	filesys := dsess.DSessFromSess(ctx.Session).Provider().FileSystem()
+	configs := make(map[string]channelConfiguration)
+
+	path := filepath.Join(replicationRunningStateDirectory, channelConfigurationFilename)
+	if exists, _ := filesys.Exists(path); !exists {
+		return configs, nil
+	}
+	bytes, err := filesys.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(bytes, &configs); err != nil {
+		return nil, fmt.Errorf("unable to load replication channel configuration: %s", err.Error())
+	}
+	return configs, nil
+}
+
 // createEmptyFile creates an empty file at |fullFilepath| if a file does not exist already. If a file does exist
 // at that path, no action is taken.
 func createEmptyFile(fullFilepath string) (err error) {
@@ -37,14 +37,27 @@ const mysqlFlavor = "MySQL56"
 // server can be restarted and resume binlog event messages at the correct point.
 type binlogPositionStore struct {
 	mu sync.Mutex
+	// filename is the name of the binlog position file in the .doltcfg directory.
+	filename string
 }
 
-// Load loads a mysql.Position instance from the .doltcfg/binlog-position file at the root of the specified |filesystem|.
+// newBinlogPositionStore creates a binlogPositionStore for the replication channel named |channel|. The position of
+// the default channel is stored in .doltcfg/binlog-position, and the position of a named channel is stored in
+// .doltcfg/binlog-position-<channel>.
+func newBinlogPositionStore(channel string) *binlogPositionStore {
+	filename := binlogPositionFilename
+	if channel != defaultChannel {
+		filename += "-" + channel
+	}
+	return &binlogPositionStore{filename: filename}
+}
+
+// Load loads a mysql.Position instance from the store's binlog position file at the root of the specified |filesystem|.
 // This file MUST be stored at the root of the provider's filesystem, and NOT inside a nested database's .doltcfg directory,
 // since the binlog position contains events that cover all databases in a SQL server. The returned mysql.Position
-// represents the set of GTIDs that have been successfully executed and applied on this replica. Currently only the
-// default binlog channel ("") is supported. If no .doltcfg/binlog-position file is stored, this method returns a nil
-// mysql.Position and a nil error. If any errors are encountered, a nil mysql.Position and an error are returned.
+// represents the set of GTIDs that have been successfully executed and applied on this replica for the store's
+// channel. If no binlog position file is stored, this method returns a nil mysql.Position and a nil error. If any
+// errors are encountered, a nil mysql.Position and an error are returned.
 func (store *binlogPositionStore) Load(filesys filesys.Filesys) (*mysql.Position, error) {
 	store.mu.Lock()
 	defer store.mu.Unlock()
@@ -54,12 +67,12 @@ func (store *binlogPositionStore) Load(filesys filesys.Filesys) (*mysql.Position
 		return nil, nil
 	}
 
-	positionFileExists, _ := filesys.Exists(filepath.Join(binlogPositionDirectory, binlogPositionFilename))
+	positionFileExists, _ := filesys.Exists(filepath.Join(binlogPositionDirectory, store.filename))
 	if !positionFileExists {
 		return nil, nil
 	}
 
-	filePath, err := filesys.Abs(filepath.Join(binlogPositionDirectory, binlogPositionFilename))
+	filePath, err := filesys.Abs(filepath.Join(binlogPositionDirectory, store.filename))
 	if err != nil {
 		return nil, err
 	}
@@ -84,12 +97,11 @@ func (store *binlogPositionStore) Load(filesys filesys.Filesys) (*mysql.Position
 	return &position, nil
 }
 
-// Save saves the specified |position| to disk in the .doltcfg/binlog-position file at the root of the provider's
+// Save saves the specified |position| to disk in the store's binlog position file at the root of the provider's
 // filesystem. This file MUST be stored at the root of the provider's filesystem, and NOT inside a nested database's
 // .doltcfg directory, since the binlog position contains events that cover all databases in a SQL server. |position|
-// represents the set of GTIDs that have been successfully executed and applied on this replica. Currently only the
-// default binlog channel ("") is supported. If any errors are encountered persisting the position to disk, an
-// error is returned.
+// represents the set of GTIDs that have been successfully executed and applied on this replica for the store's
+// channel. If any errors are encountered persisting the position to disk, an error is returned.
 func (store *binlogPositionStore) Save(ctx *sql.Context, position *mysql.Position) error {
 	if position == nil {
 		return fmt.Errorf("unable to save binlog position: nil position passed")
@@ -106,7 +118,7 @@ func (store *binlogPositionStore) Save(ctx *sql.Context, position *mysql.Positio
 		return err
 	}
 
-	filePath, err := filesys.Abs(filepath.Join(binlogPositionDirectory, binlogPositionFilename))
+	filePath, err := filesys.Abs(filepath.Join(binlogPositionDirectory, store.filename))
 	if err != nil {
 		return err
 	}
@@ -115,7 +127,7 @@ func (store *binlogPositionStore) Save(ctx *sql.Context, position *mysql.Positio
 	return os.WriteFile(filePath, []byte(encodedPosition), 0666)
 }
 
-// Delete deletes the stored mysql.Position information stored in .doltcfg/binlog-position in the root of the provider's
+// Delete deletes the stored mysql.Position information stored in the store's binlog position file in the root of the provider's
 // filesystem. This is useful for the "RESET REPLICA" command, since it clears out the current replication state. If
 // any errors are encountered removing the position file, an error is returned.
 func (store *binlogPositionStore) Delete(ctx *sql.Context) error {
@@ -125,7 +137,7 @@ func (store *binlogPositionStore) Delete(ctx *sql.Context) error {
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	filesys := doltSession.Provider().FileSystem()
 
-	return filesys.Delete(filepath.Join(binlogPositionDirectory, binlogPositionFilename), false)
+	return filesys.Delete(filepath.Join(binlogPositionDirectory, store.filename), false)
 }
 
 // createDoltCfgDir creates the .doltcfg directory if it doesn't already exist.
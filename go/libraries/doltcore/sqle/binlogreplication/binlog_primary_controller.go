@@ -18,12 +18,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
 	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/dolthub/vitess/go/mysql"
 	"github.com/sirupsen/logrus"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 )
 
 // DoltBinlogPrimaryController implements the binlogreplication.BinlogPrimaryController
@@ -45,7 +48,6 @@ func NewDoltBinlogPrimaryController() *DoltBinlogPrimaryController {
 
 func (d *DoltBinlogPrimaryController) BinlogProducer(binlogProducer *binlogProducer) {
 	d.binlogProducer = binlogProducer
-	d.streamerManager.logManager = binlogProducer.logManager
 }
 
 // RegisterReplica implements the BinlogPrimaryController interface.
@@ -115,7 +117,12 @@ func (d *DoltBinlogPrimaryController) validateReplicationConfiguration() *mysql.
 	return nil
 }
 
-// BinlogDumpGtid implements the BinlogPrimaryController interface.
+// BinlogDumpGtid implements the BinlogPrimaryController interface. The replica is sent the binlog events of the
+// branch its replication user is mapped to by @@dolt_binlog_replica_branches. If its user isn't mapped to a branch,
+// the replica is sent the binlog events of the branch named by the @@dolt_binlog_branch system variable of its
+// session, or of BinlogBranch if that isn't set.
+// Each branch has its own GTIDs, so only the GTIDs of the streamed branch are compared with the GTIDs the replica
+// has executed.
 func (d *DoltBinlogPrimaryController) BinlogDumpGtid(ctx *sql.Context, conn *mysql.Conn, replicaExecutedGtids mysql.GTIDSet) error {
 	if err := d.validateReplicationConfiguration(); err != nil {
 		return err
@@ -125,8 +132,17 @@ func (d *DoltBinlogPrimaryController) BinlogDumpGtid(ctx *sql.Context, conn *mys
 		replicaExecutedGtids = mysql.Mysql56GTIDSet{}
 	}
 
-	primaryExecutedGtids := d.binlogProducer.gtidPosition.GTIDSet
-	missingGtids := d.binlogProducer.logManager.calculateMissingGtids(replicaExecutedGtids, primaryExecutedGtids)
+	branch, err := replicaBinlogBranch(ctx, conn.User)
+	if err != nil {
+		return mysql.NewSQLError(mysql.ERMasterFatalReadingBinlog, "HY000", err.Error())
+	}
+	logManager, err := d.binlogProducer.logManagerForBranch(ctx, branch)
+	if err != nil {
+		return mysql.NewSQLError(mysql.ERMasterFatalReadingBinlog, "HY000", err.Error())
+	}
+
+	primaryExecutedGtids := d.binlogProducer.executedGtids(logManager.sid)
+	missingGtids := logManager.calculateMissingGtids(replicaExecutedGtids, primaryExecutedGtids)
 	if !missingGtids.Equal(mysql.Mysql56GTIDSet{}) {
 		// We must send back error code 1236 (ER_MASTER_FATAL_ERROR_READING_BINLOG) to the replica to signal an error,
 		// otherwise the replica won't expose the error in replica status and will just keep trying to reconnect and
@@ -138,7 +154,8 @@ func (d *DoltBinlogPrimaryController) BinlogDumpGtid(ctx *sql.Context, conn *mys
 			replicaExecutedGtids.String(), missingGtids.String())
 	}
 
-	err := d.streamerManager.StartStream(ctx, conn, replicaExecutedGtids, d.binlogProducer.binlogFormat, d.binlogProducer.binlogEventMeta)
+	logrus.WithField("connection_id", conn.ConnectionID).Debugf("streaming binlog events for branch %s", branch)
+	err = d.streamerManager.StartStream(ctx, conn, logManager, replicaExecutedGtids, d.binlogProducer.binlogFormat, d.binlogProducer.binlogEventMeta)
 	if err != nil {
 		logrus.Warnf("exiting binlog streamer due to error: %s", err.Error())
 	} else {
@@ -160,7 +177,7 @@ func (d *DoltBinlogPrimaryController) ListBinaryLogs(_ *sql.Context) ([]binlogre
 	}
 	logManager := d.binlogProducer.logManager
 
-	logFiles, err := logManager.logFilesOnDiskForBranch(BinlogBranch)
+	logFiles, err := logManager.logFilesOnDiskForBranch(logManager.branch)
 	if err != nil {
 		return nil, err
 	}
@@ -191,3 +208,70 @@ func (d *DoltBinlogPrimaryController) GetBinaryLogStatus(_ *sql.Context) ([]binl
 		ExecutedGtids: d.binlogProducer.currentGtidPosition(),
 	}}, nil
 }
+
+// replicaBinlogBranch returns the branch whose binlog events are streamed to the replica connected as |user| with
+// the session of |ctx|. This is the branch |user| is mapped to by @@dolt_binlog_replica_branches if there is one,
+// otherwise it is the value of the session's @@dolt_binlog_branch system variable, or BinlogBranch if that is empty.
+func replicaBinlogBranch(ctx *sql.Context, user string) (string, error) {
+	replicaBranches, err := globalReplicaBranches()
+	if err != nil {
+		return "", err
+	}
+	if branch, ok := replicaBranches[user]; ok {
+		return branch, nil
+	}
+
+	value, err := ctx.GetSessionVariable(ctx, dsess.DoltBinlogBranch)
+	if err != nil {
+		return "", err
+	}
+	branch, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected type for @@%s: %T", dsess.DoltBinlogBranch, value)
+	}
+	if branch == "" {
+		return BinlogBranch, nil
+	}
+	if err = validateBinlogBranch(branch); err != nil {
+		return "", err
+	}
+	return branch, nil
+}
+
+// globalReplicaBranches returns the branches replication users are mapped to by the global value of
+// @@dolt_binlog_replica_branches, keyed by user.
+func globalReplicaBranches() (map[string]string, error) {
+	_, value, ok := sql.SystemVariables.GetGlobal(dsess.DoltBinlogReplicaBranches)
+	if !ok {
+		return nil, nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type for @@%s: %T", dsess.DoltBinlogReplicaBranches, value)
+	}
+	return parseReplicaBranches(s)
+}
+
+// parseReplicaBranches parses |s|, a comma-separated list of user:branch pairs, into a map of branches keyed by user.
+func parseReplicaBranches(s string) (map[string]string, error) {
+	replicaBranches := make(map[string]string)
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		user, branch, ok := strings.Cut(entry, ":")
+		user, branch = strings.TrimSpace(user), strings.TrimSpace(branch)
+		if !ok || user == "" {
+			return nil, fmt.Errorf("invalid replica branch mapping '%s': expected user:branch", entry)
+		}
+		if err := validateBinlogBranch(branch); err != nil {
+			return nil, err
+		}
+		if _, ok := replicaBranches[user]; ok {
+			return nil, fmt.Errorf("replication user %s is mapped to more than one branch", user)
+		}
+		replicaBranches[user] = branch
+	}
+	return replicaBranches, nil
+}
@@ -15,6 +15,7 @@
 package binlogreplication
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -34,13 +35,18 @@ import (
 
 var binlogDirectory = filepath.Join(".dolt", "binlog")
 
-// logManager is responsible for the binary log files on disk, including actually writing events to the log files,
-// rotating the log files, listing the available log files, purging old log files, and keeping track of what GTIDs
-// are available in the log files.
+// logManager is responsible for the binary log files of a single branch on disk, including actually writing events
+// to the log files, rotating the log files, listing the available log files, purging old log files, and keeping
+// track of what GTIDs are available in the log files.
 type logManager struct {
 	binlogFormat    mysql.BinlogFormat
 	binlogEventMeta mysql.BinlogEventMetadata
 
+	// branch is the branch whose binlog events are recorded by this logManager, and sid is the source ID
+	// of the GTIDs of those events.
+	branch string
+	sid    mysql.SID
+
 	mu                    *sync.Mutex
 	currentBinlogFile     *os.File
 	currentBinlogFileName string
@@ -50,21 +56,33 @@ type logManager struct {
 	availableGtids        mysql.GTIDSet
 }
 
-// NewLogManager creates a new logManager instance where binlog files are stored in the .dolt/binlog directory
-// underneath the specified |fs| filesystem. This method also initializes the binlog logging system, including
-// rotating to a new log file and purging expired log files.
+// NewLogManager creates a new logManager instance for BinlogBranch where binlog files are stored in the .dolt/binlog
+// directory underneath the specified |fs| filesystem. This method also initializes the binlog logging system,
+// including rotating to a new log file and purging expired log files.
 func NewLogManager(fs filesys.Filesys) (*logManager, error) {
+	return newLogManager(fs, BinlogBranch)
+}
+
+// newLogManager creates a new logManager instance for |branch| where binlog files are stored in the .dolt/binlog
+// directory underneath the specified |fs| filesystem, and initializes the binlog files of |branch|.
+func newLogManager(fs filesys.Filesys, branch string) (*logManager, error) {
 	binlogFormat := createBinlogFormat()
 	binlogEventMeta, err := createBinlogEventMetadata()
 	if err != nil {
 		return nil, err
 	}
+	sid, err := binlogBranchSid(context.Background(), branch)
+	if err != nil {
+		return nil, err
+	}
 
 	lm := &logManager{
 		mu:              &sync.Mutex{},
 		fs:              fs,
 		binlogFormat:    *binlogFormat,
 		binlogEventMeta: *binlogEventMeta,
+		branch:          branch,
+		sid:             sid,
 	}
 
 	// Initialize binlog file storage directory
@@ -92,7 +110,7 @@ func NewLogManager(fs filesys.Filesys) (*logManager, error) {
 		return nil, err
 	}
 
-	// Initialize @@gtid_purged based on the first GTID we see available in the available binary logs
+	// Initialize this branch's GTIDs in @@gtid_purged based on the first GTID we see available in the available binary logs
 	// NOTE that we assume that all GTIDs are available after the first GTID we find in the logs. This won't
 	// be true if someone goes directly to the file system and deletes binary log files, but that isn't
 	// how we expect people to manage the binary log files.
@@ -108,37 +126,23 @@ func NewLogManager(fs filesys.Filesys) (*logManager, error) {
 	return lm, nil
 }
 
-// initializeAvailableGtids sets the value of availableGtids by seeing what GTIDs have been executed (@@gtid_executed)
-// and subtracting any GTIDs that have been marked as purged (@@gtid_purged).
+// initializeAvailableGtids sets the value of availableGtids by seeing what GTIDs of this branch have been executed
+// (@@gtid_executed) and subtracting any GTIDs that have been marked as purged (@@gtid_purged).
 func (lm *logManager) initializeAvailableGtids() (err error) {
 	// Initialize availableGtids from @@gtid_executed – we start by assuming we have all executed GTIDs available
 	// in the logs, and then adjust availableGtids based on which GTIDs we detect have been purged.
-	_, gtidExecutedValue, ok := sql.SystemVariables.GetGlobal("gtid_executed")
-	if !ok {
-		return fmt.Errorf("unable to find system variable @@gtid_executed")
-	}
-	if _, ok := gtidExecutedValue.(string); !ok {
-		return fmt.Errorf("unexpected type for @@gtid_executed: %T", gtidExecutedValue)
-	}
-	lm.availableGtids, err = mysql.ParseMysql56GTIDSet(gtidExecutedValue.(string))
+	gtidExecuted, err := lookupGtidSet("gtid_executed")
 	if err != nil {
 		return err
 	}
-
-	_, gtidPurgedValue, ok := sql.SystemVariables.GetGlobal("gtid_purged")
-	if !ok {
-		return fmt.Errorf("unable to find system variable @@gtid_purged")
-	}
-	if _, ok := gtidExecutedValue.(string); !ok {
-		return fmt.Errorf("unexpected type for @@gtid_purged: %T", gtidPurgedValue)
-	}
-	purgedGtids, err := mysql.ParseMysql56GTIDSet(gtidPurgedValue.(string))
+	gtidPurged, err := lookupGtidSet("gtid_purged")
 	if err != nil {
 		return err
 	}
 
-	lm.availableGtids = lm.availableGtids.Subtract(purgedGtids)
-	logrus.Debugf("setting availableGtids to %s after removing purgedGtids %s", lm.availableGtids, purgedGtids)
+	purgedGtids := gtidsForSid(gtidPurged, lm.sid)
+	lm.availableGtids = gtidsForSid(gtidExecuted, lm.sid).Subtract(purgedGtids)
+	logrus.Debugf("setting availableGtids for branch %s to %s after removing purgedGtids %s", lm.branch, lm.availableGtids, purgedGtids)
 	return nil
 }
 
@@ -158,7 +162,7 @@ func (lm *logManager) purgeExpiredLogFiles() error {
 
 	purgeThresholdTime := time.Now().Add(-time.Duration(expireLogsSeconds) * time.Second)
 
-	filenames, err := lm.logFilesOnDiskForBranch(BinlogBranch)
+	filenames, err := lm.logFilesOnDiskForBranch(lm.branch)
 	if err != nil {
 		return err
 	}
@@ -187,13 +191,13 @@ func (lm *logManager) purgeExpiredLogFiles() error {
 	return nil
 }
 
-// initializePurgedGtids searches through the available binary logs to find the first GTID available
-// in the binary logs. If a GTID is found in the available logs, then @@gtid_purged is set to the GTID immediately
-// preceding the found GTID, unless the found GTID is sequence number 1. If no GTIDs are found in the available
-// binary logs, then it is assumed that all GTIDs have been purged, so @@gtid_purged is set to the same value
-// held in @@gtid_executed.
+// initializePurgedGtids searches through the available binary logs of this branch to find the first GTID available
+// in the binary logs. If a GTID is found in the available logs, then this branch's GTIDs in @@gtid_purged are set to
+// the GTIDs preceding the found GTID, unless the found GTID is sequence number 1. If no GTIDs are found in the
+// available binary logs, then it is assumed that all of this branch's GTIDs have been purged, so its GTIDs in
+// @@gtid_purged are set to its GTIDs in @@gtid_executed. The GTIDs of other branches in @@gtid_purged are unchanged.
 func (lm *logManager) initializePurgedGtids() error {
-	filenames, err := lm.logFilesOnDiskForBranch(BinlogBranch)
+	filenames, err := lm.logFilesOnDiskForBranch(lm.branch)
 	if err != nil {
 		return err
 	}
@@ -211,22 +215,45 @@ func (lm *logManager) initializePurgedGtids() error {
 		// all GTIDs before the first sequence number found have been purged.
 		sequenceNumber := gtid.SequenceNumber().(int64)
 		if sequenceNumber > 1 {
-			gtidPurged := fmt.Sprintf("%s:%d", gtid.SourceServer(), sequenceNumber-1)
-			logrus.Debugf("setting gtid_purged to: %s", gtidPurged)
-			return sql.SystemVariables.SetGlobal("gtid_purged", gtidPurged)
+			branchPurged, err := mysql.ParseMysql56GTIDSet(fmt.Sprintf("%s:1-%d", gtid.SourceServer(), sequenceNumber-1))
+			if err != nil {
+				return err
+			}
+			return lm.setPurgedGtids(branchPurged)
 		} else {
-			return nil
+			return lm.setPurgedGtids(mysql.Mysql56GTIDSet{})
 		}
 	}
 
 	// If there are no GTID events in any of the files, then all GTIDs have been purged, so
-	// initialize @@gtid_purged with the value of @@gtid_executed.
-	_, gtidExecutedValue, ok := sql.SystemVariables.GetGlobal("gtid_executed")
-	if !ok {
-		return fmt.Errorf("unable to find system variable @@gtid_executed")
+	// initialize this branch's GTIDs in @@gtid_purged with its GTIDs in @@gtid_executed.
+	gtidExecuted, err := lookupGtidSet("gtid_executed")
+	if err != nil {
+		return err
+	}
+	logrus.Debugf("no available GTIDs found in logs for branch %s", lm.branch)
+	return lm.setPurgedGtids(gtidsForSid(gtidExecuted, lm.sid))
+}
+
+// setPurgedGtids replaces the GTIDs of this branch in @@gtid_purged with |branchPurged|.
+func (lm *logManager) setPurgedGtids(branchPurged mysql.GTIDSet) error {
+	gtidPurged, err := lookupGtidSet("gtid_purged")
+	if err != nil {
+		return err
+	}
+
+	newGtidPurged := mysql.Mysql56GTIDSet{}
+	for sid, intervals := range gtidPurged.(mysql.Mysql56GTIDSet) {
+		if sid != lm.sid {
+			newGtidPurged[sid] = intervals
+		}
+	}
+	if intervals := gtidsForSid(branchPurged, lm.sid)[lm.sid]; len(intervals) > 0 {
+		newGtidPurged[lm.sid] = intervals
 	}
-	logrus.Debugf("no available GTIDs found in logs, setting gtid_purged to: %s", gtidExecutedValue)
-	return sql.SystemVariables.SetGlobal("gtid_purged", gtidExecutedValue)
+
+	logrus.Debugf("setting gtid_purged to: %s", newGtidPurged)
+	return sql.SystemVariables.SetGlobal("gtid_purged", newGtidPurged.String())
 }
 
 // findLogFileForPosition searches through the available binlog files on disk for the first log file that
@@ -234,7 +261,7 @@ func (lm *logManager) initializePurgedGtids() error {
 // from each log file and selecting the previous file when the first GTID not in |executedGtids| is found. If
 // the first GTID event in all available logs files is in |executedGtids|, then the current log file is returned.
 func (lm *logManager) findLogFileForPosition(executedGtids mysql.GTIDSet) (string, error) {
-	files, err := lm.logFilesOnDiskForBranch(BinlogBranch)
+	files, err := lm.logFilesOnDiskForBranch(lm.branch)
 	if err != nil {
 		return "", err
 	}
@@ -348,13 +375,13 @@ func (lm *logManager) createNewBinlogFile() error {
 // current log file is "binlog-main.000008" the nextLogFile() method would return "binlog-main.000009".
 // Note that this function returns the file name only, not the full file path.
 func (lm *logManager) nextLogFile() (filename string, err error) {
-	mostRecentLogfile, err := lm.mostRecentLogFileForBranch(BinlogBranch)
+	mostRecentLogfile, err := lm.mostRecentLogFileForBranch(lm.branch)
 	if err != nil {
 		return "", err
 	}
 
 	if mostRecentLogfile == "" {
-		return formatBinlogFilename(BinlogBranch, 1), nil
+		return formatBinlogFilename(lm.branch, 1), nil
 	} else {
 		branch, sequence, err := parseBinlogFilename(mostRecentLogfile)
 		if err != nil {
@@ -395,10 +422,11 @@ func (lm *logManager) logFilesOnDisk() (files []string, err error) {
 }
 
 func (lm *logManager) logFilesOnDiskForBranch(branch string) (files []string, err error) {
-	branch = strings.ToLower(branch)
 	err = lm.fs.Iter(binlogDirectory, false, func(path string, size int64, isDir bool) (stop bool) {
 		base := filepath.Base(path)
-		if strings.HasPrefix(base, "binlog-"+branch) {
+		// Match the branch name exactly, so that the files of a branch named "main" don't include
+		// the files of a branch named "main2"
+		if fileBranch, _, err := parseBinlogFilename(base); err == nil && strings.EqualFold(fileBranch, branch) {
 			files = append(files, base)
 		}
 
@@ -491,20 +519,11 @@ func (lm *logManager) initializeCurrentLogFile(binlogFormat mysql.BinlogFormat,
 	// TODO: Instead of using the @@gtid_executed system variable, logManager could keep track of which GTIDs
 	//       it has seen logged and use that as the source of truth for the Previous GTIDs event. This would
 	//       eliminate a race condition.
-	_, rawValue, ok := sql.SystemVariables.GetGlobal("gtid_executed")
-	if !ok {
-		panic("unable to find @@gtid_executed system variable")
-	}
-	stringValue, ok := rawValue.(string)
-	if !ok {
-		panic(fmt.Sprintf("unexpected type for @@gtid_executed system variable: %T", rawValue))
-	}
-
-	gtidSet, err := mysql.ParseMysql56GTIDSet(stringValue)
+	gtidSet, err := lookupGtidSet("gtid_executed")
 	if err != nil {
 		return err
 	}
-	return lm.writeEventsHelper(mysql.NewPreviousGtidsEvent(binlogFormat, binlogEventMeta, gtidSet.(mysql.Mysql56GTIDSet)))
+	return lm.writeEventsHelper(mysql.NewPreviousGtidsEvent(binlogFormat, binlogEventMeta, gtidsForSid(gtidSet, lm.sid)))
 }
 
 // WriteEvents writes |binlogEvents| to the current binlog file. Access to write to the binary log is synchronized,
@@ -597,6 +616,20 @@ func (lm *logManager) currentBinlogFilepath() string {
 	return lm.resolveLogFile(lm.currentBinlogFileName)
 }
 
+// lookupGtidSet looks up the value of the GTID set system variable named |name|, such as @@gtid_executed, and
+// returns it, along with any errors encountered while looking it up or parsing it.
+func lookupGtidSet(name string) (mysql.GTIDSet, error) {
+	_, value, ok := sql.SystemVariables.GetGlobal(name)
+	if !ok {
+		return nil, fmt.Errorf("unable to find system variable @@%s", name)
+	}
+	stringValue, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type for @@%s: %T", name, value)
+	}
+	return mysql.ParseMysql56GTIDSet(stringValue)
+}
+
 // lookupMaxBinlogSize looks up the value of the @@max_binlog_size system variable and returns it, along with any
 // errors encountered while looking it up.
 func lookupMaxBinlogSize() (int, error) {
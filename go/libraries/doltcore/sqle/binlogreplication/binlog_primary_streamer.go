@@ -174,7 +174,6 @@ type binlogStreamerManager struct {
 	streamers      []*binlogStreamer
 	streamersMutex sync.Mutex
 	quitChan       chan struct{}
-	logManager     *logManager
 }
 
 // NewBinlogStreamerManager creates a new binlogStreamerManager instance.
@@ -211,18 +210,18 @@ func (m *binlogStreamerManager) copyStreamers() []*binlogStreamer {
 	return results
 }
 
-// StartStream starts a new binlogStreamer and streams events over |conn| until the connection
-// is closed, the streamer is sent a quit signal over its quit channel, or the streamer receives
+// StartStream starts a new binlogStreamer and streams the events logged by |logManager| over |conn| until the
+// connection is closed, the streamer is sent a quit signal over its quit channel, or the streamer receives
 // errors while sending events over the connection. Note that this method blocks until the
 // streamer exits. Note that this function does NOT validate that the primary has the correct set
 // of GTIDs available to get the replica in sync with the primary – it is expected for that
 // validation to have been completed before starting a binlog stream.
-func (m *binlogStreamerManager) StartStream(ctx *sql.Context, conn *mysql.Conn, executedGtids mysql.GTIDSet, binlogFormat *mysql.BinlogFormat, binlogEventMeta mysql.BinlogEventMetadata) error {
+func (m *binlogStreamerManager) StartStream(ctx *sql.Context, conn *mysql.Conn, logManager *logManager, executedGtids mysql.GTIDSet, binlogFormat *mysql.BinlogFormat, binlogEventMeta mysql.BinlogEventMetadata) error {
 	streamer := newBinlogStreamer()
 	m.addStreamer(streamer)
 	defer m.removeStreamer(streamer)
 
-	file, err := m.logManager.findLogFileForPosition(executedGtids)
+	file, err := logManager.findLogFileForPosition(executedGtids)
 	if err != nil {
 		return err
 	}
@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dolthub/vitess/go/mysql"
 	"github.com/stretchr/testify/require"
 )
 
@@ -572,6 +573,70 @@ func TestBinlogPrimary_ChangeReplicationBranch(t *testing.T) {
 	requireReplicaResults(t, "select * from db01.t;", [][]any{{"hundred", "100", "2000"}})
 }
 
+// TestBinlogPrimary_ReplicaSelectsBranch asserts that a replica can follow a branch other than @@log_bin_branch
+// when its replication user is mapped to that branch by @@dolt_binlog_replica_branches, and that the binlog events
+// of that branch use their own GTIDs.
+func TestBinlogPrimary_ReplicaSelectsBranch(t *testing.T) {
+	defer teardown(t)
+	startSqlServersWithDoltSystemVars(t, doltReplicationPrimarySystemVars)
+	setupForDoltToMySqlReplication()
+
+	// Replicas can only follow branches that exist, so create branch1 before the replica connects
+	primaryDatabase.MustExec("create database db01;")
+	primaryDatabase.MustExec("use db01;")
+	primaryDatabase.MustExec("call dolt_branch('branch1');")
+	primaryDatabase.MustExec("SET @@GLOBAL.dolt_binlog_replica_branches='replicator:branch1';")
+
+	startReplication(t, doltPort)
+	time.Sleep(100 * time.Millisecond)
+	primaryDatabase.MustExec("create database db02;")
+	primaryDatabase.MustExec("use db02;")
+	primaryDatabase.MustExec("call dolt_branch('branch1');")
+	waitForReplicaToCatchUpOnBranch(t)
+	requireReplicaResults(t, "show databases like 'db0%';", [][]any{{"db02"}})
+
+	// No events should be sent for main, even though it is @@log_bin_branch
+	primaryDatabase.MustExec("create table db02.t (pk varchar(100) primary key, c1 int, c2 year);")
+	primaryDatabase.MustExec("call dolt_commit('-Am', 'creating table t');")
+	waitForReplicaToCatchUpOnBranch(t)
+	requireReplicaResults(t, "show tables from db02;", [][]any{})
+
+	// Changes to branch1 are replicated
+	primaryDatabase.MustExec("call dolt_checkout('branch1');")
+	primaryDatabase.MustExec("create table t (pk varchar(100) primary key, c1 int, c2 year);")
+	primaryDatabase.MustExec("insert into t values('hundred', 100, 2000);")
+	waitForReplicaToCatchUpOnBranch(t)
+	requireReplicaResults(t, "show tables from db02;", [][]any{{"t"}})
+	requireReplicaResults(t, "select * from db02.t;", [][]any{{"hundred", "100", "2000"}})
+
+	// Insert another row on main and make sure it doesn't get replicated
+	primaryDatabase.MustExec("call dolt_checkout('main');")
+	primaryDatabase.MustExec("insert into t values('two hundred', 200, 2000);")
+	waitForReplicaToCatchUpOnBranch(t)
+	requireReplicaResults(t, "select * from db02.t;", [][]any{{"hundred", "100", "2000"}})
+
+	// The replica has only executed the GTIDs of branch1, which don't use the primary's @@server_uuid
+	replicaGtid := queryGtid(t, replicaDatabase)
+	require.NotEmpty(t, replicaGtid)
+	require.NotContains(t, replicaGtid, queryPrimaryServerUuid(t))
+	require.Contains(t, queryGtid(t, primaryDatabase), replicaGtid)
+}
+
+// TestBinlogPrimary_ReplicaBranchNotFound asserts that a replica mapped to a branch that doesn't exist is sent an
+// error, instead of the branch being recorded in the binlog.
+func TestBinlogPrimary_ReplicaBranchNotFound(t *testing.T) {
+	defer teardown(t)
+	startSqlServersWithDoltSystemVars(t, doltReplicationPrimarySystemVars)
+	setupForDoltToMySqlReplication()
+
+	primaryDatabase.MustExec("SET @@GLOBAL.dolt_binlog_replica_branches='replicator:nosuchbranch';")
+	startReplication(t, doltPort)
+	time.Sleep(500 * time.Millisecond)
+	status := queryReplicaStatus(t)
+	require.Equal(t, "13114", status["Last_IO_Errno"])
+	require.Contains(t, status["Last_IO_Error"], "branch not found: nosuchbranch")
+}
+
 // TestBinlogPrimary_SimpleSchemaChangesWithAutocommit tests that we can make simple schema changes (e.g. create table,
 // alter table, drop table) and replicate the DDL statements correctly.
 func TestBinlogPrimary_SimpleSchemaChangesWithAutocommit(t *testing.T) {
@@ -1055,6 +1120,35 @@ func outputShowReplicaStatus(t *testing.T) {
 	fmt.Printf("\n\nSHOW REPLICA STATUS: %v\n", allNewRows)
 }
 
+// waitForReplicaToCatchUpOnBranch waits (up to 30s) for the replica to execute every GTID the primary has executed
+// on the branch the replica follows, when that isn't @@log_bin_branch. The primary's GTIDs for @@log_bin_branch use
+// its @@server_uuid, and are never sent to the replica, so they are ignored.
+func waitForReplicaToCatchUpOnBranch(t *testing.T) {
+	primarySid, err := mysql.ParseSID(queryPrimaryServerUuid(t))
+	require.NoError(t, err)
+
+	timeLimit := 30 * time.Second
+	endTime := time.Now().Add(timeLimit)
+	for time.Now().Before(endTime) {
+		replicaGtid := queryGtid(t, replicaDatabase)
+		primaryGtidSet, err := mysql.ParseMysql56GTIDSet(queryGtid(t, primaryDatabase))
+		require.NoError(t, err)
+		delete(primaryGtidSet.(mysql.Mysql56GTIDSet), primarySid)
+		primaryGtid := primaryGtidSet.String()
+
+		if primaryGtid == replicaGtid {
+			return
+		} else {
+			fmt.Printf("primary and replica not in sync yet... (primary: %s, replica: %s)\n", primaryGtid, replicaGtid)
+			time.Sleep(250 * time.Millisecond)
+		}
+	}
+
+	// Log some status of the replica, before failing the test
+	outputShowReplicaStatus(t)
+	t.Fatal("primary and replica did not synchronize within " + timeLimit.String())
+}
+
 // copyMap returns a copy of the specified map |m|.
 func copyMap(m map[string]string) map[string]string {
 	mapCopy := make(map[string]string)
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -25,12 +26,14 @@ import (
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/vitess/go/mysql"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlfmt"
 	"github.com/dolthub/dolt/go/libraries/utils/filesys"
 	"github.com/dolthub/dolt/go/store/prolly"
@@ -38,7 +41,9 @@ import (
 	"github.com/dolthub/dolt/go/store/val"
 )
 
-// BinlogBranch specifies the branch used for generating binlog events.
+// BinlogBranch specifies the default branch replicas stream binlog events for. The GTIDs of its binlog events use
+// @@server_uuid as their source ID, while the GTIDs of every other branch use an ID derived from @@server_uuid and
+// the branch name (see binlogBranchSid), so that each branch has its own GTID sequence.
 var BinlogBranch = "main"
 
 // binlogProducer implements the doltdb.DatabaseUpdateListener interface so that it can listen for updates to Dolt
@@ -53,10 +58,17 @@ type binlogProducer struct {
 
 	mu *sync.Mutex
 
-	gtidPosition *mysql.Position
-	gtidSequence int64
+	gtidPosition  *mysql.Position
+	gtidSequences map[mysql.SID]int64
 
 	logManager *logManager
+
+	// logManagers holds the logManager for each branch whose updates are recorded in the binlog, including
+	// |logManager|, which is the logManager for BinlogBranch. Other branches are only recorded once a replica has
+	// requested their binlog events, they are the global value of @@dolt_binlog_branch, or they have binlog files
+	// from before the server started (see InitializeBranchLogManagers).
+	logManagers   map[string]*logManager
+	logManagersMu *sync.Mutex
 }
 
 var _ doltdb.DatabaseUpdateListener = (*binlogProducer)(nil)
@@ -75,6 +87,9 @@ func NewBinlogProducer(fs filesys.Filesys) (*binlogProducer, error) {
 		binlogEventMeta: *binlogEventMeta,
 		binlogFormat:    binlogFormat,
 		mu:              &sync.Mutex{},
+		gtidSequences:   make(map[mysql.SID]int64),
+		logManagers:     make(map[string]*logManager),
+		logManagersMu:   &sync.Mutex{},
 	}
 
 	if err = b.initializeGtidPosition(fs); err != nil {
@@ -84,20 +99,125 @@ func NewBinlogProducer(fs filesys.Filesys) (*binlogProducer, error) {
 	return b, nil
 }
 
-// LogManager sets the |logManager| this producer will send events to.
+// LogManager sets the |logManager| this producer will send events for BinlogBranch to.
 func (b *binlogProducer) LogManager(logManager *logManager) {
+	b.logManagersMu.Lock()
+	defer b.logManagersMu.Unlock()
+
 	b.logManager = logManager
+	b.logManagers[strings.ToLower(logManager.branch)] = logManager
+}
+
+// InitializeBranchLogManagers starts recording the binlog events of every branch, other than BinlogBranch, that has
+// binlog files on disk, is the global value of @@dolt_binlog_branch, or is mapped to a replication user by
+// @@dolt_binlog_replica_branches, so that the updates made to those branches while no replica is connected are
+// available to their replicas. Branches that don't exist in any database are skipped. This must be called after
+// LogManager.
+func (b *binlogProducer) InitializeBranchLogManagers(ctx *sql.Context) error {
+	files, err := b.logManager.logFilesOnDisk()
+	if err != nil {
+		return err
+	}
+
+	var branches []string
+	for _, file := range files {
+		if branch, _, err := parseBinlogFilename(file); err == nil {
+			branches = append(branches, branch)
+		}
+	}
+	if _, value, ok := sql.SystemVariables.GetGlobal(dsess.DoltBinlogBranch); ok {
+		if branch, ok := value.(string); ok && branch != "" {
+			branches = append(branches, branch)
+		}
+	}
+	replicaBranches, err := globalReplicaBranches()
+	if err != nil {
+		logrus.Warnf("ignoring @@%s: %s", dsess.DoltBinlogReplicaBranches, err.Error())
+	}
+	for _, branch := range replicaBranches {
+		branches = append(branches, branch)
+	}
+
+	for _, branch := range branches {
+		if b.recordedLogManager(branch) != nil {
+			continue
+		}
+		if _, err := b.logManagerForBranch(ctx, branch); err != nil {
+			logrus.Warnf("not recording binlog events for branch %s: %s", branch, err.Error())
+		}
+	}
+	return nil
+}
+
+// recordedLogManager returns the logManager that records the binlog events for |branch|, or nil if the updates to
+// |branch| are not being recorded.
+func (b *binlogProducer) recordedLogManager(branch string) *logManager {
+	b.logManagersMu.Lock()
+	defer b.logManagersMu.Unlock()
+
+	return b.logManagers[strings.ToLower(branch)]
+}
+
+// logManagerForBranch returns the logManager that records the binlog events for |branch|, creating it, and starting
+// to record the updates to |branch|, if they weren't already being recorded. An error is returned if |branch| isn't
+// already recorded and doesn't exist in any database.
+func (b *binlogProducer) logManagerForBranch(ctx *sql.Context, branch string) (*logManager, error) {
+	if err := validateBinlogBranch(branch); err != nil {
+		return nil, err
+	}
+	if lm := b.recordedLogManager(branch); lm != nil {
+		return lm, nil
+	}
+
+	exists, err := branchExists(ctx, branch)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("branch not found: %s", branch)
+	}
+
+	b.logManagersMu.Lock()
+	defer b.logManagersMu.Unlock()
+
+	key := strings.ToLower(branch)
+	if lm, ok := b.logManagers[key]; ok {
+		return lm, nil
+	}
+
+	lm, err := newLogManager(b.logManager.fs, branch)
+	if err != nil {
+		return nil, err
+	}
+	b.logManagers[key] = lm
+	return lm, nil
+}
+
+// copyLogManagers returns the logManagers of every branch whose updates are recorded, ordered by branch name.
+func (b *binlogProducer) copyLogManagers() []*logManager {
+	b.logManagersMu.Lock()
+	defer b.logManagersMu.Unlock()
+
+	branches := make([]string, 0, len(b.logManagers))
+	for branch := range b.logManagers {
+		branches = append(branches, branch)
+	}
+	sort.Strings(branches)
+
+	logManagers := make([]*logManager, len(branches))
+	for i, branch := range branches {
+		logManagers[i] = b.logManagers[branch]
+	}
+	return logManagers
 }
 
 // WorkingRootUpdated implements the doltdb.DatabaseUpdateListener interface. When a working root changes,
-// this function generates events for the binary log and sends them to all connected replicas.
-//
-// This function currently sends the events to all connected replicas as the events are produced. Eventually we
-// need to change this so that it writes to a binary log file as the intermediate, and the readers watch that
-// log to stream events back to the connected replicas.
+// this function generates events for the binary log of the updated branch, which are streamed from the log files
+// to the replicas following that branch.
 func (b *binlogProducer) WorkingRootUpdated(ctx *sql.Context, databaseName string, branchName string, before doltdb.RootValue, after doltdb.RootValue) error {
-	// We only support updates to a single branch for binlog events, so ignore all other updates
-	if branchName != BinlogBranch {
+	// Ignore updates to branches that no replica follows
+	logManager := b.recordedLogManager(branchName)
+	if logManager == nil {
 		return nil
 	}
 
@@ -108,7 +228,7 @@ func (b *binlogProducer) WorkingRootUpdated(ctx *sql.Context, databaseName strin
 	}
 
 	// Process schema changes first
-	binlogEvents, hasDataChanges, err := b.createSchemaChangeQueryEvents(ctx, databaseName, tableDeltas, after)
+	binlogEvents, hasDataChanges, err := b.createSchemaChangeQueryEvents(ctx, logManager.sid, databaseName, tableDeltas, after)
 	if err != nil {
 		return err
 	}
@@ -116,7 +236,7 @@ func (b *binlogProducer) WorkingRootUpdated(ctx *sql.Context, databaseName strin
 	// Process data changes...
 	if hasDataChanges {
 		// GTID
-		binlogEvent, err := b.createGtidEvent(ctx)
+		binlogEvent, err := b.createGtidEvent(ctx, logManager.sid)
 		if err != nil {
 			return err
 		}
@@ -143,7 +263,7 @@ func (b *binlogProducer) WorkingRootUpdated(ctx *sql.Context, databaseName strin
 		binlogEvents = append(binlogEvents, b.newXIDEvent())
 	}
 
-	return b.logManager.WriteEvents(binlogEvents...)
+	return logManager.WriteEvents(binlogEvents...)
 }
 
 // DatabaseCreated implements the doltdb.DatabaseUpdateListener interface.
@@ -151,33 +271,30 @@ func (b *binlogProducer) DatabaseCreated(ctx *sql.Context, databaseName string)
 	// TODO: All of these need to be sequentially processed by a single goroutine, so that we can ensure the GTID
 	//       assignment happens sequentially and safely. Also... if a database is created, we need to process that
 	//       update before any data updates to the database itself. Seems like that race could happen otherwise?
-
-	var binlogEvents []mysql.BinlogEvent
-	binlogEvent, err := b.createGtidEvent(ctx)
-	if err != nil {
-		return err
-	}
-	binlogEvents = append(binlogEvents, binlogEvent)
-
 	createDatabaseStatement := fmt.Sprintf("create database `%s`;", databaseName)
-	binlogEvents = append(binlogEvents, b.newQueryEvent(databaseName, createDatabaseStatement))
-
-	return b.logManager.WriteEvents(binlogEvents...)
+	return b.writeQueryEventToAllBranches(ctx, databaseName, createDatabaseStatement)
 }
 
 // DatabaseDropped implements the doltdb.DatabaseUpdateListener interface.
 func (b *binlogProducer) DatabaseDropped(ctx *sql.Context, databaseName string) error {
-	var binlogEvents []mysql.BinlogEvent
-	binlogEvent, err := b.createGtidEvent(ctx)
-	if err != nil {
-		return err
-	}
-	binlogEvents = append(binlogEvents, binlogEvent)
-
 	dropDatabaseStatement := fmt.Sprintf("drop database `%s`;", databaseName)
-	binlogEvents = append(binlogEvents, b.newQueryEvent(databaseName, dropDatabaseStatement))
+	return b.writeQueryEventToAllBranches(ctx, databaseName, dropDatabaseStatement)
+}
 
-	return b.logManager.WriteEvents(binlogEvents...)
+// writeQueryEventToAllBranches writes a transaction executing |query| in the database named |databaseName| to the
+// binlog of every branch, since databases are created and dropped for the replicas of every branch.
+func (b *binlogProducer) writeQueryEventToAllBranches(ctx *sql.Context, databaseName, query string) error {
+	for _, logManager := range b.copyLogManagers() {
+		binlogEvent, err := b.createGtidEvent(ctx, logManager.sid)
+		if err != nil {
+			return err
+		}
+		err = logManager.WriteEvents(binlogEvent, b.newQueryEvent(databaseName, query))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // initializeGtidPosition loads the persisted GTID position from disk and initializes it
@@ -202,66 +319,43 @@ func (b *binlogProducer) initializeGtidPosition(fs filesys.Filesys) error {
 		b.gtidPosition = &mysql.Position{
 			GTIDSet: mysql.Mysql56GTIDSet{},
 		}
-		b.gtidSequence = int64(1)
 		return nil
 	}
 
-	// Otherwise, interpret the value loaded from disk and set the GTID position
-	// Unfortunately, the GTIDSet API from Vitess doesn't provide a good way to directly
-	// access the GTID value, so we have to resort to string parsing.
-	b.gtidPosition = position
-	gtidString := position.GTIDSet.String()
-	if strings.Contains(gtidString, ",") {
-		return fmt.Errorf("unexpected GTID format: %s", gtidString)
-	}
-	gtidComponents := strings.Split(gtidString, ":")
-	if len(gtidComponents) != 2 {
-		return fmt.Errorf("unexpected GTID format: %s", gtidString)
-	}
-	sequenceComponents := strings.Split(gtidComponents[1], "-")
-	var gtidSequenceString string
-	switch len(sequenceComponents) {
-	case 1:
-		gtidSequenceString = sequenceComponents[0]
-	case 2:
-		gtidSequenceString = sequenceComponents[1]
-	default:
-		return fmt.Errorf("unexpected GTID format: %s", gtidString)
-	}
-
-	i, err := strconv.Atoi(gtidSequenceString)
-	if err != nil {
-		return fmt.Errorf("unable to parse GTID position (%s): %s", gtidString, err.Error())
+	// Otherwise, use the GTIDs loaded from disk, which may include the GTIDs of many branches. The next
+	// sequence number for each branch is determined from these GTIDs when the branch is next updated.
+	if _, ok := position.GTIDSet.(mysql.Mysql56GTIDSet); !ok {
+		return fmt.Errorf("unexpected GTID format: %s", position.GTIDSet.String())
 	}
-	b.gtidSequence = int64(i + 1)
+	b.gtidPosition = position
 
 	logrus.Tracef("setting @@gtid_executed to %s", b.gtidPosition.GTIDSet.String())
 	return sql.SystemVariables.AssignValues(map[string]any{
 		"gtid_executed": b.gtidPosition.GTIDSet.String()})
 }
 
-// createGtidEvent creates a new GTID event for the current transaction and updates the stream's
-// current log position.
-func (b *binlogProducer) createGtidEvent(ctx *sql.Context) (mysql.BinlogEvent, error) {
-	serverUuid, err := getServerUuid(ctx)
-	if err != nil {
-		return nil, err
-	}
-	sid, err := mysql.ParseSID(serverUuid)
-	if err != nil {
-		return nil, err
-	}
-
+// createGtidEvent creates a new GTID event for the current transaction, with the next GTID in the sequence of the
+// source ID |sid|, and updates the stream's current log position.
+func (b *binlogProducer) createGtidEvent(ctx *sql.Context, sid mysql.SID) (mysql.BinlogEvent, error) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
-	gtid := mysql.Mysql56GTID{Server: sid, Sequence: b.gtidSequence}
+	sequence, ok := b.gtidSequences[sid]
+	if !ok {
+		lastSequence, err := lastGtidSequence(b.gtidPosition.GTIDSet, sid)
+		if err != nil {
+			return nil, err
+		}
+		sequence = lastSequence + 1
+	}
+
+	gtid := mysql.Mysql56GTID{Server: sid, Sequence: sequence}
 	binlogEvent := mysql.NewMySQLGTIDEvent(*b.binlogFormat, b.binlogEventMeta, gtid, false)
-	b.gtidSequence++
+	b.gtidSequences[sid] = sequence + 1
 
 	// Store the latest executed GTID to disk
 	b.gtidPosition.GTIDSet = b.gtidPosition.GTIDSet.AddGTID(gtid)
-	err = positionStore.Save(ctx, b.gtidPosition)
+	err := positionStore.Save(ctx, b.gtidPosition)
 	if err != nil {
 		return nil, fmt.Errorf("unable to store GTID executed metadata to disk: %s", err.Error())
 	}
@@ -279,7 +373,7 @@ func (b *binlogProducer) createGtidEvent(ctx *sql.Context) (mysql.BinlogEvent, e
 // a slice of binlog events that replicate any schema changes in the TableDeltas, as well as a boolean indicating if
 // any TableDeltas were seen that contain data changes that need to be replicated.
 func (b *binlogProducer) createSchemaChangeQueryEvents(
-	ctx *sql.Context, databaseName string, tableDeltas []diff.TableDelta, newRoot doltdb.RootValue) (
+	ctx *sql.Context, sid mysql.SID, databaseName string, tableDeltas []diff.TableDelta, newRoot doltdb.RootValue) (
 	events []mysql.BinlogEvent, hasDataChanges bool, err error) {
 	for _, tableDelta := range tableDeltas {
 		isRename := tableDelta.IsRename()
@@ -308,7 +402,7 @@ func (b *binlogProducer) createSchemaChangeQueryEvents(
 		for _, schemaPatchStatement := range schemaPatchStatements {
 			// Schema changes in MySQL are always in an implicit transaction, so before each one, we
 			// send a new GTID event for the next transaction start
-			binlogEvent, err := b.createGtidEvent(ctx)
+			binlogEvent, err := b.createGtidEvent(ctx, sid)
 			if err != nil {
 				return nil, false, err
 			}
@@ -505,6 +599,14 @@ func (b *binlogProducer) createRowEvents(ctx *sql.Context, tableDeltas []diff.Ta
 	return events, nil
 }
 
+// executedGtids returns the GTIDs that have been executed with the source ID |sid|.
+func (b *binlogProducer) executedGtids(sid mysql.SID) mysql.GTIDSet {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return gtidsForSid(b.gtidPosition.GTIDSet, sid)
+}
+
 // currentGtidPosition returns the current GTID position of the binlog events.
 func (b *binlogProducer) currentGtidPosition() string {
 	b.mu.Lock()
@@ -696,3 +798,85 @@ func createBinlogEventMetadata() (*mysql.BinlogEventMetadata, error) {
 		Timestamp: uint32(time.Now().Unix()),
 	}, nil
 }
+
+// validateBinlogBranch returns an error if |branch| can't be recorded in the binlog. Branch names are part of the
+// names of binlog files, so branch names containing a '/' are not supported.
+func validateBinlogBranch(branch string) error {
+	if branch == "" {
+		return fmt.Errorf("binlog branch name must not be empty")
+	}
+	if strings.Contains(branch, "/") {
+		return fmt.Errorf("branch names containing '/' are not supported for binlog replication: %s", branch)
+	}
+	return nil
+}
+
+// branchExists returns whether any database of the session of |ctx| has a branch named |branch|.
+func branchExists(ctx *sql.Context, branch string) (bool, error) {
+	sess := dsess.DSessFromSess(ctx.Session)
+	for _, db := range sess.Provider().DoltDatabases() {
+		_, ok, err := db.DbData().Ddb.HasBranch(ctx, branch)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// binlogBranchSid returns the source ID used in the GTIDs of the binlog events for |branch|. BinlogBranch uses
+// @@server_uuid, so that GTIDs recorded before other branches could be replicated remain valid, and every other
+// branch uses a name-based UUID derived from @@server_uuid and the branch name, so that its GTIDs are a separate
+// domain that replicas of other branches never see.
+func binlogBranchSid(ctx context.Context, branch string) (mysql.SID, error) {
+	serverUuid, err := getServerUuid(ctx)
+	if err != nil {
+		return mysql.SID{}, err
+	}
+	if strings.EqualFold(branch, BinlogBranch) {
+		return mysql.ParseSID(serverUuid)
+	}
+
+	namespace, err := uuid.Parse(serverUuid)
+	if err != nil {
+		return mysql.SID{}, fmt.Errorf("unable to parse @@server_uuid (%s): %s", serverUuid, err.Error())
+	}
+	return mysql.SID(uuid.NewSHA1(namespace, []byte(strings.ToLower(branch)))), nil
+}
+
+// gtidsForSid returns the GTIDs in |gtidSet| with the source ID |sid|.
+func gtidsForSid(gtidSet mysql.GTIDSet, sid mysql.SID) mysql.Mysql56GTIDSet {
+	mysql56GtidSet, ok := gtidSet.(mysql.Mysql56GTIDSet)
+	if !ok || len(mysql56GtidSet[sid]) == 0 {
+		return mysql.Mysql56GTIDSet{}
+	}
+	return mysql.Mysql56GTIDSet{sid: mysql56GtidSet[sid]}
+}
+
+// lastGtidSequence returns the largest sequence number of the GTIDs in |gtidSet| with the source ID |sid|, or 0 if
+// there are none.
+func lastGtidSequence(gtidSet mysql.GTIDSet, sid mysql.SID) (int64, error) {
+	// Unfortunately, the GTIDSet API from Vitess doesn't provide a good way to directly
+	// access the GTID intervals, so we have to resort to string parsing.
+	gtidString := gtidsForSid(gtidSet, sid).String()
+	if gtidString == "" {
+		return 0, nil
+	}
+
+	// The intervals are sorted, so the sequence we want ends the last interval,
+	// e.g. "3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5:11-18"
+	intervals := strings.Split(gtidString, ":")
+	if len(intervals) < 2 {
+		return 0, fmt.Errorf("unexpected GTID format: %s", gtidString)
+	}
+	lastInterval := intervals[len(intervals)-1]
+	sequenceString := lastInterval[strings.LastIndex(lastInterval, "-")+1:]
+
+	sequence, err := strconv.ParseInt(sequenceString, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse GTID position (%s): %s", gtidString, err.Error())
+	}
+	return sequence, nil
+}
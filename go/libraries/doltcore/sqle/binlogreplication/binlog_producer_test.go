@@ -0,0 +1,110 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dolthub/vitess/go/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinlogBranchSid(t *testing.T) {
+	ctx := context.Background()
+	serverUuid, err := getServerUuid(ctx)
+	require.NoError(t, err)
+	serverSid, err := mysql.ParseSID(serverUuid)
+	require.NoError(t, err)
+
+	// The default branch uses @@server_uuid
+	sid, err := binlogBranchSid(ctx, BinlogBranch)
+	require.NoError(t, err)
+	require.Equal(t, serverSid, sid)
+
+	// Other branches each have their own source ID, which doesn't depend on the case of the branch name
+	releaseSid, err := binlogBranchSid(ctx, "release")
+	require.NoError(t, err)
+	require.NotEqual(t, serverSid, releaseSid)
+	sid, err = binlogBranchSid(ctx, "Release")
+	require.NoError(t, err)
+	require.Equal(t, releaseSid, sid)
+	sid, err = binlogBranchSid(ctx, "staging")
+	require.NoError(t, err)
+	require.NotEqual(t, releaseSid, sid)
+}
+
+func TestLastGtidSequence(t *testing.T) {
+	sid1, err := mysql.ParseSID("3e11fa47-71ca-11e1-9e33-c80aa9429562")
+	require.NoError(t, err)
+	sid2, err := mysql.ParseSID("a1b2c3d4-71ca-11e1-9e33-c80aa9429562")
+	require.NoError(t, err)
+
+	gtidSet, err := mysql.ParseMysql56GTIDSet(
+		"3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5:11-18,a1b2c3d4-71ca-11e1-9e33-c80aa9429562:7")
+	require.NoError(t, err)
+
+	sequence, err := lastGtidSequence(gtidSet, sid1)
+	require.NoError(t, err)
+	require.EqualValues(t, 18, sequence)
+	sequence, err = lastGtidSequence(gtidSet, sid2)
+	require.NoError(t, err)
+	require.EqualValues(t, 7, sequence)
+	sequence, err = lastGtidSequence(mysql.Mysql56GTIDSet{}, sid1)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, sequence)
+
+	require.Equal(t, "a1b2c3d4-71ca-11e1-9e33-c80aa9429562:7", gtidsForSid(gtidSet, sid2).String())
+}
+
+func TestParseBinlogFilename(t *testing.T) {
+	branch, sequence, err := parseBinlogFilename("binlog-main.000042")
+	require.NoError(t, err)
+	require.Equal(t, "main", branch)
+	require.Equal(t, 42, sequence)
+
+	branch, sequence, err = parseBinlogFilename(formatBinlogFilename("v1.2", 3))
+	require.NoError(t, err)
+	require.Equal(t, "v1.2", branch)
+	require.Equal(t, 3, sequence)
+
+	_, _, err = parseBinlogFilename("binlog-main")
+	require.Error(t, err)
+	_, _, err = parseBinlogFilename("relay-main.000001")
+	require.Error(t, err)
+
+	require.NoError(t, validateBinlogBranch("release"))
+	require.Error(t, validateBinlogBranch("release/1.0"))
+	require.Error(t, validateBinlogBranch(""))
+}
+
+func TestParseReplicaBranches(t *testing.T) {
+	replicaBranches, err := parseReplicaBranches("")
+	require.NoError(t, err)
+	require.Empty(t, replicaBranches)
+
+	replicaBranches, err = parseReplicaBranches("staging:release, production : main,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"staging": "release", "production": "main"}, replicaBranches)
+
+	_, err = parseReplicaBranches("staging")
+	require.Error(t, err)
+	_, err = parseReplicaBranches(":main")
+	require.Error(t, err)
+	_, err = parseReplicaBranches("staging:release/1.0")
+	require.Error(t, err)
+	_, err = parseReplicaBranches("staging:release,staging:main")
+	require.Error(t, err)
+}
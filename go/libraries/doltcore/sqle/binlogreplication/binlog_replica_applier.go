@@ -36,14 +36,16 @@ import (
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/writer"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqlserver"
 )
 
-// positionStore is a singleton instance for loading/saving binlog position state to disk for durable storage.
-var positionStore = &binlogPositionStore{}
+// positionStore is a singleton instance for loading/saving the default channel's binlog position state to disk for
+// durable storage.
+var positionStore = newBinlogPositionStore(defaultChannel)
 
 const (
 	ERNetReadError      = 1158
@@ -52,9 +54,10 @@ const (
 
 // binlogReplicaApplier represents the process that applies updates from a binlog connection.
 //
-// This type is NOT used concurrently – there is currently only one single applier process running to process binlog
-// events, so the state in this type is NOT protected with a mutex.
+// This type is NOT used concurrently – there is one single applier process running to process the binlog events of
+// each replication channel, so the state in this type is NOT protected with a mutex.
 type binlogReplicaApplier struct {
+	channel                   *replicaChannel
 	format                    *mysql.BinlogFormat
 	tableMapsById             map[uint64]*mysql.TableMap
 	stopReplicationChan       chan struct{}
@@ -65,13 +68,17 @@ type binlogReplicaApplier struct {
 	running                   atomic.Bool
 	engine                    *gms.Engine
 	dbsWithUncommittedChanges map[string]struct{}
+	// branch is the Dolt branch that replicated changes are committed to. If empty, changes are committed to the
+	// default branch of each database.
+	branch string
 }
 
-func newBinlogReplicaApplier(filters *filterConfiguration) *binlogReplicaApplier {
+func newBinlogReplicaApplier(channel *replicaChannel) *binlogReplicaApplier {
 	return &binlogReplicaApplier{
+		channel:             channel,
 		tableMapsById:       make(map[uint64]*mysql.TableMap),
 		stopReplicationChan: make(chan struct{}),
-		filters:             filters,
+		filters:             channel.filters,
 	}
 }
 
@@ -94,7 +101,7 @@ func (a *binlogReplicaApplier) Go(ctx *sql.Context) {
 		a.running.Store(false)
 		if err != nil {
 			ctx.GetLogger().Errorf("unexpected error of type %T: '%v'", err, err.Error())
-			DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, err.Error())
+			a.channel.setSqlError(mysql.ERUnknownError, err.Error())
 		}
 	}()
 }
@@ -109,7 +116,7 @@ func (a *binlogReplicaApplier) IsRunning() bool {
 func (a *binlogReplicaApplier) connectAndStartReplicationEventStream(ctx *sql.Context) (*mysql.Conn, error) {
 	var maxConnectionAttempts uint64
 	var connectRetryDelay uint32
-	DoltBinlogReplicaController.updateStatus(func(status *binlogreplication.ReplicaStatus) {
+	a.channel.updateStatus(func(status *binlogreplication.ReplicaStatus) {
 		status.ReplicaIoRunning = binlogreplication.ReplicaIoConnecting
 		status.ReplicaSqlRunning = binlogreplication.ReplicaSqlRunning
 		maxConnectionAttempts = status.SourceRetryCount
@@ -119,21 +126,25 @@ func (a *binlogReplicaApplier) connectAndStartReplicationEventStream(ctx *sql.Co
 	var conn *mysql.Conn
 	var err error
 	for connectionAttempts := uint64(0); ; connectionAttempts++ {
-		replicaSourceInfo, err := loadReplicationConfiguration(ctx, a.engine.Analyzer.Catalog.MySQLDb)
+		replicaSourceInfo, err := a.channel.loadSourceInfo(ctx, a.engine.Analyzer.Catalog.MySQLDb)
+		if err != nil {
+			a.channel.setIoError(ERFatalReplicaError, err.Error())
+			return nil, err
+		}
 
 		if replicaSourceInfo == nil {
 			err = ErrServerNotConfiguredAsReplica
-			DoltBinlogReplicaController.setIoError(ERFatalReplicaError, err.Error())
+			a.channel.setIoError(ERFatalReplicaError, err.Error())
 			return nil, err
 		} else if replicaSourceInfo.Uuid != "" {
 			a.replicationSourceUuid = replicaSourceInfo.Uuid
 		}
 
 		if replicaSourceInfo.Host == "" {
-			DoltBinlogReplicaController.setIoError(ERFatalReplicaError, ErrEmptyHostname.Error())
+			a.channel.setIoError(ERFatalReplicaError, ErrEmptyHostname.Error())
 			return nil, ErrEmptyHostname
 		} else if replicaSourceInfo.User == "" {
-			DoltBinlogReplicaController.setIoError(ERFatalReplicaError, ErrEmptyUsername.Error())
+			a.channel.setIoError(ERFatalReplicaError, ErrEmptyUsername.Error())
 			return nil, ErrEmptyUsername
 		}
 
@@ -177,7 +188,7 @@ func (a *binlogReplicaApplier) connectAndStartReplicationEventStream(ctx *sql.Co
 		return nil, err
 	}
 
-	DoltBinlogReplicaController.updateStatus(func(status *binlogreplication.ReplicaStatus) {
+	a.channel.updateStatus(func(status *binlogreplication.ReplicaStatus) {
 		status.ReplicaIoRunning = binlogreplication.ReplicaIoRunning
 	})
 
@@ -195,13 +206,13 @@ func (a *binlogReplicaApplier) startReplicationEventStream(ctx *sql.Context, con
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	filesys := doltSession.Provider().FileSystem()
 
-	position, err := positionStore.Load(filesys)
+	position, err := a.channel.positions.Load(filesys)
 	if err != nil {
 		return err
 	}
 
 	if position == nil {
-		// If the positionStore doesn't have a record of executed GTIDs, check to see if the gtid_purged system
+		// If the position store doesn't have a record of executed GTIDs, check to see if the gtid_purged system
 		// variable is set. If it holds a GTIDSet, then we use that as our starting position. As part of loading
 		// a mysqldump onto a replica, gtid_purged will be set to indicate where to start replication.
 		_, value, ok := sql.SystemVariables.GetGlobal("gtid_purged")
@@ -289,7 +300,7 @@ func (a *binlogReplicaApplier) replicaBinlogEventHandler(ctx *sql.Context) error
 			err := a.processBinlogEvent(ctx, engine, event)
 			if err != nil {
 				ctx.GetLogger().Errorf("unexpected error of type %T: '%v'", err, err.Error())
-				DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, err.Error())
+				a.channel.setSqlError(mysql.ERUnknownError, err.Error())
 			}
 
 		case err := <-eventProducer.ErrorChan():
@@ -297,7 +308,7 @@ func (a *binlogReplicaApplier) replicaBinlogEventHandler(ctx *sql.Context) error
 				badConnection := sqlError.Message == io.EOF.Error() ||
 					strings.HasPrefix(sqlError.Message, io.ErrUnexpectedEOF.Error())
 				if badConnection {
-					DoltBinlogReplicaController.updateStatus(func(status *binlogreplication.ReplicaStatus) {
+					a.channel.updateStatus(func(status *binlogreplication.ReplicaStatus) {
 						status.LastIoError = sqlError.Message
 						status.LastIoErrNumber = ERNetReadError
 						currentTime := time.Now()
@@ -311,7 +322,7 @@ func (a *binlogReplicaApplier) replicaBinlogEventHandler(ctx *sql.Context) error
 			} else {
 				// otherwise, log the error if it's something we don't expect and continue
 				ctx.GetLogger().Errorf("unexpected error of type %T: '%v'", err, err.Error())
-				DoltBinlogReplicaController.setIoError(mysql.ERUnknownError, err.Error())
+				a.channel.setIoError(mysql.ERUnknownError, err.Error())
 			}
 
 		case <-a.stopReplicationChan:
@@ -340,7 +351,7 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 		if err != nil {
 			msg := fmt.Sprintf("unable to strip checksum from binlog event: '%v'", err.Error())
 			ctx.GetLogger().Error(msg)
-			DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, msg)
+			a.channel.setSqlError(mysql.ERUnknownError, msg)
 		}
 	}
 
@@ -407,8 +418,11 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 			ctx.SetSessionVariable(ctx, "unique_checks", 1)
 		}
 
+		if err = a.checkoutBranches(ctx, engine); err != nil {
+			return err
+		}
 		ctx.SetCurrentDatabase(query.Database)
-		executeQueryWithEngine(ctx, engine, query.SQL)
+		executeQueryWithEngine(ctx, engine, a.channel, query.SQL)
 		createCommit = !strings.EqualFold(query.SQL, "begin")
 
 	case event.IsRotate():
@@ -462,7 +476,7 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 		// if the source's UUID hasn't been set yet, set it and persist it
 		if a.replicationSourceUuid == "" {
 			uuid := fmt.Sprintf("%v", gtid.SourceServer())
-			err = persistSourceUuid(ctx, uuid, a.engine.Analyzer.Catalog.MySQLDb)
+			err = a.channel.persistSourceUuid(ctx, uuid, a.engine.Analyzer.Catalog.MySQLDb)
 			if err != nil {
 				return err
 			}
@@ -504,7 +518,7 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 			if flags != 0 {
 				msg := fmt.Sprintf("unsupported binlog protocol message: TableMap event with unsupported flags '%x'", flags)
 				ctx.GetLogger().Errorf(msg)
-				DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, msg)
+				a.channel.setSqlError(mysql.ERUnknownError, msg)
 			}
 			a.tableMapsById[tableId] = tableMap
 		}
@@ -541,11 +555,11 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 
 		// Record the last GTID processed after the commit
 		a.currentPosition.GTIDSet = a.currentPosition.GTIDSet.AddGTID(a.currentGtid)
-		err := sql.SystemVariables.AssignValues(map[string]interface{}{"gtid_executed": a.currentPosition.GTIDSet.String()})
+		err := sql.SystemVariables.AssignValues(map[string]interface{}{"gtid_executed": DoltBinlogReplicaController.executedGtidSet()})
 		if err != nil {
 			ctx.GetLogger().Errorf("unable to set @@GLOBAL.gtid_executed: %s", err.Error())
 		}
-		err = positionStore.Save(ctx, a.currentPosition)
+		err = a.channel.positions.Save(ctx, a.currentPosition)
 		if err != nil {
 			return fmt.Errorf("unable to store GTID executed metadata to disk: %s", err.Error())
 		}
@@ -557,8 +571,8 @@ func (a *binlogReplicaApplier) processBinlogEvent(ctx *sql.Context, engine *gms.
 		// as dirty in our session, since we used TableWriter to update them.
 		a.addDatabasesWithUncommittedChanges(databasesToCommit...)
 		for _, database := range a.databasesWithUncommittedChanges() {
-			executeQueryWithEngine(ctx, engine, "use `"+database+"`;")
-			executeQueryWithEngine(ctx, engine,
+			executeQueryWithEngine(ctx, engine, a.channel, "use `"+database+"`;")
+			executeQueryWithEngine(ctx, engine, a.channel,
 				fmt.Sprintf("call dolt_commit('-Am', 'Dolt binlog replica commit: GTID %s');", a.currentGtid))
 		}
 		a.dbsWithUncommittedChanges = nil
@@ -591,6 +605,74 @@ func (a *binlogReplicaApplier) databasesWithUncommittedChanges() []string {
 	return dbNames
 }
 
+// checkoutBranches switches the applier's session to this channel's branch in every user database, so that statements
+// referencing tables in databases other than the current database are applied to the channel's branch, too.
+func (a *binlogReplicaApplier) checkoutBranches(ctx *sql.Context, engine *gms.Engine) error {
+	if a.branch == "" {
+		return nil
+	}
+	for _, dbName := range getAllUserDatabaseNames(ctx, engine) {
+		if err := a.checkoutBranch(ctx, dbName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkoutBranch switches the applier's session to this channel's branch in the database named |dbName|, so that
+// replicated statements and row changes for that database are applied to, and committed on, the channel's branch. If
+// the branch doesn't exist yet, it is created from the head of the database's default branch. If the channel doesn't
+// replicate to a branch, or |dbName| isn't a Dolt database, this is a no-op.
+func (a *binlogReplicaApplier) checkoutBranch(ctx *sql.Context, dbName string) error {
+	if a.branch == "" || dbName == "" {
+		return nil
+	}
+
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	if head, ok, err := doltSession.CurrentHead(ctx, dbName); err != nil {
+		return err
+	} else if ok && strings.EqualFold(head, a.branch) {
+		return nil
+	}
+
+	database, ok := doltSession.Provider().BaseDatabase(ctx, dbName)
+	if !ok {
+		// Statements for other databases, such as information_schema or a database that is created by this statement,
+		// are applied without switching branches
+		return nil
+	}
+
+	ddb := database.DbData().Ddb
+	branchRef := ref.NewBranchRef(a.branch)
+	exists, err := ddb.HasRef(ctx, branchRef)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		defaultHead, err := dsess.DefaultHead(dbName, database)
+		if err != nil {
+			return err
+		}
+		headCommit, err := ddb.ResolveCommitRef(ctx, ref.NewBranchRef(defaultHead))
+		if err != nil {
+			return err
+		}
+		if err = ddb.NewBranchAtCommit(ctx, branchRef, headCommit, nil); err != nil {
+			return err
+		}
+	}
+
+	// Make sure the session has loaded the database before switching its working set
+	if _, _, err = doltSession.LookupDbState(ctx, dbName); err != nil {
+		return err
+	}
+	wsRef, err := ref.WorkingSetRefForHead(branchRef)
+	if err != nil {
+		return err
+	}
+	return doltSession.SwitchWorkingSet(ctx, dbName, wsRef)
+}
+
 // processRowEvent processes a WriteRows, DeleteRows, or UpdateRows binlog event and returns an error if any problems
 // were encountered.
 func (a *binlogReplicaApplier) processRowEvent(ctx *sql.Context, event mysql.BinlogEvent, engine *gms.Engine) error {
@@ -640,7 +722,10 @@ func (a *binlogReplicaApplier) processRowEvent(ctx *sql.Context, event mysql.Bin
 	if flags != 0 {
 		msg := fmt.Sprintf("unsupported binlog protocol message: row event with unsupported flags '%x'", flags)
 		ctx.GetLogger().Errorf(msg)
-		DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, msg)
+		a.channel.setSqlError(mysql.ERUnknownError, msg)
+	}
+	if err = a.checkoutBranch(ctx, tableMap.Database); err != nil {
+		return err
 	}
 	schema, tableName, err := getTableSchema(ctx, engine, tableMap.Name, tableMap.Database)
 	if err != nil {
@@ -656,7 +741,7 @@ func (a *binlogReplicaApplier) processRowEvent(ctx *sql.Context, event mysql.Bin
 		ctx.GetLogger().Tracef(" - Inserted Rows (table: %s)", tableMap.Name)
 	}
 
-	writeSession, tableWriter, err := getTableWriter(ctx, engine, tableName, tableMap.Database, foreignKeyChecksDisabled)
+	writeSession, tableWriter, err := getTableWriter(ctx, engine, tableName, tableMap.Database, a.branch, foreignKeyChecksDisabled)
 	if err != nil {
 		return err
 	}
@@ -751,7 +836,9 @@ func getTableSchema(ctx *sql.Context, engine *gms.Engine, tableName, databaseNam
 }
 
 // getTableWriter returns a WriteSession and a TableWriter for writing to the specified |table| in the specified |database|.
-func getTableWriter(ctx *sql.Context, engine *gms.Engine, tableName, databaseName string, foreignKeyChecksDisabled bool) (dsess.WriteSession, dsess.TableWriter, error) {
+// If |branch| is not empty, the TableWriter writes to the working set of that branch instead of the database's default
+// branch.
+func getTableWriter(ctx *sql.Context, engine *gms.Engine, tableName, databaseName, branch string, foreignKeyChecksDisabled bool) (dsess.WriteSession, dsess.TableWriter, error) {
 	database, err := engine.Analyzer.Catalog.Database(ctx, databaseName)
 	if err != nil {
 		return nil, nil, err
@@ -766,7 +853,17 @@ func getTableWriter(ctx *sql.Context, engine *gms.Engine, tableName, databaseNam
 
 	binFormat := sqlDatabase.DbData().Ddb.Format()
 
-	ws, err := env.WorkingSet(ctx, sqlDatabase.GetDoltDB(), sqlDatabase.DbData().Rsr)
+	var ws *doltdb.WorkingSet
+	if branch == "" {
+		ws, err = env.WorkingSet(ctx, sqlDatabase.GetDoltDB(), sqlDatabase.DbData().Rsr)
+	} else {
+		var wsRef ref.WorkingSetRef
+		wsRef, err = ref.WorkingSetRefForHead(ref.NewBranchRef(branch))
+		if err != nil {
+			return nil, nil, err
+		}
+		ws, err = sqlDatabase.GetDoltDB().ResolveWorkingSet(ctx, wsRef)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -965,7 +1062,8 @@ func loadReplicaServerId() (uint32, error) {
 	return serverId, nil
 }
 
-func executeQueryWithEngine(ctx *sql.Context, engine *gms.Engine, query string) {
+// executeQueryWithEngine executes |query| with |engine| and records any error in the status of |channel|.
+func executeQueryWithEngine(ctx *sql.Context, engine *gms.Engine, channel *replicaChannel, query string) {
 	// Create a sub-context when running queries against the engine, so that we get an accurate query start time.
 	queryCtx := sql.NewContext(ctx, sql.WithSession(ctx.Session))
 
@@ -984,7 +1082,7 @@ func executeQueryWithEngine(ctx *sql.Context, engine *gms.Engine, query string)
 				"query": query,
 			}).Errorf("Error executing query")
 			msg := fmt.Sprintf("Error executing query: %v", err.Error())
-			DoltBinlogReplicaController.setSqlError(mysql.ERUnknownError, msg)
+			channel.setSqlError(mysql.ERUnknownError, msg)
 		}
 		return
 	}
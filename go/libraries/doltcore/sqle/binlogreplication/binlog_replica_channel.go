@@ -0,0 +1,213 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+)
+
+// defaultChannel is the name of the default replication channel, which is used by statements without a FOR CHANNEL
+// clause and is the only channel configured through CHANGE REPLICATION SOURCE and the other replication statements.
+const defaultChannel = ""
+
+// channelNameRegex matches valid names for named replication channels. MySQL limits channel names to 64 characters;
+// names are also used in file names in the .doltcfg directory, so only a safe set of characters is allowed.
+var channelNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_\-]{1,64}$`)
+
+// ErrReplicationChannelNotFound is returned when an operation references a channel that has not been configured.
+var ErrReplicationChannelNotFound = fmt.Errorf("replication channel does not exist")
+
+// validateChannelName returns an error if |name| can't be used as the name of a replication channel.
+func validateChannelName(name string) error {
+	if name == defaultChannel || channelNameRegex.MatchString(name) {
+		return nil
+	}
+	return fmt.Errorf("invalid replication channel name '%s'; channel names may only contain letters, "+
+		"digits, '_' and '-', and must be at most 64 characters long", name)
+}
+
+// replicaChannel holds the state of a single replication channel: the connection to one replication source, its
+// replication filters, the applier applying its binlog events, its executed GTID position and its status.
+//
+// Channels are used concurrently by the sessions managing replication and by the channel's applier, so the status
+// MUST only be accessed while holding statusMutex.
+type replicaChannel struct {
+	name      string
+	status    binlogreplication.ReplicaStatus
+	filters   *filterConfiguration
+	applier   *binlogReplicaApplier
+	positions *binlogPositionStore
+	ctx       *sql.Context
+
+	// statusMutex blocks concurrent access to the ReplicaStatus struct
+	statusMutex *sync.Mutex
+}
+
+// newReplicaChannel creates a new, stopped replicaChannel named |name|.
+func newReplicaChannel(name string) *replicaChannel {
+	channel := &replicaChannel{
+		name:        name,
+		filters:     newFilterConfiguration(),
+		statusMutex: &sync.Mutex{},
+	}
+	if name == defaultChannel {
+		// The binlog producer also loads the default channel's position, so both must share one store
+		channel.positions = positionStore
+	} else {
+		channel.positions = newBinlogPositionStore(name)
+	}
+	channel.status.ConnectRetry = 60
+	channel.status.SourceRetryCount = 86400
+	channel.status.AutoPosition = true
+	channel.status.ReplicaIoRunning = binlogreplication.ReplicaIoNotRunning
+	channel.status.ReplicaSqlRunning = binlogreplication.ReplicaSqlNotRunning
+	channel.applier = newBinlogReplicaApplier(channel)
+	return channel
+}
+
+// updateStatus allows the caller to safely update the channel's status. The channel locks its mutex before the
+// specified function |f| is called, and unlocks it after |f| is finished running. The current status is passed into
+// the callback function |f| and the caller can safely update or copy any fields they need.
+func (c *replicaChannel) updateStatus(f func(status *binlogreplication.ReplicaStatus)) {
+	c.statusMutex.Lock()
+	defer c.statusMutex.Unlock()
+	f(&c.status)
+}
+
+// setIoError updates the current replication status with the specific |errno| and |message| to describe an IO error.
+func (c *replicaChannel) setIoError(errno uint, message string) {
+	c.statusMutex.Lock()
+	defer c.statusMutex.Unlock()
+
+	// truncate the message to avoid errors when reporting replica status
+	if len(message) > 256 {
+		message = message[:256]
+	}
+
+	currentTime := time.Now()
+	c.status.LastIoErrorTimestamp = &currentTime
+	c.status.LastIoErrNumber = errno
+	c.status.LastIoError = message
+}
+
+// setSqlError updates the current replication status with the specific |errno| and |message| to describe an SQL error.
+func (c *replicaChannel) setSqlError(errno uint, message string) {
+	c.statusMutex.Lock()
+	defer c.statusMutex.Unlock()
+
+	// truncate the message to avoid errors when reporting replica status
+	if len(message) > 256 {
+		message = message[:256]
+	}
+
+	currentTime := time.Now()
+	c.status.LastSqlErrorTimestamp = &currentTime
+	c.status.LastSqlErrNumber = errno
+	c.status.LastSqlError = message
+}
+
+// loadSourceInfo loads the replication source configuration for this channel. Source options for the default channel
+// are stored in the "mysql" database, |mysqlDb|, and source options for named channels are stored in the channel
+// configuration file. If the channel has no source configured, nil is returned.
+func (c *replicaChannel) loadSourceInfo(ctx *sql.Context, mysqlDb *mysql_db.MySQLDb) (*mysql_db.ReplicaSourceInfo, error) {
+	if c.name == defaultChannel {
+		return loadReplicationConfiguration(ctx, mysqlDb)
+	}
+
+	config, ok, err := loadChannelConfiguration(ctx, c.name)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return config.Source, nil
+}
+
+// persistSourceInfo saves |replicaSourceInfo| as the replication source configuration for this channel.
+func (c *replicaChannel) persistSourceInfo(ctx *sql.Context, replicaSourceInfo *mysql_db.ReplicaSourceInfo, mysqlDb *mysql_db.MySQLDb) error {
+	if c.name == defaultChannel {
+		return persistReplicationConfiguration(ctx, replicaSourceInfo, mysqlDb)
+	}
+
+	return updateChannelConfiguration(ctx, c.name, func(config *channelConfiguration) bool {
+		config.Source = replicaSourceInfo
+		return true
+	})
+}
+
+// persistSourceUuid saves |sourceUuid| in the replication source configuration for this channel.
+func (c *replicaChannel) persistSourceUuid(ctx *sql.Context, sourceUuid string, mysqlDb *mysql_db.MySQLDb) error {
+	if c.name == defaultChannel {
+		return persistSourceUuid(ctx, sourceUuid, mysqlDb)
+	}
+
+	replicaSourceInfo, err := c.loadSourceInfo(ctx, mysqlDb)
+	if err != nil {
+		return err
+	} else if replicaSourceInfo == nil {
+		return ErrServerNotConfiguredAsReplica
+	}
+	replicaSourceInfo.Uuid = sourceUuid
+	return c.persistSourceInfo(ctx, replicaSourceInfo, mysqlDb)
+}
+
+// deleteSourceInfo deletes all stored configuration for this channel, including the branch it replicates to.
+func (c *replicaChannel) deleteSourceInfo(ctx *sql.Context, mysqlDb *mysql_db.MySQLDb) error {
+	if c.name == defaultChannel {
+		if err := deleteReplicationConfiguration(ctx, mysqlDb); err != nil {
+			return err
+		}
+	}
+
+	return updateChannelConfiguration(ctx, c.name, func(*channelConfiguration) bool {
+		return false
+	})
+}
+
+// loadBranch returns the name of the Dolt branch this channel commits replicated changes to. An empty string means
+// that changes are committed to the default branch of each database.
+func (c *replicaChannel) loadBranch(ctx *sql.Context) (string, error) {
+	config, _, err := loadChannelConfiguration(ctx, c.name)
+	if err != nil {
+		return "", err
+	}
+	return config.Branch, nil
+}
+
+// persistBranch saves |branch| as the Dolt branch this channel commits replicated changes to.
+func (c *replicaChannel) persistBranch(ctx *sql.Context, branch string) error {
+	return updateChannelConfiguration(ctx, c.name, func(config *channelConfiguration) bool {
+		config.Branch = branch
+		return c.name != defaultChannel || branch != ""
+	})
+}
+
+// persistRunningState records the running |state| of this channel, so that it can be restarted automatically the
+// next time the server is started.
+func (c *replicaChannel) persistRunningState(ctx *sql.Context, state replicaRunningState) error {
+	if c.name == defaultChannel {
+		return persistReplicaRunningState(ctx, state)
+	}
+
+	return updateChannelConfiguration(ctx, c.name, func(config *channelConfiguration) bool {
+		config.Running = state == running
+		return true
+	})
+}
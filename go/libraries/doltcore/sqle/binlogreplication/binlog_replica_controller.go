@@ -17,9 +17,9 @@ package binlogreplication
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -27,6 +27,8 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
 	"github.com/dolthub/go-mysql-server/sql/mysql_db"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 )
 
 var DoltBinlogReplicaController = newDoltBinlogReplicaController()
@@ -56,14 +58,23 @@ var ErrReplicationStopped = fmt.Errorf("replication stop requested")
 //
 // This type is used concurrently – multiple sessions on the DB can call this interface concurrently,
 // so all state that the controller tracks MUST be protected with a mutex.
+//
+// The controller manages a set of replication channels, each replicating from a different source (multi-source
+// replication) with its own binlog position, replication filters and status. The BinlogReplicaController interface
+// and the CHANGE REPLICATION SOURCE, START/STOP/RESET REPLICA and SHOW REPLICA STATUS statements don't support a
+// FOR CHANNEL clause yet, so they operate on the default channel (""), and named channels are managed through the
+// dolt_change_replication_source, dolt_change_replication_filter, dolt_start_replica, dolt_stop_replica,
+// dolt_reset_replica and dolt_replica_status stored procedures. Each channel can optionally commit the changes it
+// replicates to its own Dolt branch, configured with the DOLT_BRANCH source option.
 type doltBinlogReplicaController struct {
-	status  binlogreplication.ReplicaStatus
-	filters *filterConfiguration
-	applier *binlogReplicaApplier
-	ctx     *sql.Context
+	channels map[string]*replicaChannel
+	ctx      *sql.Context
 
-	// statusMutex blocks concurrent access to the ReplicaStatus struct
-	statusMutex *sync.Mutex
+	// newExecutionContext creates the execution contexts used by the appliers of named channels
+	newExecutionContext func() (*sql.Context, error)
+
+	// channelsMutex blocks concurrent access to the channels map
+	channelsMutex *sync.Mutex
 
 	// operationMutex blocks concurrent access to the START/STOP/RESET REPLICA operations
 	operationMutex *sync.Mutex
@@ -75,28 +86,27 @@ var _ binlogreplication.BinlogReplicaController = (*doltBinlogReplicaController)
 // newDoltBinlogReplicaController creates a new doltBinlogReplicaController instance.
 func newDoltBinlogReplicaController() *doltBinlogReplicaController {
 	controller := doltBinlogReplicaController{
-		filters:        newFilterConfiguration(),
-		statusMutex:    &sync.Mutex{},
+		channels:       map[string]*replicaChannel{defaultChannel: newReplicaChannel(defaultChannel)},
+		channelsMutex:  &sync.Mutex{},
 		operationMutex: &sync.Mutex{},
 	}
-	controller.status.ConnectRetry = 60
-	controller.status.SourceRetryCount = 86400
-	controller.status.AutoPosition = true
-	controller.status.ReplicaIoRunning = binlogreplication.ReplicaIoNotRunning
-	controller.status.ReplicaSqlRunning = binlogreplication.ReplicaSqlNotRunning
-	controller.applier = newBinlogReplicaApplier(controller.filters)
 	return &controller
 }
 
 // StartReplica implements the BinlogReplicaController interface.
 func (d *doltBinlogReplicaController) StartReplica(ctx *sql.Context) error {
+	return d.startReplica(ctx, d.defaultChannel())
+}
+
+// startReplica starts replication for |channel|.
+func (d *doltBinlogReplicaController) startReplica(ctx *sql.Context, channel *replicaChannel) error {
 	d.operationMutex.Lock()
 	defer d.operationMutex.Unlock()
 
 	// START REPLICA may be called multiple times, but if replication is already running,
 	// it will log a warning and not start up new threads.
-	if d.applier.IsRunning() {
-		ctx.Warn(3083, "Replication thread(s) for channel '' are already running.")
+	if channel.applier.IsRunning() {
+		ctx.Warn(3083, "Replication thread(s) for channel '%s' are already running.", channel.name)
 		return nil
 	}
 
@@ -116,43 +126,74 @@ func (d *doltBinlogReplicaController) StartReplica(ctx *sql.Context) error {
 		return fmt.Errorf("unable to start replication: %s", err.Error())
 	}
 
-	configuration, err := loadReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb)
+	configuration, err := channel.loadSourceInfo(ctx, d.engine.Analyzer.Catalog.MySQLDb)
 	if err != nil {
 		return err
 	} else if configuration == nil {
 		return ErrServerNotConfiguredAsReplica
 	} else if configuration.Host == "" {
-		DoltBinlogReplicaController.setIoError(ERFatalReplicaError, ErrEmptyHostname.Error())
+		channel.setIoError(ERFatalReplicaError, ErrEmptyHostname.Error())
 		return ErrEmptyHostname
 	} else if configuration.User == "" {
-		DoltBinlogReplicaController.setIoError(ERFatalReplicaError, ErrEmptyUsername.Error())
+		channel.setIoError(ERFatalReplicaError, ErrEmptyUsername.Error())
 		return ErrEmptyUsername
 	}
 
-	if d.ctx == nil {
-		return fmt.Errorf("no execution context set for the replica controller")
+	branch, err := channel.loadBranch(ctx)
+	if err != nil {
+		return err
+	}
+
+	executionCtx, err := d.executionContext(channel)
+	if err != nil {
+		return err
 	}
 
 	d.configureReplicationUser(ctx)
 
 	// Set execution context's user to the binlog replication user
-	d.ctx.SetClient(sql.Client{
+	executionCtx.SetClient(sql.Client{
 		User:    binlogApplierUser,
 		Address: "localhost",
 	})
 
-	ctx.GetLogger().Info("starting binlog replication...")
-	d.applier.Go(d.ctx)
+	ctx.GetLogger().Infof("starting binlog replication for channel '%s'...", channel.name)
+	channel.applier.branch = branch
+	channel.applier.Go(executionCtx)
 
 	// Attempt to record that the replica has started replication so that it will
 	// start automatically the next time the replica server is started.
-	if err := persistReplicaRunningState(ctx, running); err != nil {
+	if err := channel.persistRunningState(ctx, running); err != nil {
 		ctx.GetLogger().Errorf("unable to persist replica running state: %s", err.Error())
 	}
 
 	return nil
 }
 
+// executionContext returns the context that the applier for |channel| uses to apply changes. The default channel uses
+// the context set with SetExecutionContext, and named channels each get their own context, so that their appliers
+// don't share a session.
+func (d *doltBinlogReplicaController) executionContext(channel *replicaChannel) (*sql.Context, error) {
+	if channel.name == defaultChannel {
+		if d.ctx == nil {
+			return nil, fmt.Errorf("no execution context set for the replica controller")
+		}
+		return d.ctx, nil
+	}
+
+	if channel.ctx == nil {
+		if d.newExecutionContext == nil {
+			return nil, fmt.Errorf("no execution context factory set for the replica controller")
+		}
+		ctx, err := d.newExecutionContext()
+		if err != nil {
+			return nil, err
+		}
+		channel.ctx = ctx
+	}
+	return channel.ctx, nil
+}
+
 // configureReplicationUser creates or configures the superuser account needed to apply replication
 // changes and execute DDL statements on the running server. If the account doesn't exist, it will be
 // created and locked to disable log ins, and if it does exist, but is missing super privs or is not
@@ -171,30 +212,47 @@ func (d *doltBinlogReplicaController) SetExecutionContext(ctx *sql.Context) {
 	d.ctx = ctx
 }
 
+// SetExecutionContextFactory sets the function used to create a new, unique context for the applier of each named
+// replication channel. Like the context set with SetExecutionContext, these contexts must not be shared with any
+// other session.
+func (d *doltBinlogReplicaController) SetExecutionContextFactory(f func() (*sql.Context, error)) {
+	d.newExecutionContext = f
+}
+
 // SetEngine sets the SQL engine this replica will use when running replicated statements and
 // when loading the Catalog to find the "mysql" database.
 func (d *doltBinlogReplicaController) SetEngine(engine *sqle.Engine) {
 	d.engine = engine
-	d.applier.engine = engine
+
+	d.channelsMutex.Lock()
+	defer d.channelsMutex.Unlock()
+	for _, channel := range d.channels {
+		channel.applier.engine = engine
+	}
 }
 
 // StopReplica implements the BinlogReplicaController interface.
 func (d *doltBinlogReplicaController) StopReplica(ctx *sql.Context) error {
-	if d.applier.IsRunning() == false {
-		ctx.Warn(3084, "Replication thread(s) for channel '' are already stopped.")
+	return d.stopReplica(ctx, d.defaultChannel())
+}
+
+// stopReplica stops replication for |channel|.
+func (d *doltBinlogReplicaController) stopReplica(ctx *sql.Context, channel *replicaChannel) error {
+	if channel.applier.IsRunning() == false {
+		ctx.Warn(3084, "Replication thread(s) for channel '%s' are already stopped.", channel.name)
 		return nil
 	}
 
-	d.applier.stopReplicationChan <- struct{}{}
+	channel.applier.stopReplicationChan <- struct{}{}
 
-	d.updateStatus(func(status *binlogreplication.ReplicaStatus) {
+	channel.updateStatus(func(status *binlogreplication.ReplicaStatus) {
 		status.ReplicaIoRunning = binlogreplication.ReplicaIoNotRunning
 		status.ReplicaSqlRunning = binlogreplication.ReplicaSqlNotRunning
 	})
 
 	// Attempt to record that the replica has stopped replication so that it will not
 	// start automatically the next time the replica server is started.
-	if err := persistReplicaRunningState(ctx, notRunning); err != nil {
+	if err := channel.persistRunningState(ctx, notRunning); err != nil {
 		ctx.GetLogger().Errorf("unable to persist replica running state: %s", err.Error())
 	}
 
@@ -203,7 +261,13 @@ func (d *doltBinlogReplicaController) StopReplica(ctx *sql.Context) error {
 
 // SetReplicationSourceOptions implements the BinlogReplicaController interface.
 func (d *doltBinlogReplicaController) SetReplicationSourceOptions(ctx *sql.Context, options []binlogreplication.ReplicationOption) error {
-	replicaSourceInfo, err := loadReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb)
+	return d.setReplicationSourceOptions(ctx, d.defaultChannel(), options)
+}
+
+// setReplicationSourceOptions applies the source |options| to |channel| and persists them.
+func (d *doltBinlogReplicaController) setReplicationSourceOptions(ctx *sql.Context, channel *replicaChannel, options []binlogreplication.ReplicationOption) error {
+	mysqlDb := d.engine.Analyzer.Catalog.MySQLDb
+	replicaSourceInfo, err := channel.loadSourceInfo(ctx, mysqlDb)
 	if err != nil {
 		return err
 	}
@@ -212,6 +276,12 @@ func (d *doltBinlogReplicaController) SetReplicationSourceOptions(ctx *sql.Conte
 		replicaSourceInfo = mysql_db.NewReplicaSourceInfo()
 	}
 
+	branch, err := channel.loadBranch(ctx)
+	if err != nil {
+		return err
+	}
+	newBranch := branch
+
 	for _, option := range options {
 		switch strings.ToUpper(option.Name) {
 		case "SOURCE_HOST":
@@ -258,17 +328,74 @@ func (d *doltBinlogReplicaController) SetReplicationSourceOptions(ctx *sql.Conte
 			if intValue < 1 {
 				return fmt.Errorf("SOURCE_AUTO_POSITION cannot be disabled")
 			}
+		case "DOLT_BRANCH":
+			value, err := getOptionValueAsString(option)
+			if err != nil {
+				return err
+			}
+			if value != "" && !doltdb.IsValidUserBranchName(value) {
+				return fmt.Errorf("invalid branch name for DOLT_BRANCH: '%s'", value)
+			}
+			newBranch = value
 		default:
 			return fmt.Errorf("unknown replication source option: %s", option.Name)
 		}
 	}
 
+	if newBranch != branch && channel.applier.IsRunning() {
+		return fmt.Errorf("unable to change DOLT_BRANCH for channel '%s' while replication is running; "+
+			"stop replication and try again", channel.name)
+	}
+
+	if err = d.verifySourceIsUnique(ctx, channel, replicaSourceInfo); err != nil {
+		return err
+	}
+
 	// Persist the updated replica source configuration to disk
-	return persistReplicationConfiguration(ctx, replicaSourceInfo, d.engine.Analyzer.Catalog.MySQLDb)
+	if err = channel.persistSourceInfo(ctx, replicaSourceInfo, mysqlDb); err != nil {
+		return err
+	}
+	if newBranch != branch {
+		return channel.persistBranch(ctx, newBranch)
+	}
+	return nil
+}
+
+// verifySourceIsUnique returns an error if another channel than |channel| already replicates from the host and port
+// in |replicaSourceInfo|, since replicating the same source twice would apply its changes twice.
+func (d *doltBinlogReplicaController) verifySourceIsUnique(ctx *sql.Context, channel *replicaChannel, replicaSourceInfo *mysql_db.ReplicaSourceInfo) error {
+	if replicaSourceInfo.Host == "" {
+		return nil
+	}
+
+	channels, err := d.allChannels(ctx)
+	if err != nil {
+		return err
+	}
+	for _, other := range channels {
+		if other == channel {
+			continue
+		}
+		otherSourceInfo, err := other.loadSourceInfo(ctx, d.engine.Analyzer.Catalog.MySQLDb)
+		if err != nil {
+			return err
+		}
+		if otherSourceInfo != nil && strings.EqualFold(otherSourceInfo.Host, replicaSourceInfo.Host) &&
+			otherSourceInfo.Port == replicaSourceInfo.Port {
+			return fmt.Errorf("replication channel '%s' already replicates from source %s:%d",
+				other.name, replicaSourceInfo.Host, replicaSourceInfo.Port)
+		}
+	}
+	return nil
 }
 
 // SetReplicationFilterOptions implements the BinlogReplicaController interface.
-func (d *doltBinlogReplicaController) SetReplicationFilterOptions(_ *sql.Context, options []binlogreplication.ReplicationOption) error {
+func (d *doltBinlogReplicaController) SetReplicationFilterOptions(ctx *sql.Context, options []binlogreplication.ReplicationOption) error {
+	return d.setReplicationFilterOptions(ctx, d.defaultChannel(), options)
+}
+
+// setReplicationFilterOptions applies the replication filter |options| to |channel|.
+func (d *doltBinlogReplicaController) setReplicationFilterOptions(_ *sql.Context, channel *replicaChannel, options []binlogreplication.ReplicationOption) error {
 	for _, option := range options {
 		switch strings.ToUpper(option.Name) {
 		case "REPLICATE_DO_TABLE":
@@ -276,7 +403,7 @@ func (d *doltBinlogReplicaController) SetReplicationFilterOptions(_ *sql.Context
 			if err != nil {
 				return err
 			}
-			err = d.filters.setDoTables(value)
+			err = channel.filters.setDoTables(value)
 			if err != nil {
 				return err
 			}
@@ -285,7 +412,7 @@ func (d *doltBinlogReplicaController) SetReplicationFilterOptions(_ *sql.Context
 			if err != nil {
 				return err
 			}
-			err = d.filters.setIgnoreTables(value)
+			err = channel.filters.setIgnoreTables(value)
 			if err != nil {
 				return err
 			}
@@ -305,15 +432,20 @@ func (d *doltBinlogReplicaController) SetReplicationFilterOptions(_ *sql.Context
 
 // GetReplicaStatus implements the BinlogReplicaController interface
 func (d *doltBinlogReplicaController) GetReplicaStatus(ctx *sql.Context) (*binlogreplication.ReplicaStatus, error) {
-	replicaSourceInfo, err := loadReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb)
+	return d.getReplicaStatus(ctx, d.defaultChannel())
+}
+
+// getReplicaStatus returns the current status of |channel|.
+func (d *doltBinlogReplicaController) getReplicaStatus(ctx *sql.Context, channel *replicaChannel) (*binlogreplication.ReplicaStatus, error) {
+	replicaSourceInfo, err := channel.loadSourceInfo(ctx, d.engine.Analyzer.Catalog.MySQLDb)
 	if err != nil {
 		return nil, err
 	}
 
 	// Lock to read status consistently
-	d.statusMutex.Lock()
-	defer d.statusMutex.Unlock()
-	var copy = d.status
+	channel.statusMutex.Lock()
+	defer channel.statusMutex.Unlock()
+	var copy = channel.status
 
 	if replicaSourceInfo == nil {
 		return &copy, nil
@@ -325,11 +457,11 @@ func (d *doltBinlogReplicaController) GetReplicaStatus(ctx *sql.Context) (*binlo
 	copy.SourceServerUuid = replicaSourceInfo.Uuid
 	copy.ConnectRetry = replicaSourceInfo.ConnectRetryInterval
 	copy.SourceRetryCount = replicaSourceInfo.ConnectRetryCount
-	copy.ReplicateDoTables = d.filters.getDoTables()
-	copy.ReplicateIgnoreTables = d.filters.getIgnoreTables()
+	copy.ReplicateDoTables = channel.filters.getDoTables()
+	copy.ReplicateIgnoreTables = channel.filters.getIgnoreTables()
 
-	if d.applier.currentPosition != nil {
-		copy.ExecutedGtidSet = d.applier.currentPosition.GTIDSet.String()
+	if channel.applier.currentPosition != nil {
+		copy.ExecutedGtidSet = channel.applier.currentPosition.GTIDSet.String()
 		copy.RetrievedGtidSet = copy.ExecutedGtidSet
 	}
 
@@ -338,15 +470,21 @@ func (d *doltBinlogReplicaController) GetReplicaStatus(ctx *sql.Context) (*binlo
 
 // ResetReplica implements the BinlogReplicaController interface
 func (d *doltBinlogReplicaController) ResetReplica(ctx *sql.Context, resetAll bool) error {
+	return d.resetReplica(ctx, d.defaultChannel(), resetAll)
+}
+
+// resetReplica clears the error status of |channel|, and if |resetAll| is true, also removes its source
+// configuration and filters. Named channels are removed entirely when |resetAll| is true.
+func (d *doltBinlogReplicaController) resetReplica(ctx *sql.Context, channel *replicaChannel, resetAll bool) error {
 	d.operationMutex.Lock()
 	defer d.operationMutex.Unlock()
 
-	if d.applier.IsRunning() {
+	if channel.applier.IsRunning() {
 		return fmt.Errorf("unable to reset replica while replication is running; stop replication and try again")
 	}
 
 	// Reset error status
-	d.updateStatus(func(status *binlogreplication.ReplicaStatus) {
+	channel.updateStatus(func(status *binlogreplication.ReplicaStatus) {
 		status.LastIoErrNumber = 0
 		status.LastSqlErrNumber = 0
 		status.LastIoErrorTimestamp = nil
@@ -356,59 +494,114 @@ func (d *doltBinlogReplicaController) ResetReplica(ctx *sql.Context, resetAll bo
 	})
 
 	if resetAll {
-		err := deleteReplicationConfiguration(ctx, d.engine.Analyzer.Catalog.MySQLDb)
+		err := channel.deleteSourceInfo(ctx, d.engine.Analyzer.Catalog.MySQLDb)
 		if err != nil {
 			return err
 		}
 
-		d.filters = newFilterConfiguration()
+		channel.filters = newFilterConfiguration()
+		channel.applier.filters = channel.filters
+
+		if channel.name != defaultChannel {
+			d.channelsMutex.Lock()
+			delete(d.channels, channel.name)
+			d.channelsMutex.Unlock()
+		}
 	}
 
 	return nil
 }
 
-// updateStatus allows the caller to safely update the replica controller's status. The controller locks it's mutex
-// before the specified function |f| is called, and unlocks it after |f| is finished running. The current status is
-// passed into the callback function |f| and the caller can safely update or copy any fields they need.
-func (d *doltBinlogReplicaController) updateStatus(f func(status *binlogreplication.ReplicaStatus)) {
-	d.statusMutex.Lock()
-	defer d.statusMutex.Unlock()
-	f(&d.status)
+// defaultChannel returns the default replication channel.
+func (d *doltBinlogReplicaController) defaultChannel() *replicaChannel {
+	d.channelsMutex.Lock()
+	defer d.channelsMutex.Unlock()
+	return d.channels[defaultChannel]
 }
 
-// setIoError updates the current replication status with the specific |errno| and |message| to describe an IO error.
-func (d *doltBinlogReplicaController) setIoError(errno uint, message string) {
-	d.statusMutex.Lock()
-	defer d.statusMutex.Unlock()
+// getChannel returns the replication channel named |name|. Channels that were configured before the server was
+// restarted are loaded from the stored channel configuration. If the channel doesn't exist and |create| is true, a
+// new channel is created, otherwise ErrReplicationChannelNotFound is returned.
+func (d *doltBinlogReplicaController) getChannel(ctx *sql.Context, name string, create bool) (*replicaChannel, error) {
+	if err := validateChannelName(name); err != nil {
+		return nil, err
+	}
 
-	// truncate the message to avoid errors when reporting replica status
-	if len(message) > 256 {
-		message = message[:256]
+	d.channelsMutex.Lock()
+	channel, ok := d.channels[name]
+	d.channelsMutex.Unlock()
+	if ok {
+		return channel, nil
+	}
+
+	if !create {
+		_, ok, err := loadChannelConfiguration(ctx, name)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, fmt.Errorf("%w: '%s'", ErrReplicationChannelNotFound, name)
+		}
 	}
 
-	currentTime := time.Now()
-	d.status.LastIoErrorTimestamp = &currentTime
-	d.status.LastIoErrNumber = errno
-	d.status.LastIoError = message
+	d.channelsMutex.Lock()
+	defer d.channelsMutex.Unlock()
+	if channel, ok = d.channels[name]; !ok {
+		channel = newReplicaChannel(name)
+		channel.applier.engine = d.engine
+		d.channels[name] = channel
+	}
+	return channel, nil
 }
 
-// setSqlError updates the current replication status with the specific |errno| and |message| to describe an SQL error.
-func (d *doltBinlogReplicaController) setSqlError(errno uint, message string) {
-	d.statusMutex.Lock()
-	defer d.statusMutex.Unlock()
+// allChannels returns all replication channels, including channels that have only been configured before the server
+// was restarted, ordered by name.
+func (d *doltBinlogReplicaController) allChannels(ctx *sql.Context) ([]*replicaChannel, error) {
+	configs, err := loadChannelConfigurations(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	// truncate the message to avoid errors when reporting replica status
-	if len(message) > 256 {
-		message = message[:256]
+	d.channelsMutex.Lock()
+	names := keys(d.channels)
+	d.channelsMutex.Unlock()
+	for name := range configs {
+		names = append(names, name)
 	}
+	sort.Strings(names)
 
-	currentTime := time.Now()
-	d.status.LastSqlErrorTimestamp = &currentTime
-	d.status.LastSqlErrNumber = errno
-	d.status.LastSqlError = message
+	channels := make([]*replicaChannel, 0, len(names))
+	for i, name := range names {
+		if i > 0 && names[i-1] == name {
+			continue
+		}
+		channel, err := d.getChannel(ctx, name, true)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, channel)
+	}
+	return channels, nil
 }
 
-// AutoStart starts up replication if replication was running before the server was shutdown. If
+// executedGtidSet returns the set of GTIDs executed by all replication channels, which is the value of
+// @@gtid_executed on a replica.
+func (d *doltBinlogReplicaController) executedGtidSet() string {
+	d.channelsMutex.Lock()
+	defer d.channelsMutex.Unlock()
+
+	names := keys(d.channels)
+	sort.Strings(names)
+	gtidSets := make([]string, 0, len(names))
+	for _, name := range names {
+		position := d.channels[name].applier.currentPosition
+		if position != nil && position.GTIDSet != nil {
+			gtidSets = append(gtidSets, position.GTIDSet.String())
+		}
+	}
+	return strings.Join(gtidSets, ",")
+}
+
+// AutoStart starts up replication for every channel that was running before the server was shutdown. If
 // replication is not configured, hasn't been started, or has been stopped before the server was
 // shutdown, then this method will not start replication. This method should only be called during
 // the server startup process and should not be invoked after that.
@@ -421,11 +614,32 @@ func (d *doltBinlogReplicaController) AutoStart(_ context.Context) error {
 
 	if runningState == notRunning {
 		logrus.Trace("no previous replication running state; not auto starting replication")
-		return nil
+	} else {
+		logrus.Info("auto-starting binlog replication from source...")
+		if err = d.StartReplica(d.ctx); err != nil {
+			return err
+		}
 	}
 
-	logrus.Info("auto-starting binlog replication from source...")
-	return d.StartReplica(d.ctx)
+	configs, err := loadChannelConfigurations(d.ctx)
+	if err != nil {
+		logrus.Errorf("Unable to load replication channel configuration: %s", err.Error())
+		return err
+	}
+	for name, config := range configs {
+		if name == defaultChannel || !config.Running {
+			continue
+		}
+		logrus.Infof("auto-starting binlog replication for channel '%s' from source...", name)
+		channel, err := d.getChannel(d.ctx, name, false)
+		if err != nil {
+			return err
+		}
+		if err = d.startReplica(d.ctx, channel); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 //
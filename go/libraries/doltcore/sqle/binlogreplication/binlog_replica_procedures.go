@@ -0,0 +1,344 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/dolthub/go-mysql-server/sql/plan"
+	"github.com/dolthub/go-mysql-server/sql/types"
+)
+
+// The CHANGE REPLICATION SOURCE, CHANGE REPLICATION FILTER, START/STOP/RESET REPLICA and SHOW REPLICA STATUS
+// statements don't support a FOR CHANNEL clause yet, so the stored procedures below give access to all replication
+// channels. Each procedure takes the channel name as its first argument, and the empty string names the default
+// channel. Options are passed as 'NAME=value' strings, for example:
+//
+//	CALL dolt_change_replication_source('east', 'SOURCE_HOST=east.example.com', 'SOURCE_PORT=3306',
+//	    'SOURCE_USER=replicator', 'SOURCE_PASSWORD=secret', 'DOLT_BRANCH=east');
+//	CALL dolt_change_replication_filter('east', 'REPLICATE_IGNORE_TABLE=(db1.t1,db1.t2)');
+//	CALL dolt_start_replica('east');
+//	CALL dolt_replica_status('east');
+
+// procedurestore is the interface used to register the replication stored procedures.
+type procedurestore interface {
+	Register(sql.ExternalStoredProcedureDetails)
+}
+
+// ErrReplicationAccessDenied is returned when a user without the privileges needed to manage replication calls one
+// of the replication stored procedures.
+var ErrReplicationAccessDenied = fmt.Errorf("access denied; you need (at least one of) the REPLICATION_SLAVE_ADMIN " +
+	"privilege(s) for this operation")
+
+// replicaStatusSchema is the schema of the dolt_replica_status stored procedure.
+var replicaStatusSchema = sql.Schema{
+	&sql.Column{Name: "Channel_Name", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Source_Host", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Source_User", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Source_Port", Type: types.Uint64, Nullable: false},
+	&sql.Column{Name: "Replica_IO_Running", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Replica_SQL_Running", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Replicate_Do_Table", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Replicate_Ignore_Table", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Last_IO_Errno", Type: types.Uint64, Nullable: false},
+	&sql.Column{Name: "Last_IO_Error", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Last_SQL_Errno", Type: types.Uint64, Nullable: false},
+	&sql.Column{Name: "Last_SQL_Error", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Source_UUID", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Retrieved_Gtid_Set", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Executed_Gtid_Set", Type: types.LongText, Nullable: false},
+	&sql.Column{Name: "Branch", Type: types.LongText, Nullable: false},
+}
+
+// procedureStatusSchema is the schema of the replication stored procedures that don't return any results.
+var procedureStatusSchema = sql.Schema{
+	&sql.Column{Name: "status", Type: types.Int64, Nullable: false},
+}
+
+// RegisterStoredProcedures registers the stored procedures used to manage replication channels with |store|.
+func (d *doltBinlogReplicaController) RegisterStoredProcedures(store procedurestore) {
+	store.Register(sql.ExternalStoredProcedureDetails{
+		Name:     "dolt_change_replication_source",
+		Schema:   procedureStatusSchema,
+		Function: d.changeReplicationSourceProcedure,
+	})
+	store.Register(sql.ExternalStoredProcedureDetails{
+		Name:     "dolt_change_replication_filter",
+		Schema:   procedureStatusSchema,
+		Function: d.changeReplicationFilterProcedure,
+	})
+	store.Register(sql.ExternalStoredProcedureDetails{
+		Name:     "dolt_start_replica",
+		Schema:   procedureStatusSchema,
+		Function: d.startReplicaProcedure,
+	})
+	store.Register(sql.ExternalStoredProcedureDetails{
+		Name:     "dolt_stop_replica",
+		Schema:   procedureStatusSchema,
+		Function: d.stopReplicaProcedure,
+	})
+	store.Register(sql.ExternalStoredProcedureDetails{
+		Name:     "dolt_reset_replica",
+		Schema:   procedureStatusSchema,
+		Function: d.resetReplicaProcedure,
+	})
+	store.Register(sql.ExternalStoredProcedureDetails{
+		Name:     "dolt_replica_status",
+		Schema:   replicaStatusSchema,
+		Function: d.replicaStatusProcedure,
+		ReadOnly: true,
+	})
+}
+
+// changeReplicationSourceProcedure implements the dolt_change_replication_source stored procedure, which creates the
+// replication channel named |channelName| if it doesn't exist yet.
+func (d *doltBinlogReplicaController) changeReplicationSourceProcedure(ctx *sql.Context, channelName string, args ...string) (sql.RowIter, error) {
+	if err := d.checkReplicationPrivileges(ctx, false); err != nil {
+		return nil, err
+	}
+	options, err := parseReplicationOptions(args)
+	if err != nil {
+		return nil, err
+	}
+	channel, err := d.getChannel(ctx, channelName, true)
+	if err != nil {
+		return nil, err
+	}
+	if err = d.setReplicationSourceOptions(ctx, channel, options); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
+
+// changeReplicationFilterProcedure implements the dolt_change_replication_filter stored procedure.
+func (d *doltBinlogReplicaController) changeReplicationFilterProcedure(ctx *sql.Context, channelName string, args ...string) (sql.RowIter, error) {
+	if err := d.checkReplicationPrivileges(ctx, false); err != nil {
+		return nil, err
+	}
+	options, err := parseReplicationOptions(args)
+	if err != nil {
+		return nil, err
+	}
+	channel, err := d.getChannel(ctx, channelName, false)
+	if err != nil {
+		return nil, err
+	}
+	if err = d.setReplicationFilterOptions(ctx, channel, options); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
+
+// startReplicaProcedure implements the dolt_start_replica stored procedure.
+func (d *doltBinlogReplicaController) startReplicaProcedure(ctx *sql.Context, channelName string) (sql.RowIter, error) {
+	if err := d.checkReplicationPrivileges(ctx, false); err != nil {
+		return nil, err
+	}
+	channel, err := d.getChannel(ctx, channelName, false)
+	if err != nil {
+		return nil, err
+	}
+	if err = d.startReplica(ctx, channel); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
+
+// stopReplicaProcedure implements the dolt_stop_replica stored procedure.
+func (d *doltBinlogReplicaController) stopReplicaProcedure(ctx *sql.Context, channelName string) (sql.RowIter, error) {
+	if err := d.checkReplicationPrivileges(ctx, false); err != nil {
+		return nil, err
+	}
+	channel, err := d.getChannel(ctx, channelName, false)
+	if err != nil {
+		return nil, err
+	}
+	if err = d.stopReplica(ctx, channel); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
+
+// resetReplicaProcedure implements the dolt_reset_replica stored procedure. Passing 'all' after the channel name
+// behaves like RESET REPLICA ALL, and removes a named channel entirely.
+func (d *doltBinlogReplicaController) resetReplicaProcedure(ctx *sql.Context, channelName string, args ...string) (sql.RowIter, error) {
+	if err := d.checkReplicationPrivileges(ctx, false); err != nil {
+		return nil, err
+	}
+	resetAll := false
+	for _, arg := range args {
+		if !strings.EqualFold(arg, "all") {
+			return nil, fmt.Errorf("unsupported argument for dolt_reset_replica: '%s'", arg)
+		}
+		resetAll = true
+	}
+	channel, err := d.getChannel(ctx, channelName, false)
+	if err != nil {
+		return nil, err
+	}
+	if err = d.resetReplica(ctx, channel, resetAll); err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(sql.Row{int64(0)}), nil
+}
+
+// replicaStatusProcedure implements the dolt_replica_status stored procedure, which returns one row with the status
+// of each of the named |channelNames|, or of all channels if no names are given.
+func (d *doltBinlogReplicaController) replicaStatusProcedure(ctx *sql.Context, channelNames ...string) (sql.RowIter, error) {
+	if err := d.checkReplicationPrivileges(ctx, true); err != nil {
+		return nil, err
+	}
+
+	var channels []*replicaChannel
+	if len(channelNames) == 0 {
+		var err error
+		channels, err = d.allChannels(ctx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for _, name := range channelNames {
+			channel, err := d.getChannel(ctx, name, false)
+			if err != nil {
+				return nil, err
+			}
+			channels = append(channels, channel)
+		}
+	}
+
+	rows := make([]sql.Row, 0, len(channels))
+	for _, channel := range channels {
+		status, err := d.getReplicaStatus(ctx, channel)
+		if err != nil {
+			return nil, err
+		}
+		branch, err := channel.loadBranch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, sql.Row{
+			channel.name,
+			status.SourceHost,
+			status.SourceUser,
+			uint64(status.SourcePort),
+			status.ReplicaIoRunning,
+			status.ReplicaSqlRunning,
+			strings.Join(status.ReplicateDoTables, ","),
+			strings.Join(status.ReplicateIgnoreTables, ","),
+			uint64(status.LastIoErrNumber),
+			status.LastIoError,
+			uint64(status.LastSqlErrNumber),
+			status.LastSqlError,
+			status.SourceServerUuid,
+			status.RetrievedGtidSet,
+			status.ExecutedGtidSet,
+			branch,
+		})
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+// checkReplicationPrivileges returns an error if the current user doesn't have the REPLICATION_SLAVE_ADMIN privilege,
+// which is required by the replication statements. If |statusOnly| is true, the REPLICATION CLIENT privilege, which
+// is required by SHOW REPLICA STATUS, is accepted as well.
+func (d *doltBinlogReplicaController) checkReplicationPrivileges(ctx *sql.Context, statusOnly bool) error {
+	if d.engine == nil {
+		return fmt.Errorf("binlog replication is only supported when running as a SQL server")
+	}
+
+	mysqlDb := d.engine.Analyzer.Catalog.MySQLDb
+	if mysqlDb.UserHasPrivileges(ctx, sql.NewDynamicPrivilegedOperation(plan.DynamicPrivilege_ReplicationSlaveAdmin)) {
+		return nil
+	}
+	if statusOnly && mysqlDb.UserHasPrivileges(ctx,
+		sql.NewPrivilegedOperation(sql.PrivilegeCheckSubject{}, sql.PrivilegeType_ReplicationClient)) {
+		return nil
+	}
+	return ErrReplicationAccessDenied
+}
+
+// parseReplicationOptions parses replication options passed to the replication stored procedures as 'NAME=value'
+// strings. Integer options and table list options (such as REPLICATE_DO_TABLE=(db.t1,db.t2)) are converted to the
+// same option value types that the SQL parser creates for the replication statements.
+func parseReplicationOptions(args []string) ([]binlogreplication.ReplicationOption, error) {
+	options := make([]binlogreplication.ReplicationOption, 0, len(args))
+	for _, arg := range args {
+		name, value, ok := strings.Cut(arg, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid replication option '%s'; options must be specified as NAME=value", arg)
+		}
+		name = strings.ToUpper(strings.TrimSpace(name))
+		value = strings.TrimSpace(value)
+
+		var optionValue binlogreplication.ReplicationOptionValue
+		switch name {
+		case "SOURCE_PORT", "SOURCE_CONNECT_RETRY", "SOURCE_RETRY_COUNT", "SOURCE_AUTO_POSITION":
+			intValue, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for option %s: '%s'; expected an integer", name, value)
+			}
+			optionValue = binlogreplication.IntegerReplicationOptionValue{Value: intValue}
+		case "REPLICATE_DO_TABLE", "REPLICATE_IGNORE_TABLE":
+			tables, err := parseTableList(value)
+			if err != nil {
+				return nil, err
+			}
+			optionValue = binlogreplication.TableNamesReplicationOptionValue{Value: tables}
+		default:
+			optionValue = binlogreplication.StringReplicationOptionValue{Value: unquote(value)}
+		}
+		options = append(options, *binlogreplication.NewReplicationOption(name, optionValue))
+	}
+	return options, nil
+}
+
+// parseTableList parses a parenthesized, comma separated list of database qualified table names, such as
+// "(db1.t1, db2.t2)".
+func parseTableList(value string) ([]sql.UnresolvedTable, error) {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		value = value[1 : len(value)-1]
+	}
+
+	var tables []sql.UnresolvedTable
+	for _, tableName := range strings.Split(value, ",") {
+		tableName = strings.TrimSpace(tableName)
+		if tableName == "" {
+			continue
+		}
+		dbName, tableName, ok := strings.Cut(tableName, ".")
+		if !ok {
+			return nil, fmt.Errorf("no database specified for table '%s'; "+
+				"all filter table names must be qualified with a database name", unquote(dbName))
+		}
+		tables = append(tables, plan.NewUnresolvedTable(unquote(tableName), unquote(dbName)))
+	}
+	return tables, nil
+}
+
+// unquote removes matching single quotes, double quotes or backticks around |s|.
+func unquote(s string) string {
+	if len(s) >= 2 {
+		first, last := s[0], s[len(s)-1]
+		if first == last && (first == '\'' || first == '"' || first == '`') {
+			return s[1 : len(s)-1]
+		}
+	}
+	return s
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/binlogreplication"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReplicationOptions(t *testing.T) {
+	options, err := parseReplicationOptions([]string{
+		"source_host='localhost'",
+		"SOURCE_PORT = 3307",
+		"SOURCE_PASSWORD=pass=word",
+		"DOLT_BRANCH=east",
+		"REPLICATE_IGNORE_TABLE=(db1.t1, `db2`.`t2`)",
+	})
+	require.NoError(t, err)
+	require.Len(t, options, 5)
+
+	require.Equal(t, "SOURCE_HOST", options[0].Name)
+	require.Equal(t, binlogreplication.StringReplicationOptionValue{Value: "localhost"}, options[0].Value)
+	require.Equal(t, "SOURCE_PORT", options[1].Name)
+	require.Equal(t, binlogreplication.IntegerReplicationOptionValue{Value: 3307}, options[1].Value)
+	require.Equal(t, binlogreplication.StringReplicationOptionValue{Value: "pass=word"}, options[2].Value)
+	require.Equal(t, binlogreplication.StringReplicationOptionValue{Value: "east"}, options[3].Value)
+
+	tables, err := getOptionValueAsTableNames(options[4])
+	require.NoError(t, err)
+	require.Len(t, tables, 2)
+	require.Equal(t, "db1", tables[0].Database().Name())
+	require.Equal(t, "t1", tables[0].Name())
+	require.Equal(t, "db2", tables[1].Database().Name())
+	require.Equal(t, "t2", tables[1].Name())
+
+	_, err = parseReplicationOptions([]string{"SOURCE_HOST"})
+	require.ErrorContains(t, err, "NAME=value")
+	_, err = parseReplicationOptions([]string{"SOURCE_PORT=abc"})
+	require.ErrorContains(t, err, "expected an integer")
+	_, err = parseReplicationOptions([]string{"REPLICATE_DO_TABLE=(t1)"})
+	require.ErrorContains(t, err, "no database specified for table 't1'")
+}
+
+func TestValidateChannelName(t *testing.T) {
+	require.NoError(t, validateChannelName(defaultChannel))
+	require.NoError(t, validateChannelName("east"))
+	require.NoError(t, validateChannelName("source_2-b"))
+	require.Error(t, validateChannelName("../east"))
+	require.Error(t, validateChannelName("east west"))
+	require.Error(t, validateChannelName(strings.Repeat("a", 65)))
+}
+
+func TestBinlogPositionStoreFilename(t *testing.T) {
+	require.Equal(t, "binlog-position", positionStore.filename)
+	require.Equal(t, "binlog-position", newBinlogPositionStore(defaultChannel).filename)
+	require.Equal(t, "binlog-position-east", newBinlogPositionStore("east").filename)
+}
+
+func TestRegisterStoredProcedures(t *testing.T) {
+	registry := sql.NewExternalStoredProcedureRegistry()
+	newDoltBinlogReplicaController().RegisterStoredProcedures(&registry)
+
+	for _, name := range []string{"dolt_change_replication_source", "dolt_change_replication_filter",
+		"dolt_start_replica", "dolt_stop_replica", "dolt_reset_replica", "dolt_replica_status"} {
+		procedures, err := registry.LookupByName(name)
+		require.NoError(t, err)
+		require.Len(t, procedures, 1, name)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package binlogreplication
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBinlogReplicationChannels tests that a Dolt replica can replicate from two MySQL sources at the same time,
+// using the default channel for one source and a named channel, which commits to its own branch, for the other.
+func TestBinlogReplicationChannels(t *testing.T) {
+	defer teardown(t)
+	startSqlServersWithDoltSystemVars(t, doltReplicaSystemVars)
+	startReplicationAndCreateTestDb(t, mySqlPort)
+
+	// Start a second MySQL server, restoring the globals that point to the first one afterwards
+	firstPrimary, firstPort, firstProcess := primaryDatabase, mySqlPort, mySqlProcess
+	firstLogFile, firstLogFilePath := mysqlLogFile, mysqlLogFilePath
+	secondPort, secondProcess, err := startMySqlServer(filepath.Join(testDir, "second"))
+	require.NoError(t, err)
+	secondPrimary := primaryDatabase
+	defer secondProcess.Kill()
+	primaryDatabase, mySqlPort, mySqlProcess = firstPrimary, firstPort, firstProcess
+	mysqlLogFile, mysqlLogFilePath = firstLogFile, firstLogFilePath
+
+	// Make a change through the default channel
+	primaryDatabase.MustExec("create table db01.t1 (pk int primary key, c1 varchar(100));")
+	primaryDatabase.MustExec("insert into db01.t1 values (1, 'default channel');")
+	waitForReplicaToCatchUp(t)
+
+	// Configure and start the "second" channel, which commits to the "second" branch
+	replicaDatabase.MustExec(fmt.Sprintf("call dolt_change_replication_source('second', 'SOURCE_HOST=localhost', "+
+		"'SOURCE_USER=replicator', 'SOURCE_PASSWORD=Zqr8_blrGm1!', 'SOURCE_PORT=%d', 'SOURCE_CONNECT_RETRY=5', "+
+		"'DOLT_BRANCH=second');", secondPort))
+	replicaDatabase.MustExec("call dolt_start_replica('second');")
+
+	// The same source can't be replicated by two channels
+	_, err = replicaDatabase.Exec(fmt.Sprintf("call dolt_change_replication_source('third', "+
+		"'SOURCE_HOST=localhost', 'SOURCE_PORT=%d');", secondPort))
+	require.ErrorContains(t, err, "already replicates from source")
+
+	// Unknown channels are reported as errors
+	_, err = replicaDatabase.Exec("call dolt_start_replica('third');")
+	require.ErrorContains(t, err, ErrReplicationChannelNotFound.Error())
+
+	// Make changes through the second channel; db01 already exists on the replica, so it's only created if needed
+	secondPrimary.MustExec("create database if not exists db01;")
+	secondPrimary.MustExec("create table db01.t2 (pk int primary key, c1 varchar(100));")
+	secondPrimary.MustExec("insert into db01.t2 values (1, 'second channel');")
+	waitForChannelToCatchUp(t, "second", secondPrimary)
+
+	// The default channel keeps replicating to the default branch. @@gtid_executed now includes the GTIDs of both
+	// sources, so only the default channel's executed GTIDs can be compared to the first source.
+	primaryDatabase.MustExec("insert into db01.t1 values (2, 'default channel');")
+	waitForChannelToCatchUp(t, "", primaryDatabase)
+
+	requireReplicaResults(t, "select * from db01.t1 order by pk;",
+		[][]any{{"1", "default channel"}, {"2", "default channel"}})
+	requireReplicaResults(t, "show tables from db01;", [][]any{{"t1"}})
+	requireReplicaResults(t, "select * from `db01/second`.t2;", [][]any{{"1", "second channel"}})
+	requireReplicaResults(t, "select message like 'Dolt binlog replica commit%' from `db01/second`.dolt_log limit 1;",
+		[][]any{{"1"}})
+
+	// Each channel reports its own status
+	rows, err := replicaDatabase.Queryx("call dolt_replica_status();")
+	require.NoError(t, err)
+	allRows := readAllRowsIntoMaps(t, rows)
+	require.Len(t, allRows, 2)
+	require.Equal(t, "", allRows[0]["Channel_Name"])
+	require.Equal(t, fmt.Sprintf("%d", mySqlPort), allRows[0]["Source_Port"])
+	require.Equal(t, "", allRows[0]["Branch"])
+	require.Equal(t, "second", allRows[1]["Channel_Name"])
+	require.Equal(t, fmt.Sprintf("%d", secondPort), allRows[1]["Source_Port"])
+	require.Equal(t, "Yes", allRows[1]["Replica_IO_Running"])
+	require.Equal(t, "second", allRows[1]["Branch"])
+
+	// Stopping and removing the named channel leaves the default channel running
+	replicaDatabase.MustExec("call dolt_stop_replica('second');")
+	replicaDatabase.MustExec("call dolt_reset_replica('second', 'all');")
+	rows, err = replicaDatabase.Queryx("call dolt_replica_status();")
+	require.NoError(t, err)
+	allRows = readAllRowsIntoMaps(t, rows)
+	require.Len(t, allRows, 1)
+	require.Equal(t, "Yes", allRows[0]["Replica_IO_Running"])
+}
+
+// waitForChannelToCatchUp waits (up to 30s) for the replication channel named |channel| to have executed all
+// transactions from its source, |primary|.
+func waitForChannelToCatchUp(t *testing.T, channel string, primary *sqlx.DB) {
+	timeLimit := 30 * time.Second
+
+	endTime := time.Now().Add(timeLimit)
+	for time.Now().Before(endTime) {
+		primaryGtid := queryGtid(t, primary)
+		rows, err := replicaDatabase.Queryx(fmt.Sprintf("call dolt_replica_status('%s');", channel))
+		require.NoError(t, err)
+		status := convertMapScanResultToStrings(readNextRow(t, rows))
+		require.NoError(t, rows.Close())
+
+		if strings.EqualFold(primaryGtid, fmt.Sprintf("%v", status["Executed_Gtid_Set"])) {
+			return
+		}
+		fmt.Printf("channel %s not in sync yet... (primary: %s, replica: %v)\n",
+			channel, primaryGtid, status["Executed_Gtid_Set"])
+		time.Sleep(250 * time.Millisecond)
+	}
+
+	t.Fatal("channel " + channel + " did not synchronize with its source within " + timeLimit.String())
+}
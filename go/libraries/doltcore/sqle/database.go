@@ -259,6 +259,10 @@ func (db Database) GetTableInsensitive(ctx *sql.Context, tblName string) (sql.Ta
 		return tbl, ok, nil
 	}
 
+	if err := dsess.CheckReadAccessForDb(ctx, db); err != nil {
+		return nil, false, err
+	}
+
 	root, err := db.GetRoot(ctx)
 	if err != nil {
 		return nil, false, err
@@ -272,6 +276,9 @@ func (db Database) GetTableInsensitiveAsOf(ctx *sql.Context, tableName string, a
 	if asOf == nil {
 		return db.GetTableInsensitive(ctx, tableName)
 	}
+	if err := dsess.CheckReadAccessForDb(ctx, db); err != nil {
+		return nil, false, err
+	}
 	head, root, err := resolveAsOf(ctx, db, asOf)
 	if err != nil {
 		return nil, false, err
@@ -570,6 +577,14 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 		if !resolve.UseSearchPath || isDoltgresSystemTable {
 			dt, found = dtables.NewRemotesTable(ctx, db.ddb, lwrName), true
 		}
+	case doltdb.StashesTableName, doltdb.GetStashesTableName():
+		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
+		if err != nil {
+			return nil, false, err
+		}
+		if !resolve.UseSearchPath || isDoltgresSystemTable {
+			dt, found = dtables.NewStashesTable(ctx, db.ddb, db.Name(), lwrName), true
+		}
 	case doltdb.CommitsTableName, doltdb.GetCommitsTableName():
 		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
 		if err != nil {
@@ -613,6 +628,10 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 		if !resolve.UseSearchPath || isDoltgresSystemTable {
 			dt, found = dtables.NewMergeStatusTable(db.RevisionQualifiedName(), lwrName), true
 		}
+	case doltdb.WorkflowRunsTableName:
+		dt, found = dtables.NewWorkflowRunsTable(db.AliasedName(), lwrName, dtables.WorkflowRuns), true
+	case doltdb.GCStatusTableName:
+		dt, found = dtables.NewGCStatusTable(db.AliasedName(), lwrName, dtables.GCStatuses), true
 	case doltdb.GetTagsTableName(), doltdb.TagsTableName:
 		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
 		if err != nil {
@@ -654,6 +673,17 @@ func (db Database) getTableInsensitive(ctx *sql.Context, head *doltdb.Commit, ds
 			versionableTable := backingTable.(dtables.VersionableTable)
 			dt, found = dtables.NewIgnoreTable(ctx, versionableTable, db.schemaName), true
 		}
+	case doltdb.CommitChecksTableName:
+		backingTable, _, err := db.getTable(ctx, root, doltdb.CommitChecksTableName)
+		if err != nil {
+			return nil, false, err
+		}
+		if backingTable == nil {
+			dt, found = dtables.NewEmptyCommitChecksTable(ctx, db.schemaName), true
+		} else {
+			versionableTable := backingTable.(dtables.VersionableTable)
+			dt, found = dtables.NewCommitChecksTable(ctx, versionableTable, db.schemaName), true
+		}
 	case doltdb.GetDocTableName(), doltdb.DocTableName:
 		isDoltgresSystemTable, err := resolve.IsDoltgresSystemTable(ctx, tname, root)
 		if err != nil {
@@ -844,6 +874,10 @@ func resolveAsOfCommitRef(ctx *sql.Context, db Database, head ref.DoltRef, commi
 		return nil, nil, err
 	}
 
+	if err = dsess.CheckReadAccessForCommitSpec(ctx, ddb, db.Name(), commitRef); err != nil {
+		return nil, nil, err
+	}
+
 	nomsRoot, err := dsess.TransactionRoot(ctx, db)
 	if err != nil {
 		return nil, nil, err
@@ -868,6 +902,9 @@ func resolveAsOfCommitRef(ctx *sql.Context, db Database, head ref.DoltRef, commi
 
 // GetTableNamesAsOf implements sql.VersionedDatabase
 func (db Database) GetTableNamesAsOf(ctx *sql.Context, time interface{}) ([]string, error) {
+	if err := dsess.CheckReadAccessForDb(ctx, db); err != nil {
+		return nil, err
+	}
 	_, root, err := resolveAsOf(ctx, db, time)
 	if err != nil {
 		return nil, err
@@ -1085,6 +1122,14 @@ func (db Database) newDoltTable(tableName string, sch schema.Schema, tbl *doltdb
 // name resolution in queries is handled by GetTableInsensitive. Use GetAllTableNames for an unfiltered list of all
 // tables in user space.
 func (db Database) GetTableNames(ctx *sql.Context) ([]string, error) {
+	// Tables on unreadable branches and commits are hidden rather than returning an error, as information_schema lists
+	// the tables of every database
+	if err := dsess.CheckReadAccessForDb(ctx, db); branch_control.ErrCannotReadBranch.Is(err) || branch_control.ErrCannotReadCommit.Is(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
 	showSystemTablesVar, err := ctx.GetSessionVariable(ctx, dsess.ShowSystemTables)
 	if err != nil {
 		return nil, err
@@ -26,6 +26,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
@@ -391,17 +392,20 @@ func (p *DoltDatabaseProvider) allRevisionDbs(ctx *sql.Context, db dsess.SqlData
 		return nil, err
 	}
 
-	revDbs := make([]sql.Database, len(branches))
-	for i, branch := range branches {
+	revDbs := make([]sql.Database, 0, len(branches))
+	for _, branch := range branches {
 		revisionQualifiedName := fmt.Sprintf("%s/%s", db.Name(), branch.GetPath())
 		revDb, ok, err := p.databaseForRevision(ctx, revisionQualifiedName, revisionQualifiedName)
-		if err != nil {
+		if branch_control.ErrCannotReadBranch.Is(err) {
+			// branches that the user may not read are not listed
+			continue
+		} else if err != nil {
 			return nil, err
 		}
 		if !ok {
 			return nil, fmt.Errorf("cannot get revision database for %s/%s", db.Name(), branch.GetPath())
 		}
-		revDbs[i] = revDb
+		revDbs = append(revDbs, revDb)
 	}
 
 	return revDbs, nil
@@ -930,6 +934,9 @@ func (p *DoltDatabaseProvider) databaseForRevision(ctx *sql.Context, revisionQua
 	dbCache := sess.DatabaseCache(ctx)
 	db, ok := dbCache.GetCachedRevisionDb(revisionQualifiedName, requestedName)
 	if ok {
+		if err := dsess.CheckReadAccessForDb(ctx, db); err != nil {
+			return nil, false, err
+		}
 		return db, true, nil
 	}
 
@@ -947,6 +954,10 @@ func (p *DoltDatabaseProvider) databaseForRevision(ctx *sql.Context, revisionQua
 
 	switch dbType {
 	case dsess.RevisionTypeBranch:
+		if err := dsess.CheckReadAccess(ctx, baseName, resolvedRevSpec); err != nil {
+			return nil, false, err
+		}
+
 		// fetch the upstream head if this is a replicated db
 		replicaDb, ok := srcDb.(ReadReplicaDatabase)
 		if ok && replicaDb.ValidReplicaState(ctx) {
@@ -985,6 +996,9 @@ func (p *DoltDatabaseProvider) databaseForRevision(ctx *sql.Context, revisionQua
 		if err != nil {
 			return nil, false, err
 		}
+		if err := dsess.CheckReadAccessForDb(ctx, db); err != nil {
+			return nil, false, err
+		}
 
 		dbCache.CacheRevisionDb(db)
 		return db, true, nil
@@ -1004,6 +1018,9 @@ func (p *DoltDatabaseProvider) databaseForRevision(ctx *sql.Context, revisionQua
 		if err != nil {
 			return nil, false, err
 		}
+		if err := dsess.CheckReadAccessForDb(ctx, db); err != nil {
+			return nil, false, err
+		}
 
 		dbCache.CacheRevisionDb(db)
 		return db, true, nil
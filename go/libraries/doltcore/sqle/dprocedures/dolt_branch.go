@@ -73,13 +73,13 @@ func doDoltBranch(ctx *sql.Context, args []string) (int, error) {
 
 	switch {
 	case apr.Contains(cli.CopyFlag):
-		err = copyBranch(ctx, dbData, apr, &rsc)
+		err = copyBranch(ctx, dbData, apr, dbName, &rsc)
 	case apr.Contains(cli.MoveFlag):
 		err = renameBranch(ctx, dbData, apr, dSess, dbName, &rsc)
 	case apr.Contains(cli.DeleteFlag), apr.Contains(cli.DeleteForceFlag):
 		err = deleteBranches(ctx, dbData, apr, dSess, dbName, &rsc)
 	default:
-		err = createNewBranch(ctx, dbData, apr, &rsc)
+		err = createNewBranch(ctx, dbData, apr, dbName, &rsc)
 	}
 
 	if err != nil {
@@ -122,6 +122,9 @@ func renameBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseRe
 	if err := branch_control.CanDeleteBranch(ctx, oldBranchName); err != nil {
 		return err
 	}
+	if err := dsess.CheckReadAccess(ctx, dbName, oldBranchName); err != nil {
+		return err
+	}
 	if err := branch_control.CanCreateBranch(ctx, newBranchName); err != nil {
 		return err
 	}
@@ -348,7 +351,7 @@ func loadConfig(ctx *sql.Context) *env.DoltCliConfig {
 	return dEnv.Config
 }
 
-func createNewBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseResults, rsc *doltdb.ReplicationStatusController) error {
+func createNewBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseResults, dbName string, rsc *doltdb.ReplicationStatusController) error {
 	if apr.NArg() == 0 || apr.NArg() > 2 {
 		return InvalidArgErr
 	}
@@ -405,6 +408,10 @@ func createNewBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgPars
 	if err != nil {
 		return err
 	}
+	// The new branch's creator is made its admin, so they must be able to read the start point.
+	if err = dsess.CheckReadAccessForCommitSpec(ctx, dbData.Ddb, dbName, startPt); err != nil {
+		return err
+	}
 
 	err = actions.CreateBranchWithStartPt(ctx, dbData, branchName, startPt, apr.Contains(cli.ForceFlag), rsc)
 	if err != nil {
@@ -422,7 +429,7 @@ func createNewBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgPars
 	return nil
 }
 
-func copyBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseResults, rsc *doltdb.ReplicationStatusController) error {
+func copyBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseResults, dbName string, rsc *doltdb.ReplicationStatusController) error {
 	if apr.NArg() != 2 {
 		return InvalidArgErr
 	}
@@ -437,6 +444,10 @@ func copyBranch(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseResu
 		return EmptyBranchNameErr
 	}
 
+	if err := dsess.CheckReadAccess(ctx, dbName, srcBr); err != nil {
+		return err
+	}
+
 	force := apr.Contains(cli.ForceFlag)
 	return copyABranch(ctx, dbData, srcBr, destBr, force, rsc)
 }
@@ -419,6 +419,10 @@ func checkoutNewBranch(ctx *sql.Context, dbName string, dbData env.DbData, apr *
 		newBranchName = optionBBranch
 	}
 
+	if err = dsess.CheckReadAccessForCommitSpec(ctx, dbData.Ddb, dbName, startPt); err != nil {
+		return "", "", err
+	}
+
 	err = actions.CreateBranchWithStartPt(ctx, dbData, newBranchName, startPt, createBranchForcibly, rsc)
 	if err != nil {
 		return "", "", err
@@ -472,6 +476,10 @@ func checkoutNewBranch(ctx *sql.Context, dbName string, dbData env.DbData, apr *
 
 // checkoutExistingBranch updates the active branch reference to point to an already existing branch.
 func checkoutExistingBranch(ctx *sql.Context, dbName string, branchName string, apr *argparser.ArgParseResults) error {
+	if err := dsess.CheckReadAccess(ctx, dbName, branchName); err != nil {
+		return err
+	}
+
 	wsRef, err := ref.WorkingSetRefForHead(ref.NewBranchRef(branchName))
 	if err != nil {
 		return err
@@ -518,6 +526,10 @@ func checkoutTablesFromCommit(
 	}
 	currentBranchRef := ref.NewBranchRef(currentBranch)
 
+	if err = dsess.CheckReadAccessForCommitSpec(ctx, dbData.Ddb, databaseName, commitRef); err != nil {
+		return err
+	}
+
 	cs, err := doltdb.NewCommitSpec(commitRef)
 	if err != nil {
 		return err
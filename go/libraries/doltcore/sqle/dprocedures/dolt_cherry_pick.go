@@ -17,6 +17,7 @@ package dprocedures
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
@@ -25,10 +26,15 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/cherry_pick"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 )
 
 var ErrEmptyCherryPick = errors.New("cannot cherry-pick empty string")
 
+var ErrCherryPickUnstagedChanges = errors.New("error: cannot continue a cherry-pick with unstaged changes. " +
+	"Use dolt_add() to stage the resolved tables, then continue the cherry-pick with dolt_cherry_pick('--continue')")
+
 var cherryPickSchema = []*sql.Column{
 	{
 		Name:     "hash",
@@ -61,8 +67,9 @@ func doltCherryPick(ctx *sql.Context, args ...string) (sql.RowIter, error) {
 	return rowToIter(newCommitHash, int64(dataConflicts), int64(schemaConflicts), int64(constraintViolations)), nil
 }
 
-// doDoltCherryPick attempts to perform a cherry-pick merge based on the arguments specified in |args| and returns
-// the new, created commit hash (if it was successful created), a count of the number of tables with data conflicts,
+// doDoltCherryPick attempts to perform a cherry-pick merge based on the arguments specified in |args|, which may name
+// several commits or ranges of commits that are applied in order, and returns the hash of the last commit created
+// (if they were all successfully created), a count of the number of tables with data conflicts,
 // a count of the number of tables with schema conflicts, and a count of the number of tables with constraint violations.
 func doDoltCherryPick(ctx *sql.Context, args []string) (string, int, int, int, error) {
 	// Get the information for the sql context.
@@ -81,19 +88,14 @@ func doDoltCherryPick(ctx *sql.Context, args []string) (string, int, int, int, e
 	}
 
 	if apr.Contains(cli.AbortParam) {
-		return "", 0, 0, 0, cherry_pick.AbortCherryPick(ctx, dbName)
-	}
-
-	// we only support cherry-picking a single commit for now.
-	if apr.NArg() == 0 {
-		return "", 0, 0, 0, ErrEmptyCherryPick
-	} else if apr.NArg() > 1 {
-		return "", 0, 0, 0, fmt.Errorf("cherry-picking multiple commits is not supported yet")
+		if err = cherry_pick.AbortCherryPick(ctx, dbName); err != nil {
+			return "", 0, 0, 0, err
+		}
+		// Aborting a sequence of commits may move HEAD, which the session only picks up in a new transaction
+		return "", 0, 0, 0, commitTransaction(ctx, dsess.DSessFromSess(ctx.Session), nil)
 	}
-
-	cherryStr := apr.Arg(0)
-	if len(cherryStr) == 0 {
-		return "", 0, 0, 0, ErrEmptyCherryPick
+	if apr.Contains(cli.ContinueFlag) && apr.Contains(cli.SkipFlag) {
+		return "", 0, 0, 0, fmt.Errorf("error: --continue and --skip cannot be used together")
 	}
 
 	cherryPickOptions := cherry_pick.NewCherryPickOptions()
@@ -103,9 +105,75 @@ func doDoltCherryPick(ctx *sql.Context, args []string) (string, int, int, int, e
 		cherryPickOptions.EmptyCommitHandling = doltdb.KeepEmptyCommit
 	}
 
-	commit, mergeResult, err := cherry_pick.CherryPick(ctx, cherryStr, cherryPickOptions)
-	if err != nil {
-		return "", 0, 0, 0, err
+	if mainline, ok := apr.GetInt(cli.MainlineParam); ok {
+		if mainline < 1 {
+			return "", 0, 0, 0, fmt.Errorf("error: invalid parent number %d, parents are numbered starting from 1", mainline)
+		}
+		cherryPickOptions.Mainline = mainline
+	}
+
+	var commit string
+	var mergeResult *merge.Result
+	if apr.Contains(cli.ContinueFlag) {
+		if apr.NArg() > 0 {
+			return "", 0, 0, 0, fmt.Errorf("error: --continue does not take any commits")
+		}
+
+		// report unresolved conflicts before unstaged changes, since resolving conflicts leaves changes to stage
+		if err := cherry_pick.ValidateCherryPickCanContinue(ctx, dbName); err != nil {
+			return "", 0, 0, 0, err
+		}
+
+		_, hasUnstagedChanges, err := workingSetStatus(ctx)
+		if err != nil {
+			return "", 0, 0, 0, err
+		}
+		if hasUnstagedChanges {
+			return "", 0, 0, 0, ErrCherryPickUnstagedChanges
+		}
+
+		commit, mergeResult, err = cherry_pick.ContinueCherryPick(ctx, dbName, cherryPickOptions)
+		if err != nil {
+			return "", 0, 0, 0, err
+		}
+	} else if apr.Contains(cli.SkipFlag) {
+		if apr.NArg() > 0 {
+			return "", 0, 0, 0, fmt.Errorf("error: --skip does not take any commits")
+		}
+
+		commit, mergeResult, err = cherry_pick.SkipCherryPick(ctx, dbName, cherryPickOptions)
+		if err != nil {
+			return "", 0, 0, 0, err
+		}
+	} else {
+		if apr.NArg() == 0 {
+			return "", 0, 0, 0, ErrEmptyCherryPick
+		}
+		ddb, ok := dsess.DSessFromSess(ctx.Session).GetDoltDB(ctx, dbName)
+		if !ok {
+			return "", 0, 0, 0, sql.ErrDatabaseNotFound.New(dbName)
+		}
+		for _, cherryStr := range apr.Args {
+			if len(cherryStr) == 0 {
+				return "", 0, 0, 0, ErrEmptyCherryPick
+			}
+			// both ends of a range must be readable, since the commits in between are read from the branches
+			for _, spec := range strings.Split(cherryStr, "..") {
+				if err := dsess.CheckReadAccessForCommitSpec(ctx, ddb, dbName, spec); err != nil {
+					return "", 0, 0, 0, err
+				}
+			}
+		}
+
+		commits, err := cherry_pick.ResolveCherryPickCommits(ctx, apr.Args)
+		if err != nil {
+			return "", 0, 0, 0, err
+		}
+
+		commit, mergeResult, err = cherry_pick.CherryPickCommits(ctx, commits, cherryPickOptions)
+		if err != nil {
+			return "", 0, 0, 0, err
+		}
 	}
 
 	if mergeResult != nil {
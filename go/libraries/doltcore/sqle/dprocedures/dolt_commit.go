@@ -104,35 +104,59 @@ func doDoltCommit(ctx *sql.Context, args []string) (string, bool, error) {
 		}
 	}
 
-	var name, email string
+	// In SQL mode, use the current SQL user as the committer, instead of the `dolt config` configured values.
+	// We won't have an email address for the SQL user though, so instead use the MySQL user@address notation.
+	committerName := ctx.Client().User
+	committerEmail := fmt.Sprintf("%s@%s", ctx.Client().User, ctx.Client().Address)
+	if committerStr, ok := apr.GetValue(cli.CommitterParam); ok {
+		committerName, committerEmail, err = cli.ParseAuthor(committerStr)
+		if err != nil {
+			return "", false, err
+		}
+	}
+
+	// The committer is also the author, unless another author is given explicitly
+	name, email := committerName, committerEmail
 	if authorStr, ok := apr.GetValue(cli.AuthorParam); ok {
 		name, email, err = cli.ParseAuthor(authorStr)
 		if err != nil {
 			return "", false, err
 		}
-	} else {
-		// In SQL mode, use the current SQL user as the commit author, instead of the `dolt config` configured values.
-		// We won't have an email address for the SQL user though, so instead use the MySQL user@address notation.
-		name = ctx.Client().User
-		email = fmt.Sprintf("%s@%s", ctx.Client().User, ctx.Client().Address)
 	}
 
-	amend := apr.Contains(cli.AmendFlag)
-
-	msg, msgOk := apr.GetValue(cli.MessageArg)
-	if !msgOk {
-		if amend {
-			commit, err := dSess.GetHeadCommit(ctx, dbName)
-			if err != nil {
-				return "", false, err
-			}
-			commitMeta, err := commit.GetCommitMeta(ctx)
+	var trailers []datas.CommitTrailer
+	if trailerStrs, ok := apr.GetValueList(cli.TrailerParam); ok {
+		for _, trailerStr := range trailerStrs {
+			trailer, err := datas.ParseCommitTrailer(trailerStr)
 			if err != nil {
 				return "", false, err
 			}
+			trailers = append(trailers, trailer)
+		}
+	}
+
+	amend := apr.Contains(cli.AmendFlag)
+
+	msg, msgOk := apr.GetValue(cli.MessageArg)
+	if !msgOk && !amend {
+		return "", false, fmt.Errorf("Must provide commit message.")
+	}
+
+	// When amending, the message and trailers of the amended commit are kept unless new ones are given
+	if amend && (!msgOk || !apr.Contains(cli.TrailerParam)) {
+		commit, err := dSess.GetHeadCommit(ctx, dbName)
+		if err != nil {
+			return "", false, err
+		}
+		commitMeta, err := commit.GetCommitMeta(ctx)
+		if err != nil {
+			return "", false, err
+		}
+		if !msgOk {
 			msg = commitMeta.Description
-		} else {
-			return "", false, fmt.Errorf("Must provide commit message.")
+		}
+		if !apr.Contains(cli.TrailerParam) {
+			trailers = commitMeta.Trailers
 		}
 	}
 
@@ -164,6 +188,10 @@ func doDoltCommit(ctx *sql.Context, args []string) (string, bool, error) {
 		Force:      apr.Contains(cli.ForceFlag),
 		Name:       name,
 		Email:      email,
+
+		CommitterName:  committerName,
+		CommitterEmail: committerEmail,
+		Trailers:       trailers,
 	}
 
 	shouldSign, err := dsess.GetBooleanSystemVar(ctx, "gpgsign")
@@ -249,6 +277,12 @@ func commitSignatureStr(ctx *sql.Context, dbName string, roots doltdb.Roots, csp
 	lines = append(lines, fmt.Sprint("Name: ", csp.Name))
 	lines = append(lines, fmt.Sprint("Email: ", csp.Email))
 	lines = append(lines, fmt.Sprint("Date: ", csp.Date.String()))
+	if csp.CommitterName != csp.Name || csp.CommitterEmail != csp.Email {
+		lines = append(lines, fmt.Sprint("Committer: ", csp.CommitterName, " <", csp.CommitterEmail, ">"))
+	}
+	for _, trailer := range csp.Trailers {
+		lines = append(lines, fmt.Sprint("Trailer: ", trailer.String()))
+	}
 
 	head, err := roots.Head.HashOf()
 	if err != nil {
@@ -58,7 +58,7 @@ type DoltConflictsCatFunc struct {
 	children []sql.Expression
 }
 
-func getProllyRowMaps(ctx *sql.Context, vrw types.ValueReadWriter, ns tree.NodeStore, hash hash.Hash, tblName string) (prolly.Map, error) {
+func getProllyRowMaps(ctx *sql.Context, vrw types.ValueReadWriter, ns tree.NodeStore, hash hash.Hash, tblName string, sch schema.Schema) (prolly.Map, error) {
 	rootVal, err := doltdb.LoadRootValueFromRootIshAddr(ctx, vrw, ns, hash)
 	tbl, ok, err := rootVal.GetTable(ctx, doltdb.TableName{Name: tblName})
 	if err != nil {
@@ -68,12 +68,8 @@ func getProllyRowMaps(ctx *sql.Context, vrw types.ValueReadWriter, ns tree.NodeS
 		return prolly.Map{}, doltdb.ErrTableNotFound
 	}
 
-	idx, err := tbl.GetRowData(ctx)
-	if err != nil {
-		return prolly.Map{}, err
-	}
-
-	return durable.ProllyMapFromIndex(idx), nil
+	// the rows are re-keyed if the merge changed the table's primary key
+	return merge.RekeyedRowData(ctx, doltdb.TableName{Name: tblName}, tbl, sch)
 }
 
 func resolveProllyConflicts(ctx *sql.Context, tbl *doltdb.Table, tblName string, ourSch, sch schema.Schema) (*doltdb.Table, error) {
@@ -120,7 +116,7 @@ func resolveProllyConflicts(ctx *sql.Context, tbl *doltdb.Table, tblName string,
 
 		// reload if their root hash changes
 		if theirRoot != cnfArt.TheirRootIsh {
-			theirMap, err = getProllyRowMaps(ctx, tbl.ValueReadWriter(), tbl.NodeStore(), cnfArt.TheirRootIsh, tblName)
+			theirMap, err = getProllyRowMaps(ctx, tbl.ValueReadWriter(), tbl.NodeStore(), cnfArt.TheirRootIsh, tblName, ourSch)
 			if err != nil {
 				return nil, err
 			}
@@ -16,11 +16,14 @@ package dprocedures
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 
 	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/bundle"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dbfactory"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
@@ -29,6 +32,9 @@ import (
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
 )
 
+// bundleRemoteName is the name of the remote that bundle files are fetched from.
+const bundleRemoteName = "bundle"
+
 // doltFetch is the stored procedure version for the CLI command `dolt fetch`.
 func doltFetch(ctx *sql.Context, args ...string) (sql.RowIter, error) {
 	res, err := doDoltFetch(ctx, args)
@@ -59,6 +65,16 @@ func doDoltFetch(ctx *sql.Context, args []string) (int, error) {
 		return cmdFailure, err
 	}
 
+	if apr.NArg() > 0 {
+		isBundle, err := isReadableBundle(ctx, apr.Arg(0))
+		if err != nil {
+			return cmdFailure, err
+		}
+		if isBundle {
+			return doDoltFetchFromBundle(ctx, dbData, apr)
+		}
+	}
+
 	remote, refSpecArgs, err := env.RemoteForFetchArgs(apr.Args, dbData.Rsr)
 	if err != nil {
 		return cmdFailure, err
@@ -94,6 +110,105 @@ func doDoltFetch(ctx *sql.Context, args []string) (int, error) {
 	return cmdSuccess, nil
 }
 
+// isReadableBundle returns whether |path| is a bundle file that the current user may fetch from. Since bundles are read
+// from the server's filesystem, only files in the @@dolt_bundle_dir directory are considered, and reading them requires
+// the SUPER privilege. Any other path is treated as a remote name.
+func isReadableBundle(ctx *sql.Context, path string) (bool, error) {
+	_, val, ok := sql.SystemVariables.GetGlobal(dsess.DoltBundleDir)
+	if !ok {
+		return false, sql.ErrUnknownSystemVariable.New(dsess.DoltBundleDir)
+	}
+	bundleDir, _ := val.(string)
+	if bundleDir == "" {
+		return false, nil
+	}
+	if inDir, err := isInDir(path, bundleDir); err != nil || !inDir {
+		return false, nil
+	}
+	if !bundle.IsBundle(path) {
+		return false, nil
+	}
+
+	privs, counter := ctx.GetPrivilegeSet()
+	if counter == 0 {
+		return false, fmt.Errorf("unable to check user privileges for dolt_fetch() from a bundle")
+	}
+	if !privs.Has(sql.PrivilegeType_Super) {
+		return false, sql.ErrPrivilegeCheckFailed.New(ctx.Session.Client().User)
+	}
+	return true, nil
+}
+
+// isInDir returns whether |path| is inside |dir| once symlinks are resolved.
+func isInDir(path, dir string) (bool, error) {
+	path, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return false, err
+	}
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return false, err
+	}
+	dir, err = filepath.EvalSymlinks(dir)
+	if err != nil {
+		return false, err
+	}
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return false, err
+	}
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false, err
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)), nil
+}
+
+// doDoltFetchFromBundle fetches from the bundle file given as the first argument of |apr|, which is treated as a
+// read-only remote named "bundle". Without refspecs, the branches in the bundle are fetched into remote-tracking
+// branches under bundle/.
+func doDoltFetchFromBundle(ctx *sql.Context, dbData env.DbData, apr *argparser.ArgParseResults) (int, error) {
+	path := apr.Arg(0)
+	refSpecArgs := apr.Args[1:]
+	if err := validateFetchArgs(apr, refSpecArgs); err != nil {
+		return cmdFailure, err
+	}
+
+	remote := env.NewRemote(bundleRemoteName, path, nil)
+	defaultRefSpec := len(refSpecArgs) == 0
+	if defaultRefSpec {
+		refSpecArgs = remote.FetchSpecs
+	}
+	refSpecs, err := env.ParseRSFromArgs(remote.Name, refSpecArgs)
+	if err != nil {
+		return cmdFailure, err
+	}
+
+	tempDir, err := dbData.Rsw.TempTableFilesDir()
+	if err != nil {
+		return cmdFailure, err
+	}
+	b, err := bundle.Open(ctx, path, tempDir)
+	if err != nil {
+		return cmdFailure, err
+	}
+	defer b.Close()
+
+	if b.Header.Format != dbData.Ddb.Format().VersionString() {
+		return cmdFailure, fmt.Errorf("bundle storage format %s does not match the database storage format %s", b.Header.Format, dbData.Ddb.Format().VersionString())
+	}
+	if err = b.Header.CheckPrerequisites(ctx, dbData.Ddb); err != nil {
+		return cmdFailure, err
+	}
+
+	mode := ref.UpdateMode{Force: true, Prune: apr.Contains(cli.PruneFlag)}
+	err = actions.FetchRefSpecs(ctx, dbData, b.DoltDB(), refSpecs, defaultRefSpec, &remote, mode, runProgFuncs, stopProgFuncs)
+	if err != nil {
+		return cmdFailure, fmt.Errorf("fetch failed: %w", err)
+	}
+	return cmdSuccess, nil
+}
+
 // validateFetchArgs returns an error if the arguments provided aren't valid.
 func validateFetchArgs(apr *argparser.ArgParseResults, refSpecArgs []string) error {
 	if len(refSpecArgs) > 0 && apr.Contains(cli.PruneFlag) {
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -26,7 +27,12 @@ import (
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
 	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/libraries/utils/config"
+	"github.com/dolthub/dolt/go/libraries/utils/filesys"
 	"github.com/dolthub/dolt/go/store/types"
 )
 
@@ -45,14 +51,26 @@ var DoltGCFeatureFlag = true
 
 // doltGC is the stored procedure to run online garbage collection on a database.
 func doltGC(ctx *sql.Context, args ...string) (sql.RowIter, error) {
-	if !DoltGCFeatureFlag {
-		return nil, errors.New("DOLT_GC() stored procedure disabled")
+	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+		return nil, err
 	}
-	res, err := doDoltGC(ctx, args)
-	if err != nil {
+	if err := RunDoltGC(ctx, args...); err != nil {
 		return nil, err
 	}
-	return rowToIter(int64(res)), nil
+	return rowToIter(int64(cmdSuccess)), nil
+}
+
+// RunDoltGC runs garbage collection on the current database of |ctx| without going through the SQL engine. It takes
+// the same arguments as the dolt_gc() stored procedure, and is used by the server to collect garbage in the background.
+// Unlike the stored procedure, it does not check the branch permissions of the client of |ctx|, as the server's
+// background work has no client. Like the stored procedure, a non-shallow GC kills every connection other than the one
+// of |ctx|.
+func RunDoltGC(ctx *sql.Context, args ...string) error {
+	if !DoltGCFeatureFlag {
+		return errors.New("DOLT_GC() stored procedure disabled")
+	}
+	_, err := doDoltGC(ctx, args)
+	return err
 }
 
 var ErrServerPerformedGC = errors.New("this connection was established when this server performed an online garbage collection. this connection can no longer be used. please reconnect.")
@@ -63,10 +81,6 @@ func doDoltGC(ctx *sql.Context, args []string) (int, error) {
 	if len(dbName) == 0 {
 		return cmdFailure, fmt.Errorf("Empty database name.")
 	}
-	if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
-		return cmdFailure, err
-	}
-
 	apr, err := cli.CreateGCArgParser().Parse(args)
 	if err != nil {
 		return cmdFailure, err
@@ -85,6 +99,9 @@ func doDoltGC(ctx *sql.Context, args []string) (int, error) {
 	if apr.Contains(cli.ShallowFlag) && apr.Contains(cli.FullFlag) {
 		return cmdFailure, fmt.Errorf("cannot supply both --shallow and --full to dolt_gc: %w", InvalidArgErr)
 	}
+	if apr.Contains(cli.ShallowFlag) && apr.Contains(cli.PruneHistoryParam) {
+		return cmdFailure, fmt.Errorf("cannot supply both --shallow and --%s to dolt_gc: %w", cli.PruneHistoryParam, InvalidArgErr)
+	}
 
 	if apr.Contains(cli.ShallowFlag) {
 		err = ddb.ShallowGC(ctx)
@@ -112,10 +129,18 @@ func doDoltGC(ctx *sql.Context, args []string) (int, error) {
 		}
 
 		var mode types.GCMode = types.GCModeDefault
-		if apr.Contains(cli.FullFlag) {
+		if apr.Contains(cli.FullFlag) || apr.Contains(cli.PruneHistoryParam) {
 			mode = types.GCModeFull
 		}
 
+		// History is only pruned before a full GC, which is what reclaims the storage of the pruned commits.
+		if mode == types.GCModeFull {
+			err = pruneHistory(ctx, ddb, dbName, apr)
+			if err != nil {
+				return cmdFailure, err
+			}
+		}
+
 		// TODO: If we got a callback at the beginning and an
 		// (allowed-to-block) callback at the end, we could more
 		// gracefully tear things down.
@@ -183,3 +208,65 @@ func doDoltGC(ctx *sql.Context, args []string) (int, error) {
 
 	return cmdSuccess, nil
 }
+
+// pruneHistory prunes the history of |ddb| before the date or commit given with --prune-history-before. If it was not
+// given, the history older than the number of days configured with gc.history_retention_days is pruned instead, if
+// that is set.
+func pruneHistory(ctx *sql.Context, ddb *doltdb.DoltDB, dbName string, apr *argparser.ArgParseResults) error {
+	boundary, ok := apr.GetValue(cli.PruneHistoryParam)
+	if !ok {
+		cfg, err := env.LoadDoltCliConfig(env.GetCurrentUserHomeDir, filesys.LocalFS)
+		if err != nil {
+			return err
+		}
+		days := cfg.GetStringOrDefault(config.HistoryRetentionDays, "")
+		if days == "" {
+			return nil
+		}
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid value '%s' for %s: expected a positive number of days", days, config.HistoryRetentionDays)
+		}
+		boundary = time.Now().AddDate(0, 0, -n).UTC().Format(time.RFC3339)
+	}
+
+	var prune doltdb.PruneCommitFunc
+	if t, err := dconfig.ParseDate(boundary); err == nil {
+		prune = doltdb.CommittedBefore(t)
+	} else {
+		cs, err := doltdb.NewCommitSpec(boundary)
+		if err != nil {
+			return err
+		}
+		headRef, err := dsess.DSessFromSess(ctx.Session).CWBHeadRef(ctx, dbName)
+		if err != nil {
+			return err
+		}
+		optCmt, err := ddb.Resolve(ctx, cs, headRef)
+		if err != nil {
+			return err
+		}
+		cm, ok := optCmt.ToCommit()
+		if !ok {
+			return doltdb.ErrGhostCommitEncountered
+		}
+		prune, err = doltdb.AncestorsOf(ctx, cm)
+		if err != nil {
+			return err
+		}
+		h, err := cm.HashOf()
+		if err != nil {
+			return err
+		}
+		boundary = h.String()
+	}
+
+	n, err := ddb.PruneHistory(ctx, prune, boundary)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		ctx.GetLogger().Infof("pruned %d commits before %s from the history of database %s", n, boundary, dbName)
+	}
+	return nil
+}
@@ -478,6 +478,9 @@ func createMergeSpec(ctx *sql.Context, sess *dsess.DoltSession, dbName string, a
 	if apr.Contains(cli.NoCommitFlag) && apr.Contains(cli.CommitFlag) {
 		return nil, errors.New("cannot define both 'commit' and 'no-commit' flags at the same time")
 	}
+	if err = dsess.CheckReadAccessForCommitSpec(ctx, ddb, dbName, commitSpecStr); err != nil {
+		return nil, err
+	}
 	return merge.NewMergeSpec(
 		ctx,
 		dbData.Rsr,
@@ -33,6 +33,7 @@ import (
 	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/store/datas"
 )
 
 var doltRebaseProcedureSchema = []*sql.Column{
@@ -53,7 +54,9 @@ var RebaseActionEnumType = types.MustCreateEnumType([]string{
 	rebase.RebaseActionPick,
 	rebase.RebaseActionReword,
 	rebase.RebaseActionSquash,
-	rebase.RebaseActionFixup}, sql.Collation_Default)
+	rebase.RebaseActionFixup,
+	rebase.RebaseActionEdit,
+	rebase.RebaseActionBreak}, sql.Collation_Default)
 
 // GetDoltRebaseSystemTableSchema returns the schema for the dolt_rebase system table.
 // This is used by Doltgres to update the dolt_rebase schema using Doltgres types.
@@ -94,6 +97,11 @@ var ErrRebaseUncommittedChanges = goerrors.NewKind("cannot start a rebase with u
 var ErrRebaseUnstagedChanges = goerrors.NewKind("cannot continue a rebase with unstaged changes. " +
 	"Use dolt_add() to stage tables and then continue the rebase")
 
+// ErrRebaseBreakStagedChanges is used when a rebase is continued after stopping at a break step, but
+// there are staged changes in the working set.
+var ErrRebaseBreakStagedChanges = goerrors.NewKind("cannot continue a rebase with staged changes after a break. " +
+	"Use dolt_commit() to commit the staged changes and then continue the rebase")
+
 // ErrRebaseUnresolvedConflicts is used when a rebase is continued, but there are
 // unresolved conflicts still present.
 var ErrRebaseUnresolvedConflicts = goerrors.NewKind(
@@ -129,6 +137,10 @@ var SuccessfulRebaseMessage = "Successfully rebased and updated refs/heads/"
 
 var RebaseAbortedMessage = "Interactive rebase aborted"
 
+// RebaseStoppedMessage is used when a rebase stops at an edit or break step in the rebase plan. The reason the rebase
+// stopped and the instructions for continuing it follow the message.
+var RebaseStoppedMessage = "Rebase stopped"
+
 func doltRebase(ctx *sql.Context, args ...string) (sql.RowIter, error) {
 	res, message, err := doDoltRebase(ctx, args)
 	if err != nil {
@@ -157,11 +169,11 @@ func doDoltRebase(ctx *sql.Context, args []string) (int, string, error) {
 		}
 
 	case apr.Contains(cli.ContinueFlag):
-		rebaseBranch, err := continueRebase(ctx)
+		message, err := continueRebase(ctx)
 		if err != nil {
 			return 1, "", err
 		} else {
-			return 0, SuccessfulRebaseMessage + rebaseBranch, nil
+			return 0, message, nil
 		}
 
 	default:
@@ -592,6 +604,9 @@ func recordCurrentStep(ctx *sql.Context, step rebase.RebasePlanStep) error {
 	return nil
 }
 
+// continueRebase executes the remaining steps of the rebase plan and returns the message to report to the caller.
+// When the rebase stops at an edit or break step, the message describes how to continue the rebase, otherwise the
+// rebased branch is updated and the message reports the successful rebase.
 func continueRebase(ctx *sql.Context) (string, error) {
 	// Validate that we are in an interactive rebase
 	if err := validateActiveRebase(ctx); err != nil {
@@ -656,6 +671,9 @@ func continueRebase(ctx *sql.Context) (string, error) {
 		// If we've already executed this step, but the working set has staged changes,
 		// then we need to make the commit for the manual changes made for this step.
 		if rebasingStarted && rebaseStepOrder == lastAttemptedStep && hasStagedChanges {
+			if step.Action == rebase.RebaseActionBreak {
+				return "", ErrRebaseBreakStagedChanges.New()
+			}
 			if err = commitManuallyStagedChangesForStep(ctx, step); err != nil {
 				return "", err
 			}
@@ -681,6 +699,17 @@ func continueRebase(ctx *sql.Context) (string, error) {
 			if err != nil {
 				return "", err
 			}
+
+			// Edit and break steps pause the rebase so that the caller can make changes before continuing
+			switch step.Action {
+			case rebase.RebaseActionEdit:
+				return fmt.Sprintf("%s at %s (%s); make any changes to the commit, amend it by staging the "+
+					"changes, then continue rebasing by calling dolt_rebase('--continue')",
+					RebaseStoppedMessage, step.CommitHash, step.CommitMsg), nil
+			case rebase.RebaseActionBreak:
+				return fmt.Sprintf("%s at break; continue rebasing by calling dolt_rebase('--continue')",
+					RebaseStoppedMessage), nil
+			}
 		}
 
 		// Ensure a transaction has been started, so that the session is in sync with the latest changes
@@ -742,14 +771,20 @@ func continueRebase(ctx *sql.Context) (string, error) {
 	if !ok {
 		return "", fmt.Errorf("unable to lookup dbdata")
 	}
-	return rebaseBranch, actions.DeleteBranch(ctx, dbData, rebaseWorkingBranch, actions.DeleteOptions{
+	err = actions.DeleteBranch(ctx, dbData, rebaseWorkingBranch, actions.DeleteOptions{
 		Force: true,
 	}, doltSession.Provider(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	return SuccessfulRebaseMessage + rebaseBranch, nil
 }
 
 // commitManuallyStagedChangesForStep handles committing staged changes after a conflict has been manually
 // resolved by the caller before rebasing has been continued. This involves building the correct commit
-// message based on the details of the rebase plan |step| and then creating the commit.
+// message based on the details of the rebase plan |step| and then creating the commit. Changes staged
+// after the rebase stopped at an edit step are amended into the commit for that step instead.
 func commitManuallyStagedChangesForStep(ctx *sql.Context, step rebase.RebasePlanStep) error {
 	doltSession := dsess.DSessFromSess(ctx.Session)
 	workingSet, err := doltSession.WorkingSet(ctx, ctx.GetCurrentDatabase())
@@ -759,6 +794,15 @@ func commitManuallyStagedChangesForStep(ctx *sql.Context, step rebase.RebasePlan
 
 	options, err := createCherryPickOptionsForRebaseStep(ctx, &step, workingSet.RebaseState().CommitBecomesEmptyHandling(),
 		workingSet.RebaseState().EmptyCommitHandling())
+	if err != nil {
+		return err
+	}
+
+	// If the edit step's cherry-pick stopped on conflicts, a merge is still in progress and the resolved changes
+	// still need their own commit. Otherwise, the commit for the step was already made before the rebase stopped.
+	if step.Action == rebase.RebaseActionEdit && !workingSet.MergeActive() {
+		options.Amend = true
+	}
 
 	commitProps, err := cherry_pick.CreateCommitStagedPropsFromCherryPickOptions(ctx, *options)
 	if err != nil {
@@ -766,12 +810,24 @@ func commitManuallyStagedChangesForStep(ctx *sql.Context, step rebase.RebasePlan
 	}
 
 	// If the commit message wasn't set when we created the cherry-pick options, then set it to the step's commit
-	// message. For fixup commits, we don't use their commit message, so we keep it empty, and let the amend commit
-	// codepath use the previous commit's message.
-	if commitProps.Message == "" && step.Action != rebase.RebaseActionFixup {
+	// message. For fixup commits and amended edit commits, we keep it empty, and let the amend commit codepath use
+	// the previous commit's message.
+	if commitProps.Message == "" && !commitProps.Amend {
 		commitProps.Message = step.CommitMsg
 	}
 
+	// The new commit keeps the authorship of the commit being rebased, or of the commit being amended
+	var authorMeta *datas.CommitMeta
+	if commitProps.Amend {
+		authorMeta, err = headCommitMeta(ctx)
+	} else {
+		authorMeta, err = lookupCommitMeta(ctx, step.CommitHash)
+	}
+	if err != nil {
+		return err
+	}
+	cherry_pick.PreserveAuthorship(commitProps, authorMeta)
+
 	roots, ok := doltSession.GetRoots(ctx, ctx.GetCurrentDatabase())
 	if !ok {
 		return fmt.Errorf("unable to get roots for current session")
@@ -804,8 +860,8 @@ func processRebasePlanStep(ctx *sql.Context, planStep *rebase.RebasePlanStep,
 		}
 	}
 
-	// If the action is "drop", then we don't need to do anything
-	if planStep.Action == rebase.RebaseActionDrop {
+	// If the action is "drop" or "break", then we don't need to do anything
+	if planStep.Action == rebase.RebaseActionDrop || planStep.Action == rebase.RebaseActionBreak {
 		return nil
 	}
 
@@ -825,9 +881,9 @@ func createCherryPickOptionsForRebaseStep(ctx *sql.Context, planStep *rebase.Reb
 	options.EmptyCommitHandling = emptyCommitHandling
 
 	switch planStep.Action {
-	case rebase.RebaseActionDrop, rebase.RebaseActionPick:
-		// Nothing to do – the drop action doesn't result in a cherry pick and the pick action
-		// doesn't require any special options (i.e. no amend, no custom commit message).
+	case rebase.RebaseActionDrop, rebase.RebaseActionPick, rebase.RebaseActionEdit:
+		// Nothing to do – the drop action doesn't result in a cherry pick and the pick and edit actions
+		// don't require any special options (i.e. no amend, no custom commit message).
 
 	case rebase.RebaseActionReword:
 		options.CommitMessage = planStep.CommitMsg
@@ -906,45 +962,55 @@ func handleRebaseCherryPick(ctx *sql.Context, planStep *rebase.RebasePlanStep, o
 // squashCommitMessage looks up the commit at HEAD and the commit identified by |nextCommitHash| and squashes their two
 // commit messages together.
 func squashCommitMessage(ctx *sql.Context, nextCommitHash string) (string, error) {
-	doltSession := dsess.DSessFromSess(ctx.Session)
-	headCommit, err := doltSession.GetHeadCommit(ctx, ctx.GetCurrentDatabase())
+	headMeta, err := headCommitMeta(ctx)
 	if err != nil {
 		return "", err
 	}
-	headCommitMeta, err := headCommit.GetCommitMeta(ctx)
+	nextCommitMeta, err := lookupCommitMeta(ctx, nextCommitHash)
 	if err != nil {
 		return "", err
 	}
+	commitMessage := headMeta.Description + "\n\n" + nextCommitMeta.Description
 
+	return commitMessage, nil
+}
+
+// headCommitMeta returns the metadata of the commit at HEAD in the current session.
+func headCommitMeta(ctx *sql.Context) (*datas.CommitMeta, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
+	headCommit, err := doltSession.GetHeadCommit(ctx, ctx.GetCurrentDatabase())
+	if err != nil {
+		return nil, err
+	}
+	return headCommit.GetCommitMeta(ctx)
+}
+
+// lookupCommitMeta resolves |commitSpec| against the current branch and returns the metadata of that commit.
+func lookupCommitMeta(ctx *sql.Context, commitSpec string) (*datas.CommitMeta, error) {
+	doltSession := dsess.DSessFromSess(ctx.Session)
 	ddb, ok := doltSession.GetDoltDB(ctx, ctx.GetCurrentDatabase())
 	if !ok {
-		return "", fmt.Errorf("unable to get doltdb!")
+		return nil, fmt.Errorf("unable to get doltdb!")
 	}
-	spec, err := doltdb.NewCommitSpec(nextCommitHash)
+	spec, err := doltdb.NewCommitSpec(commitSpec)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	headRef, err := doltSession.CWBHeadRef(ctx, ctx.GetCurrentDatabase())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	optCmt, err := ddb.Resolve(ctx, spec, headRef)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	nextCommit, ok := optCmt.ToCommit()
+	commit, ok := optCmt.ToCommit()
 	if !ok {
-		return "", doltdb.ErrGhostCommitEncountered
+		return nil, doltdb.ErrGhostCommitEncountered
 	}
 
-	nextCommitMeta, err := nextCommit.GetCommitMeta(ctx)
-	if err != nil {
-		return "", err
-	}
-	commitMessage := headCommitMeta.Description + "\n\n" + nextCommitMeta.Description
-
-	return commitMessage, nil
+	return commit.GetCommitMeta(ctx)
 }
 
 // currentBranch returns the name of the currently checked out branch, or any error if one was encountered.
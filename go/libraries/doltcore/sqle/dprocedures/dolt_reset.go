@@ -134,6 +134,9 @@ func resetSoftToRef(
 	dSess *dsess.DoltSession,
 	dbName string,
 ) error {
+	if err := dsess.CheckReadAccessForCommitSpec(ctx, dbData.Ddb, dbName, firstArg); err != nil {
+		return err
+	}
 	roots, err := actions.ResetSoftToRef(ctx, dbData, firstArg)
 	if err != nil {
 		return err
@@ -215,6 +218,9 @@ func resetSoft(
 
 	// If ref is "" that means HEAD, which makes reset --soft a no-op
 	if arg != "" {
+		if err := dsess.CheckReadAccessForCommitSpec(ctx, dbData.Ddb, dbName, arg); err != nil {
+			return err
+		}
 		roots, err := actions.ResetSoftToRef(ctx, dbData, arg)
 		if err != nil {
 			return err
@@ -249,6 +255,10 @@ func resetHard(
 		arg = apr.Arg(0)
 	}
 
+	if err := dsess.CheckReadAccessForCommitSpec(ctx, dbData.Ddb, dbName, arg); err != nil {
+		return err
+	}
+
 	var newHead *doltdb.Commit
 	newHead, roots, err := actions.ResetHardTables(ctx, dbData, arg, roots)
 
@@ -94,6 +94,9 @@ func doDoltRevert(ctx *sql.Context, args []string) (int, error) {
 
 	commits := make([]*doltdb.Commit, apr.NArg())
 	for i, revisionStr := range apr.Args {
+		if err = dsess.CheckReadAccessForCommitSpec(ctx, ddb, dbName, revisionStr); err != nil {
+			return 1, err
+		}
 		commitSpec, err := doltdb.NewCommitSpec(revisionStr)
 		if err != nil {
 			return 1, err
@@ -117,7 +120,14 @@ func doDoltRevert(ctx *sql.Context, args []string) (int, error) {
 		return 1, fmt.Errorf("Could not load database %s", dbName)
 	}
 
-	workingRoot, revertMessage, err := merge.Revert(ctx, ddb, workingRoot, commits, dbState.EditOpts())
+	mainline := 0
+	if parent, ok := apr.GetInt(cli.MainlineParam); ok {
+		if parent < 1 {
+			return 1, fmt.Errorf("error: invalid parent number %d, parents are numbered starting from 1", parent)
+		}
+		mainline = parent
+	}
+	workingRoot, revertMessage, err := merge.Revert(ctx, ddb, workingRoot, commits, mainline, dbState.EditOpts())
 	if err != nil {
 		return 1, err
 	}
@@ -0,0 +1,305 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dprocedures
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions"
+	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/utils/argparser"
+	"github.com/dolthub/dolt/go/store/datas"
+)
+
+const (
+	stashPushCmd  = "push"
+	stashPopCmd   = "pop"
+	stashDropCmd  = "drop"
+	stashClearCmd = "clear"
+	stashListCmd  = "list"
+)
+
+// ErrStashInTransaction is returned when dolt_stash is used to change stashes inside an explicit transaction.
+var ErrStashInTransaction = errors.New("error: dolt_stash cannot change stashes inside an explicit transaction; " +
+	"commit or roll back the transaction first, and run dolt_stash with @@autocommit enabled")
+
+var doltStashSchema = []*sql.Column{
+	{
+		Name:     "status",
+		Type:     types.Int64,
+		Nullable: false,
+	},
+	{
+		Name:     "message",
+		Type:     types.LongText,
+		Nullable: false,
+	},
+}
+
+// doltStash is the stored procedure version for the CLI command `dolt stash` and its subcommands. The subcommand is
+// given as the first argument and defaults to push. Each row returned holds the message the CLI command would print,
+// and list returns one row for each stash entry.
+func doltStash(ctx *sql.Context, args ...string) (sql.RowIter, error) {
+	rows, err := doDoltStash(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func doDoltStash(ctx *sql.Context, args []string) ([]sql.Row, error) {
+	dbName := ctx.GetCurrentDatabase()
+	if len(dbName) == 0 {
+		return nil, fmt.Errorf("Empty database name.")
+	}
+
+	apr, err := cli.CreateStashArgParser().Parse(args)
+	if err != nil {
+		return nil, err
+	}
+
+	subcommand := stashPushCmd
+	if apr.NArg() > 0 {
+		subcommand = strings.ToLower(apr.Arg(0))
+	}
+
+	if subcommand != stashListCmd {
+		if err := branch_control.CheckAccess(ctx, branch_control.Permissions_Write); err != nil {
+			return nil, err
+		}
+	}
+
+	dSess := dsess.DSessFromSess(ctx.Session)
+	ddb, ok := dSess.GetDoltDB(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("dolt database could not be found")
+	}
+	if !ddb.Format().UsesFlatbuffers() {
+		return nil, doltdb.ErrStashNotSupportedForOldFormat
+	}
+
+	if subcommand != stashPushCmd && (apr.Contains(cli.IncludeUntrackedFlag) || apr.Contains(cli.AllFlag)) {
+		return nil, fmt.Errorf("error: --%s and --%s can only be used with %s", cli.IncludeUntrackedFlag, cli.AllFlag, stashPushCmd)
+	}
+	if subcommand != stashPushCmd && apr.Contains(cli.MessageArg) {
+		return nil, fmt.Errorf("error: --%s can only be used with %s", cli.MessageArg, stashPushCmd)
+	}
+
+	switch subcommand {
+	case stashPushCmd, stashClearCmd, stashListCmd:
+		if apr.NArg() > 1 {
+			return nil, fmt.Errorf("error: stash %s does not take a stash reference", subcommand)
+		}
+	}
+
+	if subcommand != stashListCmd {
+		// Stash entries are stored in a ref outside of the working set, and are written immediately instead of when
+		// the transaction commits, so rolling back a transaction could lose a popped stash or keep a pushed one.
+		inTransaction, err := isExplicitTransaction(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if inTransaction {
+			return nil, ErrStashInTransaction
+		}
+	}
+
+	switch subcommand {
+	case stashPushCmd:
+		return doStashPush(ctx, dSess, dbName, ddb, apr)
+	case stashPopCmd:
+		idx, err := stashIndexArg(apr)
+		if err != nil {
+			return nil, err
+		}
+		return doStashPop(ctx, dSess, dbName, ddb, idx)
+	case stashDropCmd:
+		idx, err := stashIndexArg(apr)
+		if err != nil {
+			return nil, err
+		}
+		return doStashDrop(ctx, ddb, idx)
+	case stashClearCmd:
+		err = ddb.RemoveAllStashes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return []sql.Row{{int64(0), ""}}, nil
+	case stashListCmd:
+		return doStashList(ctx, ddb)
+	default:
+		return nil, fmt.Errorf("error: invalid stash subcommand '%s', expected one of %s, %s, %s, %s or %s",
+			subcommand, stashPushCmd, stashPopCmd, stashDropCmd, stashClearCmd, stashListCmd)
+	}
+}
+
+// isExplicitTransaction returns true if the current statement runs in a transaction started with BEGIN or START
+// TRANSACTION, or with @@autocommit disabled.
+func isExplicitTransaction(ctx *sql.Context) (bool, error) {
+	if ctx.GetIgnoreAutoCommit() {
+		return true, nil
+	}
+	autocommit, err := isAutocommitEnabled(ctx)
+	if err != nil {
+		return false, err
+	}
+	return !autocommit, nil
+}
+
+// stashIndexArg returns the index of the stash entry referenced by the second argument, or of the latest entry if
+// none was given.
+func stashIndexArg(apr *argparser.ArgParseResults) (int, error) {
+	if apr.NArg() < 2 {
+		return 0, nil
+	}
+	return doltdb.ParseStashIndex(apr.Arg(1))
+}
+
+func doStashPush(ctx *sql.Context, dSess *dsess.DoltSession, dbName string, ddb *doltdb.DoltDB, apr *argparser.ArgParseResults) ([]sql.Row, error) {
+	roots, ok := dSess.GetRoots(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("Could not load database %s", dbName)
+	}
+
+	includeUntracked, all := apr.Contains(cli.IncludeUntrackedFlag), apr.Contains(cli.AllFlag)
+	hasChanges, err := actions.HasStashableChanges(ctx, roots, includeUntracked, all)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanges {
+		return []sql.Row{{int64(0), "No local changes to save"}}, nil
+	}
+
+	stashRoot, addedTblsToStage, roots, err := actions.StashChanges(ctx, roots, includeUntracked, all)
+	if err != nil {
+		return nil, err
+	}
+
+	headRef, err := dSess.CWBHeadRef(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	headCommit, err := dSess.GetHeadCommit(ctx, dbName)
+	if err != nil {
+		return nil, err
+	}
+	commitMeta, err := headCommit.GetCommitMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := datas.NewStashMeta(headRef.String(), commitMeta.Description, doltdb.FlattenTableNames(addedTblsToStage))
+	meta.Message, _ = apr.GetValue(cli.MessageArg)
+	err = ddb.AddStash(ctx, headCommit, stashRoot, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dSess.SetRoots(ctx, dbName, roots)
+	if err != nil {
+		return nil, err
+	}
+
+	stash := doltdb.Stash{BranchName: meta.BranchName, Description: meta.Description, Message: meta.Message, HeadCommit: headCommit}
+	summary, err := stash.Summary()
+	if err != nil {
+		return nil, err
+	}
+	return []sql.Row{{int64(0), "Saved working directory and index state " + summary}}, nil
+}
+
+func doStashPop(ctx *sql.Context, dSess *dsess.DoltSession, dbName string, ddb *doltdb.DoltDB, idx int) ([]sql.Row, error) {
+	roots, ok := dSess.GetRoots(ctx, dbName)
+	if !ok {
+		return nil, fmt.Errorf("Could not load database %s", dbName)
+	}
+	dbState, ok, err := dSess.LookupDbState(ctx, dbName)
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, fmt.Errorf("Could not load database %s", dbName)
+	}
+
+	stashRoot, headCommit, meta, err := ddb.GetStashRootAndHeadCommitAtIdx(ctx, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	mergedRoot, tablesWithConflict, err := merge.ApplyStash(ctx, roots.Working, stashRoot, headCommit, dbState.EditOpts())
+	if err != nil {
+		return nil, err
+	}
+	if len(tablesWithConflict) > 0 {
+		tblNames := strings.Join(doltdb.FlattenTableNames(tablesWithConflict), "', '")
+		return nil, fmt.Errorf("error: Your local changes to the following tables would be overwritten by applying stash %d: {'%s'}. "+
+			"Please commit your changes or stash them before you merge. The stash entry is kept in case you need it again.", idx, tblNames)
+	}
+
+	// added tables need to be staged
+	// since these tables are coming from a stash, don't filter for ignored table names.
+	roots.Working = mergedRoot
+	roots, err = actions.StageTables(ctx, roots, doltdb.ToTableNames(meta.TablesToStage, doltdb.DefaultSchemaName), false)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dSess.SetRoots(ctx, dbName, roots)
+	if err != nil {
+		return nil, err
+	}
+
+	return doStashDrop(ctx, ddb, idx)
+}
+
+func doStashDrop(ctx *sql.Context, ddb *doltdb.DoltDB, idx int) ([]sql.Row, error) {
+	stashHash, err := ddb.GetStashHashAtIdx(ctx, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ddb.RemoveStashAtIdx(ctx, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []sql.Row{{int64(0), fmt.Sprintf("Dropped refs/stash@{%v} (%s)", idx, stashHash.String())}}, nil
+}
+
+func doStashList(ctx *sql.Context, ddb *doltdb.DoltDB) ([]sql.Row, error) {
+	stashes, err := ddb.GetStashes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]sql.Row, len(stashes))
+	for i, stash := range stashes {
+		summary, err := stash.Summary()
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = sql.Row{int64(0), fmt.Sprintf("%s: %s", stash.Name, summary)}
+	}
+
+	return rows, nil
+}
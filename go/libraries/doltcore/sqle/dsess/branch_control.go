@@ -16,8 +16,14 @@ package dsess
 
 import (
 	"context"
+	"errors"
+	"strings"
+
+	"github.com/dolthub/go-mysql-server/sql"
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 )
 
 // CheckAccessForDb checks whether the current user has the given permissions for the given database.
@@ -57,3 +63,144 @@ func CheckAccessForDb(ctx context.Context, db SqlDatabase, flags branch_control.
 	}
 	return branch_control.ErrIncorrectPermissions.New(user, host, branch)
 }
+
+// BranchReadsEnforced returns whether the dolt_enforce_branch_control_reads system variable is enabled, meaning that
+// reading from a branch requires a matching entry in the dolt_branch_control table.
+func BranchReadsEnforced() bool {
+	_, enforced, ok := sql.SystemVariables.GetGlobal(EnforceBranchControlReads)
+	return ok && enforced == SysVarTrue
+}
+
+// CheckReadAccess checks whether the current user may read from the given branch of the given database. This is a
+// no-op unless reads are enforced.
+func CheckReadAccess(ctx context.Context, dbName string, branch string) error {
+	if !BranchReadsEnforced() {
+		return nil
+	}
+	dbName, _ = SplitRevisionDbName(dbName)
+	return branch_control.CanReadBranch(ctx, dbName, branch)
+}
+
+// CheckReadAccessForDb checks whether the current user may read from the given database. Databases pinned to a branch
+// require that branch to be readable, while databases pinned to a tag or commit require that commit to be reachable
+// from a readable branch.
+func CheckReadAccessForDb(ctx context.Context, db SqlDatabase) error {
+	switch db.RevisionType() {
+	case RevisionTypeBranch:
+		dbName, branch := SplitRevisionDbName(db.RevisionQualifiedName())
+		return CheckReadAccess(ctx, dbName, branch)
+	case RevisionTypeTag, RevisionTypeCommit:
+		if !BranchReadsEnforced() {
+			return nil
+		}
+		ddb := db.DbData().Ddb
+		cs, err := doltdb.NewCommitSpec(db.Revision())
+		if err != nil {
+			return err
+		}
+		optCmt, err := ddb.Resolve(ctx, cs, nil)
+		if err != nil {
+			return err
+		}
+		cm, ok := optCmt.ToCommit()
+		if !ok {
+			return doltdb.ErrGhostCommitEncountered
+		}
+		return CheckReadAccessForCommit(ctx, ddb, db.Name(), cm)
+	default:
+		return nil
+	}
+}
+
+// CheckReadAccessForCommitSpec checks whether the current user may read the commit named by the given commit spec. When
+// the spec names a local branch, that branch must be readable. Any other commit, whether named by a hash, tag, or
+// remote ref, must be reachable from a readable branch. HEAD is resolved against a database that has already been
+// checked, so it is always allowed.
+func CheckReadAccessForCommitSpec(ctx context.Context, ddb *doltdb.DoltDB, dbName string, spec string) error {
+	if !BranchReadsEnforced() {
+		return nil
+	}
+	name, _, err := doltdb.SplitAncestorSpec(strings.TrimSpace(spec))
+	if err != nil {
+		// Let the commit spec parsing report the error
+		return nil
+	}
+	if strings.EqualFold(name, "HEAD") {
+		return nil
+	}
+	branchName := strings.TrimPrefix(strings.TrimPrefix(name, "refs/"), "heads/")
+	if ref.IsValidBranchName(branchName) {
+		branch, ok, err := ddb.HasBranch(ctx, branchName)
+		if err != nil {
+			return err
+		} else if ok {
+			return CheckReadAccess(ctx, dbName, branch)
+		}
+	}
+	cs, err := doltdb.NewCommitSpec(spec)
+	if err != nil {
+		return nil
+	}
+	optCmt, err := ddb.Resolve(ctx, cs, nil)
+	if err != nil {
+		// Let the commit resolution report the error
+		return nil
+	}
+	cm, ok := optCmt.ToCommit()
+	if !ok {
+		return nil
+	}
+	return CheckReadAccessForCommit(ctx, ddb, dbName, cm)
+}
+
+// CheckReadAccessForCommit checks whether the current user may read the given commit, which requires that the commit
+// be reachable from a local branch that the user may read. This is a no-op unless reads are enforced.
+func CheckReadAccessForCommit(ctx context.Context, ddb *doltdb.DoltDB, dbName string, cm *doltdb.Commit) error {
+	if !BranchReadsEnforced() {
+		return nil
+	}
+	dbName, _ = SplitRevisionDbName(dbName)
+	cmHash, err := cm.HashOf()
+	if err != nil {
+		return err
+	}
+	branches, err := ddb.GetBranchesWithHashes(ctx)
+	if err != nil {
+		return err
+	}
+
+	var readable []ref.DoltRef
+	for _, branch := range branches {
+		err = branch_control.CanReadBranch(ctx, dbName, branch.Ref.GetPath())
+		if branch_control.ErrCannotReadBranch.Is(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if branch.Hash == cmHash {
+			return nil
+		}
+		readable = append(readable, branch.Ref)
+	}
+	for _, branch := range readable {
+		head, err := ddb.ResolveCommitRef(ctx, branch)
+		if err != nil {
+			return err
+		}
+		optAnc, err := doltdb.GetCommitAncestor(ctx, cm, head)
+		if errors.Is(err, doltdb.ErrNoCommonAncestor) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if optAnc.Addr == cmHash {
+			return nil
+		}
+	}
+
+	user, host := "", ""
+	if branchAwareSession := branch_control.GetBranchAwareSession(ctx); branchAwareSession != nil {
+		user, host = branchAwareSession.GetUser(), branchAwareSession.GetHost()
+	}
+	return branch_control.ErrCannotReadCommit.New(user, host, cmHash.String())
+}
@@ -0,0 +1,146 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsess
+
+import (
+	"fmt"
+	"io"
+
+	gms "github.com/dolthub/go-mysql-server"
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/vitess/go/vt/sqlparser"
+	goerrors "gopkg.in/src-d/go-errors.v1"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+// ErrCommitCheckFailed is returned when a query in the dolt_commit_checks table returns rows for the root being
+// committed.
+var ErrCommitCheckFailed = goerrors.NewKind("commit check '%s' failed: query `%s` returned row %s")
+
+// ErrCommitCheckNotQuery is returned when a check in the dolt_commit_checks table is not a SELECT statement.
+var ErrCommitCheckNotQuery = goerrors.NewKind("commit check '%s' must be a SELECT statement")
+
+// runCommitChecks runs the checks in the dolt_commit_checks tables of |head|, the root of the branch's current head
+// commit, and of |root| against |root|, which is about to be committed to the branch of |branchState|, and returns an
+// error describing the first check that returns any rows. Checks are read from |head| as well, so that a commit can't
+// skip a check by deleting or changing it, and from |root|, so that a check applies to the commit that adds it.
+//
+// The queries run in a separate session, so that they see |root| as the working root of the branch without changing
+// the state of this session. That session has the client and branch permissions of this session, and its queries
+// run on this session's query engine, so a check can only read the data the committing user can read. Checks must
+// be SELECT statements, so they can't modify any data.
+func (d *DoltSession) runCommitChecks(ctx *sql.Context, branchState *branchState, head, root doltdb.RootValue) error {
+	checks, err := commitChecksToRun(ctx, head, root)
+	if err != nil || len(checks) == 0 {
+		return err
+	}
+
+	dbName := RevisionDbName(branchState.dbState.dbName, branchState.head)
+	checkSess := DefaultSession(d.provider, d.writeSessProv)
+	checkSess.SetClient(ctx.Session.Client())
+	checkSess.branchController = d.branchController
+	// The transaction of the check session must never be committed, since it would write |root| to the working set
+	checkSess.SetIgnoreAutoCommit(true)
+	checkCtx := sql.NewContext(ctx, sql.WithSession(checkSess))
+	checkCtx.SetCurrentDatabase(dbName)
+
+	if _, err = checkSess.StartTransaction(checkCtx, sql.ReadOnly); err != nil {
+		return err
+	}
+	if err = checkSess.SetWorkingRoot(checkCtx, dbName, root); err != nil {
+		return err
+	}
+
+	engine := d.queryEngine
+	if engine == nil {
+		// Sessions that aren't created for a query engine have no users, and so no privileges to check
+		engine = gms.NewDefault(d.provider)
+		defer engine.Close()
+	}
+
+	for _, check := range checks {
+		if !isSelectStatement(check.Query) {
+			return ErrCommitCheckNotQuery.New(check.Name)
+		}
+		rows, err := queryRows(checkCtx, engine, check.Query, 1)
+		if err != nil {
+			return fmt.Errorf("commit check '%s' could not be run: %w", check.Name, err)
+		}
+		if len(rows) > 0 {
+			return ErrCommitCheckFailed.New(check.Name, check.Query, sql.FormatRow(rows[0]))
+		}
+	}
+
+	return nil
+}
+
+// commitChecksToRun returns the checks in the dolt_commit_checks table of |head|, followed by the checks in the table
+// of |root| that aren't identical to one of them.
+func commitChecksToRun(ctx *sql.Context, head, root doltdb.RootValue) ([]doltdb.CommitCheck, error) {
+	checks, err := doltdb.GetCommitChecks(ctx, head)
+	if err != nil {
+		return nil, err
+	}
+	rootChecks, err := doltdb.GetCommitChecks(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[doltdb.CommitCheck]struct{}, len(checks))
+	for _, check := range checks {
+		seen[check] = struct{}{}
+	}
+	for _, check := range rootChecks {
+		if _, ok := seen[check]; !ok {
+			checks = append(checks, check)
+		}
+	}
+	return checks, nil
+}
+
+// isSelectStatement returns whether |query| is a single SELECT statement which doesn't write its results anywhere.
+func isSelectStatement(query string) bool {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return false
+	}
+	selectStmt, ok := stmt.(sqlparser.SelectStatement)
+	return ok && selectStmt.GetInto() == nil
+}
+
+// queryRows runs |query| and returns its rows, stopping after the first |limit| rows if |limit| is positive.
+func queryRows(ctx *sql.Context, engine *gms.Engine, query string, limit int) (rows []sql.Row, err error) {
+	_, iter, _, err := engine.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := iter.Close(ctx); err == nil {
+			err = cerr
+		}
+	}()
+
+	for limit <= 0 || len(rows) < limit {
+		row, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
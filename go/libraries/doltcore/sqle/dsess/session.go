@@ -23,6 +23,7 @@ import (
 	"sync"
 	"time"
 
+	gms "github.com/dolthub/go-mysql-server"
 	"github.com/dolthub/go-mysql-server/sql"
 	sqltypes "github.com/dolthub/go-mysql-server/sql/types"
 	"github.com/shopspring/decimal"
@@ -60,6 +61,7 @@ type DoltSession struct {
 	globalsConf      config.ReadWriteConfig
 	branchController *branch_control.Controller
 	statsProv        sql.StatsProvider
+	queryEngine      *gms.Engine
 	mu               *sync.Mutex
 	fs               filesys.Filesys
 	writeSessProv    WriteSessFunc
@@ -129,6 +131,12 @@ func (d *DoltSession) Provider() DoltDatabaseProvider {
 	return d.provider
 }
 
+// SetQueryEngine sets the engine that runs this session's queries. The queries in dolt_commit_checks tables are run
+// on this engine as well, so that they are subject to the same privilege checks as the session's other queries.
+func (d *DoltSession) SetQueryEngine(engine *gms.Engine) {
+	d.queryEngine = engine
+}
+
 // StatsProvider returns the sql.StatsProvider for this session.
 func (d *DoltSession) StatsProvider() sql.StatsProvider {
 	return d.statsProv
@@ -704,6 +712,8 @@ func (d *DoltSession) NewPendingCommit(
 func (d *DoltSession) newPendingCommit(ctx *sql.Context, branchState *branchState, roots doltdb.Roots, props actions.CommitStagedProps) (*doltdb.PendingCommit, error) {
 	headCommit := branchState.headCommit
 	headHash, _ := headCommit.HashOf()
+	// Commit checks are read from the root of the head before amending, which moves the head backwards
+	headRoot := roots.Head
 
 	if branchState.WorkingSet() == nil {
 		return nil, doltdb.ErrOperationNotSupportedInDetachedHead
@@ -780,6 +790,19 @@ func (d *DoltSession) newPendingCommit(ctx *sql.Context, branchState *branchStat
 		}
 	}
 
+	if pendingCommit != nil {
+		err = d.runCommitChecks(ctx, branchState, headRoot, pendingCommit.Roots.Staged)
+		if err != nil {
+			if props.Amend {
+				_, rerr := actions.ResetSoftToRef(ctx, branchState.dbData, headHash.String())
+				if rerr != nil {
+					return nil, rerr
+				}
+			}
+			return nil, err
+		}
+	}
+
 	return pendingCommit, nil
 }
 
@@ -933,6 +956,10 @@ func (d *DoltSession) ResolveRootForRef(ctx *sql.Context, dbName, refStr string)
 		return nil, nil, "", sql.ErrDatabaseNotFound.New(dbName)
 	}
 
+	if err = CheckReadAccessForCommitSpec(ctx, dbData.Ddb, dbName, refStr); err != nil {
+		return nil, nil, "", err
+	}
+
 	headRef, err := d.CWBHeadRef(ctx, dbName)
 	if err == doltdb.ErrOperationNotSupportedInDetachedHead {
 		// leave head ref nil, we may not need it (commit hash)
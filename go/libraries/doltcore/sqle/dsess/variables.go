@@ -54,6 +54,10 @@ const (
 	ShowBranchDatabases                  = "dolt_show_branch_databases"
 	DoltLogLevel                         = "dolt_log_level"
 	ShowSystemTables                     = "dolt_show_system_tables"
+	EnforceBranchControlReads            = "dolt_enforce_branch_control_reads"
+	DoltBinlogBranch                     = "dolt_binlog_branch"
+	DoltBinlogReplicaBranches            = "dolt_binlog_replica_branches"
+	DoltBundleDir                        = "dolt_bundle_dir"
 
 	DoltClusterRoleVariable         = "dolt_cluster_role"
 	DoltClusterRoleEpochVariable    = "dolt_cluster_role_epoch"
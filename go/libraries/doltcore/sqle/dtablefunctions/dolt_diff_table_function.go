@@ -261,6 +261,12 @@ func resolveCommitStrings(ctx *sql.Context, fromRef, toRef, dotRef interface{},
 				return "", "", err
 			}
 
+			for _, r := range refs {
+				if err = dsess.CheckReadAccessForCommitSpec(ctx, db.DbData().Ddb, db.Name(), r); err != nil {
+					return "", "", err
+				}
+			}
+
 			rightCm, err := resolveCommit(ctx, db.DbData().Ddb, headRef, refs[0])
 			if err != nil {
 				return "", "", err
@@ -299,6 +305,10 @@ func resolveCommitStrings(ctx *sql.Context, fromRef, toRef, dotRef interface{},
 // loadCommitStrings gets the to and from commit strings, using the common
 // ancestor as the from commit string for three dot diff
 func loadCommitStrings(ctx *sql.Context, fromRef, toRef, dotRef interface{}, db dsess.SqlDatabase) (string, string, error) {
+	if err := dsess.CheckReadAccessForDb(ctx, db); err != nil {
+		return "", "", err
+	}
+
 	fromStr, toStr, err := resolveCommitStrings(ctx, fromRef, toRef, dotRef, db)
 	if err != nil {
 		return "", "", err
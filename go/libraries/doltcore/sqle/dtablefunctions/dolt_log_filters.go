@@ -0,0 +1,411 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtablefunctions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/expression"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/dconfig"
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb/durable"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/expranalysis"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/prolly"
+	"github.com/dolthub/dolt/go/store/prolly/tree"
+	"github.com/dolthub/dolt/go/store/types"
+	"github.com/dolthub/dolt/go/store/val"
+)
+
+// errRowFilterMatched is used to stop diffing a table as soon as a changed row matches the row filter.
+var errRowFilterMatched = errors.New("row filter matched")
+
+// logCommitFilter limits the log to commits whose metadata matches the --author, --grep, --since and --until options.
+type logCommitFilter struct {
+	author *regexp.Regexp
+	grep   *regexp.Regexp
+	since  time.Time
+	until  time.Time
+}
+
+// matches returns whether the commit metadata given satisfies every filter. Dates are compared against the commit
+// date rather than the author date, like git does.
+func (f logCommitFilter) matches(meta *datas.CommitMeta) bool {
+	if f.author != nil && !f.author.MatchString(fmt.Sprintf("%s <%s>", meta.Name, meta.Email)) {
+		return false
+	}
+	if f.grep != nil && !f.grep.MatchString(meta.Description) {
+		return false
+	}
+	committed := meta.CommitterTime()
+	if !f.since.IsZero() && committed.Before(f.since) {
+		return false
+	}
+	if !f.until.IsZero() && committed.After(f.until) {
+		return false
+	}
+	return true
+}
+
+// parseLogDate parses a --since or --until date. A date without a time refers to the start of the day for --since,
+// and to the end of the day for --until.
+func parseLogDate(dateStr string, endOfDay bool) (time.Time, error) {
+	t, err := dconfig.ParseDate(dateStr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfDay && !strings.Contains(dateStr, "T") {
+		t = t.Add(24*time.Hour - time.Millisecond)
+	}
+	return t, nil
+}
+
+// findRenamedFrom returns the name |tableName| had in |parent| if the table was renamed between |parent| and |child|.
+func findRenamedFrom(ctx context.Context, child, parent doltdb.RootValue, tableName string) (string, bool, error) {
+	_, inChild, err := child.GetTable(ctx, doltdb.TableName{Name: tableName})
+	if err != nil || !inChild {
+		return "", false, err
+	}
+	_, inParent, err := parent.GetTable(ctx, doltdb.TableName{Name: tableName})
+	if err != nil || inParent {
+		return "", false, err
+	}
+
+	deltas, err := diff.GetTableDeltas(ctx, parent, child)
+	if err != nil {
+		return "", false, err
+	}
+	for _, delta := range deltas {
+		if delta.ToName.Name == tableName && delta.IsRename() {
+			return delta.FromName.Name, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+// logRowFilter limits the log to commits that changed rows of a table matching a WHERE clause given by --where.
+// Only the key ranges of the table's primary key that the clause restricts are diffed.
+type logRowFilter struct {
+	where string
+	// resolved caches the filter expression for each table schema it has been resolved against
+	resolved map[resolvedFilterKey]sql.Expression
+}
+
+type resolvedFilterKey struct {
+	tableName string
+	schema    string
+}
+
+// tableRows is one side of a diff between two versions of a table.
+type tableRows struct {
+	tbl  *doltdb.Table
+	sch  schema.Schema
+	rows prolly.Map
+	expr sql.Expression
+}
+
+func newLogRowFilter(where string) *logRowFilter {
+	if where == "" {
+		return nil
+	}
+	return &logRowFilter{
+		where:    where,
+		resolved: make(map[resolvedFilterKey]sql.Expression),
+	}
+}
+
+// didRowsChange returns whether any row matching the filter was added, removed or modified in the table named
+// |tableName| between |parent| and |child|.
+func (f *logRowFilter) didRowsChange(ctx *sql.Context, child, parent doltdb.RootValue, tableName string) (bool, error) {
+	to, err := f.loadTableRows(ctx, child, tableName)
+	if err != nil {
+		return false, err
+	}
+	from, err := f.loadTableRows(ctx, parent, tableName)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case to == nil && from == nil:
+		return false, nil
+	case to != nil && from != nil:
+		toHash, err := to.tbl.HashOf()
+		if err != nil {
+			return false, err
+		}
+		fromHash, err := from.tbl.HashOf()
+		if err != nil {
+			return false, err
+		}
+		if toHash == fromHash {
+			return false, nil
+		}
+		if !to.rows.KeyDesc().Equals(from.rows.KeyDesc()) {
+			// every row has a new key when the primary key changes
+			return true, nil
+		}
+	case to == nil:
+		if to, err = emptyTableRows(ctx, from); err != nil {
+			return false, err
+		}
+	case from == nil:
+		if from, err = emptyTableRows(ctx, to); err != nil {
+			return false, err
+		}
+	}
+
+	rangeSide := to
+	if to.tbl == nil {
+		rangeSide = from
+	}
+	ranges, err := f.keyRanges(ctx, tableName, rangeSide)
+	if err != nil {
+		return false, err
+	}
+
+	cb := func(_ context.Context, d tree.Diff) error {
+		for _, side := range []struct {
+			rows  *tableRows
+			value val.Tuple
+		}{{from, val.Tuple(d.From)}, {to, val.Tuple(d.To)}} {
+			if side.value == nil {
+				continue
+			}
+			row, err := index.BuildRow(ctx, val.Tuple(d.Key), side.value, side.rows.sch, side.rows.rows.NodeStore())
+			if err != nil {
+				return err
+			}
+			res, err := sql.EvaluateCondition(ctx, side.rows.expr, row)
+			if err != nil {
+				return err
+			}
+			if sql.IsTrue(res) {
+				return errRowFilterMatched
+			}
+		}
+		return nil
+	}
+
+	if ranges == nil {
+		err = prolly.DiffMaps(ctx, from.rows, to.rows, false, cb)
+		return diffMatched(err)
+	}
+	for _, rng := range ranges {
+		matched, err := diffMatched(prolly.RangeDiffMaps(ctx, from.rows, to.rows, rng, cb))
+		if err != nil || matched {
+			return matched, err
+		}
+	}
+	return false, nil
+}
+
+// diffMatched translates the error returned from a diff of table rows into whether the row filter matched.
+func diffMatched(err error) (bool, error) {
+	if errors.Is(err, errRowFilterMatched) {
+		return true, nil
+	}
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	return false, nil
+}
+
+// loadTableRows returns the rows of the table named |tableName| in |root|, or nil if the table does not exist.
+func (f *logRowFilter) loadTableRows(ctx *sql.Context, root doltdb.RootValue, tableName string) (*tableRows, error) {
+	tbl, ok, err := root.GetTable(ctx, doltdb.TableName{Name: tableName})
+	if err != nil || !ok {
+		return nil, err
+	}
+	if !types.IsFormat_DOLT(tbl.Format()) {
+		return nil, fmt.Errorf("--where is only supported for databases in the %s format", types.Format_DOLT.VersionString())
+	}
+
+	sch, err := tbl.GetSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idx, err := tbl.GetRowData(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schHash, err := tbl.GetSchemaHash(ctx)
+	if err != nil {
+		return nil, err
+	}
+	key := resolvedFilterKey{tableName: tableName, schema: schHash.String()}
+	expr, ok := f.resolved[key]
+	if !ok {
+		expr, err = expranalysis.ResolveFilterExpression(ctx, tableName, sch, f.where)
+		if err != nil {
+			return nil, err
+		}
+		f.resolved[key] = expr
+	}
+
+	return &tableRows{
+		tbl:  tbl,
+		sch:  sch,
+		rows: durable.ProllyMapFromIndex(idx),
+		expr: expr,
+	}, nil
+}
+
+// emptyTableRows returns a side of a diff with no rows, for tables that were created or dropped.
+func emptyTableRows(ctx context.Context, other *tableRows) (*tableRows, error) {
+	kd, vd := other.rows.Descriptors()
+	empty, err := prolly.NewMapFromTuples(ctx, other.rows.NodeStore(), kd, vd)
+	if err != nil {
+		return nil, err
+	}
+	return &tableRows{
+		sch:  other.sch,
+		rows: empty,
+		expr: other.expr,
+	}, nil
+}
+
+// keyRanges returns the ranges of the table's primary key that the filter restricts rows to, or nil if the filter
+// does not restrict the primary key and the whole table must be diffed.
+func (f *logRowFilter) keyRanges(ctx *sql.Context, tableName string, rows *tableRows) ([]prolly.Range, error) {
+	if schema.IsKeyless(rows.sch) {
+		return nil, nil
+	}
+
+	indexes, err := index.DoltIndexesFromTable(ctx, "", tableName, rows.tbl)
+	if err != nil {
+		return nil, err
+	}
+	pkIdx := indexes[0]
+
+	pkCols := make(map[string]string)
+	for _, colExpr := range pkIdx.Expressions() {
+		colExpr = strings.ToLower(colExpr)
+		pkCols[strings.TrimPrefix(colExpr, strings.ToLower(tableName)+".")] = colExpr
+	}
+
+	builder := sql.NewMySQLIndexBuilder(pkIdx)
+	if !addKeyRestrictions(ctx, builder, pkCols, rows.expr) {
+		return nil, nil
+	}
+	lookup, err := builder.Build(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if lookup.Index == nil {
+		return []prolly.Range{}, nil
+	}
+	return index.ProllyRangesForIndex(ctx, lookup.Index, lookup.Ranges)
+}
+
+// addKeyRestrictions adds the comparisons of primary key columns to literals that every row matching |expr| must
+// satisfy to |builder|. It returns whether any restriction was added.
+func addKeyRestrictions(ctx *sql.Context, builder *sql.MySQLIndexBuilder, pkCols map[string]string, expr sql.Expression) bool {
+	switch e := expr.(type) {
+	case *expression.And:
+		left := addKeyRestrictions(ctx, builder, pkCols, e.LeftChild)
+		right := addKeyRestrictions(ctx, builder, pkCols, e.RightChild)
+		return left || right
+	case *expression.InTuple:
+		colExpr, ok := keyColumnExpr(pkCols, e.Left())
+		if !ok {
+			return false
+		}
+		tup, ok := e.Right().(expression.Tuple)
+		if !ok {
+			return false
+		}
+		keys := make([]interface{}, len(tup))
+		for i, el := range tup {
+			lit, ok := el.(*expression.Literal)
+			if !ok || lit.Value() == nil {
+				return false
+			}
+			keys[i] = lit.Value()
+		}
+		builder.Equals(ctx, colExpr, keys...)
+		return true
+	case expression.Comparer:
+		left, right := e.Left(), e.Right()
+		colExpr, ok := keyColumnExpr(pkCols, left)
+		lit, isLit := right.(*expression.Literal)
+		swapped := false
+		if !ok {
+			colExpr, ok = keyColumnExpr(pkCols, right)
+			lit, isLit = left.(*expression.Literal)
+			swapped = true
+		}
+		if !ok || !isLit || lit.Value() == nil {
+			return false
+		}
+		key := lit.Value()
+
+		switch e.(type) {
+		case *expression.Equals:
+			builder.Equals(ctx, colExpr, key)
+		case *expression.GreaterThan:
+			if swapped {
+				builder.LessThan(ctx, colExpr, key)
+			} else {
+				builder.GreaterThan(ctx, colExpr, key)
+			}
+		case *expression.GreaterThanOrEqual:
+			if swapped {
+				builder.LessOrEqual(ctx, colExpr, key)
+			} else {
+				builder.GreaterOrEqual(ctx, colExpr, key)
+			}
+		case *expression.LessThan:
+			if swapped {
+				builder.GreaterThan(ctx, colExpr, key)
+			} else {
+				builder.LessThan(ctx, colExpr, key)
+			}
+		case *expression.LessThanOrEqual:
+			if swapped {
+				builder.GreaterOrEqual(ctx, colExpr, key)
+			} else {
+				builder.LessOrEqual(ctx, colExpr, key)
+			}
+		default:
+			return false
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// keyColumnExpr returns the index column expression for |expr| if it is a primary key column.
+func keyColumnExpr(pkCols map[string]string, expr sql.Expression) (string, bool) {
+	gf, ok := expr.(*expression.GetField)
+	if !ok {
+		return "", false
+	}
+	colExpr, ok := pkCols[strings.ToLower(gf.Name())]
+	return colExpr, ok
+}
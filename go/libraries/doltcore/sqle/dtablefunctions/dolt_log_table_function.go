@@ -16,19 +16,23 @@ package dtablefunctions
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 	"gopkg.in/src-d/go-errors.v1"
 
 	"github.com/dolthub/dolt/go/cmd/dolt/cli"
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/env/actions/commitwalk"
 	"github.com/dolthub/dolt/go/libraries/doltcore/merge"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/utils/gpg"
+	"github.com/dolthub/dolt/go/store/datas"
 	"github.com/dolthub/dolt/go/store/hash"
 )
 
@@ -51,6 +55,10 @@ type LogTableFunction struct {
 	showSignature bool
 	decoration    string
 
+	commitFilter logCommitFilter
+	follow       bool
+	where        string
+
 	database sql.Database
 }
 
@@ -62,6 +70,16 @@ var logTableSchema = sql.Schema{
 	&sql.Column{Name: "message", Type: types.Text},
 }
 
+// committerSchema holds the columns describing the committer and trailers of each commit. They follow all other
+// columns, including the optional parents, refs and signature columns, so that adding them didn't move any of the
+// columns that existing queries may read by position.
+var committerSchema = sql.Schema{
+	&sql.Column{Name: "committer_name", Type: types.Text},
+	&sql.Column{Name: "committer_email", Type: types.Text},
+	&sql.Column{Name: "committer_date", Type: types.Datetime},
+	&sql.Column{Name: "trailers", Type: types.Text},
+}
+
 // NewInstance creates a new instance of TableFunction interface
 func (ltf *LogTableFunction) NewInstance(ctx *sql.Context, db sql.Database, expressions []sql.Expression) (sql.Node, error) {
 	newInstance := &LogTableFunction{
@@ -157,12 +175,36 @@ func (ltf *LogTableFunction) getOptionsString() string {
 		options = append(options, "--tables", strings.Join(ltf.tableNames, ","))
 	}
 
+	if ltf.commitFilter.author != nil {
+		options = append(options, fmt.Sprintf("--%s %s", cli.AuthorParam, ltf.commitFilter.author.String()))
+	}
+
+	if ltf.commitFilter.grep != nil {
+		options = append(options, fmt.Sprintf("--%s %s", cli.GrepParam, ltf.commitFilter.grep.String()))
+	}
+
+	if !ltf.commitFilter.since.IsZero() {
+		options = append(options, fmt.Sprintf("--%s %s", cli.SinceParam, ltf.commitFilter.since.Format(time.RFC3339)))
+	}
+
+	if !ltf.commitFilter.until.IsZero() {
+		options = append(options, fmt.Sprintf("--%s %s", cli.UntilParam, ltf.commitFilter.until.Format(time.RFC3339)))
+	}
+
+	if ltf.follow {
+		options = append(options, fmt.Sprintf("--%s", cli.FollowFlag))
+	}
+
+	if len(ltf.where) > 0 {
+		options = append(options, fmt.Sprintf("--%s %s", cli.WhereParam, ltf.where))
+	}
+
 	return strings.Join(options, ", ")
 }
 
 // Schema implements the sql.Node interface.
 func (ltf *LogTableFunction) Schema() sql.Schema {
-	logSchema := logTableSchema
+	logSchema := append(sql.Schema{}, logTableSchema...)
 
 	if ltf.showParents {
 		logSchema = append(logSchema, &sql.Column{Name: "parents", Type: types.Text})
@@ -174,7 +216,7 @@ func (ltf *LogTableFunction) Schema() sql.Schema {
 		logSchema = append(logSchema, &sql.Column{Name: "signature", Type: types.Text})
 	}
 
-	return logSchema
+	return append(logSchema, committerSchema...)
 }
 
 // Children implements the sql.Node interface.
@@ -275,6 +317,44 @@ func (ltf *LogTableFunction) addOptions(expression []sql.Expression) error {
 	}
 	ltf.decoration = decorateOption
 
+	if author, ok := apr.GetValue(cli.AuthorParam); ok {
+		ltf.commitFilter.author, err = regexp.Compile(author)
+		if err != nil {
+			return ltf.invalidArgDetailsErr(fmt.Sprintf("invalid --%s pattern: %s", cli.AuthorParam, err.Error()))
+		}
+	}
+
+	if grep, ok := apr.GetValue(cli.GrepParam); ok {
+		ltf.commitFilter.grep, err = regexp.Compile(grep)
+		if err != nil {
+			return ltf.invalidArgDetailsErr(fmt.Sprintf("invalid --%s pattern: %s", cli.GrepParam, err.Error()))
+		}
+	}
+
+	if since, ok := apr.GetValue(cli.SinceParam); ok {
+		ltf.commitFilter.since, err = parseLogDate(since, false)
+		if err != nil {
+			return ltf.invalidArgDetailsErr(fmt.Sprintf("invalid --%s date: %s", cli.SinceParam, since))
+		}
+	}
+
+	if until, ok := apr.GetValue(cli.UntilParam); ok {
+		ltf.commitFilter.until, err = parseLogDate(until, true)
+		if err != nil {
+			return ltf.invalidArgDetailsErr(fmt.Sprintf("invalid --%s date: %s", cli.UntilParam, until))
+		}
+	}
+
+	ltf.follow = apr.Contains(cli.FollowFlag)
+	if ltf.follow && len(ltf.tableNames) != 1 {
+		return ltf.invalidArgDetailsErr(fmt.Sprintf("--%s requires exactly one table", cli.FollowFlag))
+	}
+
+	ltf.where = apr.GetValueOrDefault(cli.WhereParam, "")
+	if apr.Contains(cli.WhereParam) && len(ltf.tableNames) != 1 {
+		return ltf.invalidArgDetailsErr(fmt.Sprintf("--%s requires exactly one table", cli.WhereParam))
+	}
+
 	return nil
 }
 
@@ -410,6 +490,9 @@ func (ltf *LogTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 	if !ok {
 		return nil, fmt.Errorf("unexpected database type: %T", ltf.database)
 	}
+	if err = dsess.CheckReadAccessForDb(ctx, sqledb); err != nil {
+		return nil, err
+	}
 
 	sess := dsess.DSessFromSess(ctx.Session)
 	var commit *doltdb.Commit
@@ -423,7 +506,7 @@ func (ltf *LogTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 		return commit.NumParents() >= ltf.minParents, nil
 	}
 
-	cHashToRefs, err := getCommitHashToRefs(ctx, sqledb.DbData().Ddb, ltf.decoration)
+	cHashToRefs, err := getCommitHashToRefs(ctx, sqledb.DbData().Ddb, sqledb.Name(), ltf.decoration)
 	if err != nil {
 		return nil, err
 	}
@@ -449,6 +532,9 @@ func (ltf *LogTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 		if err != nil {
 			return nil, err
 		}
+		if err = dsess.CheckReadAccessForCommitSpec(ctx, sqledb.DbData().Ddb, sqledb.Name(), revisionStr); err != nil {
+			return nil, err
+		}
 
 		optCmt, err := sqledb.DbData().Ddb.Resolve(ctx, cs, headRef)
 		if err != nil {
@@ -468,6 +554,9 @@ func (ltf *LogTableFunction) RowIter(ctx *sql.Context, row sql.Row) (sql.RowIter
 		if err != nil {
 			return nil, err
 		}
+		if err = dsess.CheckReadAccessForCommitSpec(ctx, sqledb.DbData().Ddb, sqledb.Name(), notRevisionStr); err != nil {
+			return nil, err
+		}
 
 		optCmt, err := sqledb.DbData().Ddb.Resolve(ctx, cs, headRef)
 		if err != nil {
@@ -552,15 +641,20 @@ func (ltf *LogTableFunction) evaluateArguments() (revisionValStrs []string, notR
 	return revisionValStrs, notRevisionValStrs, false, nil
 }
 
-func getCommitHashToRefs(ctx *sql.Context, ddb *doltdb.DoltDB, decoration string) (map[hash.Hash][]string, error) {
+func getCommitHashToRefs(ctx *sql.Context, ddb *doltdb.DoltDB, dbName string, decoration string) (map[hash.Hash][]string, error) {
 	cHashToRefs := map[hash.Hash][]string{}
 
-	// Get all branches
+	// Get all branches, skipping those that the user may not read
 	branches, err := ddb.GetBranchesWithHashes(ctx)
 	if err != nil {
 		return nil, err
 	}
 	for _, b := range branches {
+		if err = dsess.CheckReadAccess(ctx, dbName, b.Ref.GetPath()); branch_control.ErrCannotReadBranch.Is(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
 		refName := b.Ref.String()
 		if decoration != "full" {
 			refName = b.Ref.GetPath() // trim out "refs/heads/"
@@ -613,7 +707,10 @@ type logTableFunctionRowIter struct {
 	cHashToRefs   map[hash.Hash][]string
 	headHash      hash.Hash
 
-	tableNames []string
+	tableNames   []string
+	follow       bool
+	rowFilter    *logRowFilter
+	commitFilter logCommitFilter
 }
 
 func (ltf *LogTableFunction) NewLogTableFunctionRowIter(ctx *sql.Context, ddb *doltdb.DoltDB, commit *doltdb.Commit, matchFn func(*doltdb.OptionalCommit) (bool, error), cHashToRefs map[hash.Hash][]string, tableNames []string) (*logTableFunctionRowIter, error) {
@@ -635,6 +732,9 @@ func (ltf *LogTableFunction) NewLogTableFunctionRowIter(ctx *sql.Context, ddb *d
 		cHashToRefs:   cHashToRefs,
 		headHash:      h,
 		tableNames:    tableNames,
+		follow:        ltf.follow,
+		rowFilter:     newLogRowFilter(ltf.where),
+		commitFilter:  ltf.commitFilter,
 	}, nil
 }
 
@@ -676,6 +776,9 @@ func (ltf *LogTableFunction) NewDotDotLogTableFunctionRowIter(ctx *sql.Context,
 		cHashToRefs:   cHashToRefs,
 		headHash:      headHash,
 		tableNames:    tableNames,
+		follow:        ltf.follow,
+		rowFilter:     newLogRowFilter(ltf.where),
+		commitFilter:  ltf.commitFilter,
 	}, nil
 }
 
@@ -685,6 +788,7 @@ func (itr *logTableFunctionRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 	var commitHash hash.Hash
 	var commit *doltdb.Commit
 	var optCmt *doltdb.OptionalCommit
+	var meta *datas.CommitMeta
 	var err error
 	for {
 		commitHash, optCmt, err = itr.child.Next(ctx)
@@ -698,72 +802,24 @@ func (itr *logTableFunctionRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 		}
 
 		if itr.tableNames != nil {
-			if commit.NumParents() == 0 {
-				// if we're at the root commit, we continue without checking if any tables changed
-				// we expect EOF to be returned on the next call to Next(), but continue in case there are more commits
-				continue
-			}
-			optCmt, err := commit.GetParent(ctx, 0)
+			didChange, err := itr.didTablesChange(ctx, commit)
 			if err != nil {
 				return nil, err
 			}
-			parent0Cm, ok := optCmt.ToCommit()
-			if !ok {
-				return nil, doltdb.ErrGhostCommitEncountered
-			}
-
-			var parent1Cm *doltdb.Commit
-			if commit.NumParents() > 1 {
-				optCmt, err = commit.GetParent(ctx, 1)
-				if err != nil {
-					return nil, err
-				}
-				parent1Cm, ok = optCmt.ToCommit()
-				if !ok {
-					return nil, doltdb.ErrGhostCommitEncountered
-				}
-			}
-
-			parent0RV, err := parent0Cm.GetRootValue(ctx)
-			if err != nil {
-				return nil, err
-			}
-			var parent1RV doltdb.RootValue
-			if parent1Cm != nil {
-				parent1RV, err = parent1Cm.GetRootValue(ctx)
-				if err != nil {
-					return nil, err
-				}
-			}
-			childRV, err := commit.GetRootValue(ctx)
-			if err != nil {
-				return nil, err
-			}
-
-			didChange := false
-			for _, tableName := range itr.tableNames {
-				didChange, err = didTableChangeBetweenRootValues(ctx, childRV, parent0RV, parent1RV, tableName)
-				if err != nil {
-					return nil, err
-				}
-				if didChange {
-					break
-				}
+			if !didChange {
+				continue
 			}
+		}
 
-			if didChange {
-				break
-			}
-		} else {
+		meta, err = commit.GetCommitMeta(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if itr.commitFilter.matches(meta) {
 			break
 		}
 	}
 
-	meta, err := commit.GetCommitMeta(ctx)
-	if err != nil {
-		return nil, err
-	}
-
 	row := sql.NewRow(commitHash.String(), meta.Name, meta.Email, meta.Time(), meta.Description)
 
 	if itr.showParents {
@@ -793,9 +849,125 @@ func (itr *logTableFunctionRowIter) Next(ctx *sql.Context) (sql.Row, error) {
 		}
 	}
 
+	committerName, committerEmail := meta.Committer()
+	row = row.Append(sql.NewRow(committerName, committerEmail, meta.CommitterTime(), datas.FormatTrailers(meta.Trailers)))
+
 	return row, nil
 }
 
+// didTablesChange returns whether |commit| changed any of the tables the log is restricted to, or any rows of them
+// matching the row filter. Rows are compared against the first parent, unless the table only exists in the second
+// parent of a merge, like didTableChangeBetweenRootValues does. When following renames, a table renamed by |commit|
+// relative to its first parent is followed by its previous name for the rest of the log.
+func (itr *logTableFunctionRowIter) didTablesChange(ctx *sql.Context, commit *doltdb.Commit) (bool, error) {
+	if commit.NumParents() == 0 {
+		// if we're at the root commit, we continue without checking if any tables changed
+		// we expect EOF to be returned on the next call to Next(), but continue in case there are more commits
+		return false, nil
+	}
+	optCmt, err := commit.GetParent(ctx, 0)
+	if err != nil {
+		return false, err
+	}
+	parent0Cm, ok := optCmt.ToCommit()
+	if !ok {
+		return false, doltdb.ErrGhostCommitEncountered
+	}
+
+	var parent1Cm *doltdb.Commit
+	if commit.NumParents() > 1 {
+		optCmt, err = commit.GetParent(ctx, 1)
+		if err != nil {
+			return false, err
+		}
+		parent1Cm, ok = optCmt.ToCommit()
+		if !ok {
+			return false, doltdb.ErrGhostCommitEncountered
+		}
+	}
+
+	parent0RV, err := parent0Cm.GetRootValue(ctx)
+	if err != nil {
+		return false, err
+	}
+	var parent1RV doltdb.RootValue
+	if parent1Cm != nil {
+		parent1RV, err = parent1Cm.GetRootValue(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+	childRV, err := commit.GetRootValue(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	didChange := false
+	for _, tableName := range itr.tableNames {
+		if itr.rowFilter != nil {
+			var parentRV doltdb.RootValue
+			parentRV, err = firstParentWithTable(ctx, parent0RV, parent1RV, tableName)
+			if err == nil {
+				didChange, err = itr.rowFilter.didRowsChange(ctx, childRV, parentRV, tableName)
+			}
+		} else {
+			didChange, err = didTableChangeBetweenRootValues(ctx, childRV, parent0RV, parent1RV, tableName)
+		}
+		if err != nil {
+			return false, err
+		}
+		if didChange {
+			break
+		}
+	}
+
+	if itr.follow {
+		// renames are followed along the first parent, the branch a merge was made on
+		for _, tableName := range itr.tableNames {
+			oldName, renamed, err := findRenamedFrom(ctx, childRV, parent0RV, tableName)
+			if err != nil {
+				return false, err
+			}
+			if renamed && !containsTableName(itr.tableNames, oldName) {
+				// copy rather than append, |tableNames| is shared with the table function
+				itr.tableNames = append(append([]string{}, itr.tableNames...), oldName)
+				break
+			}
+		}
+	}
+
+	return didChange, nil
+}
+
+// firstParentWithTable returns |parent0| unless the table named |tableName| only exists in |parent1|, which is nil
+// for commits that aren't merges.
+func firstParentWithTable(ctx *sql.Context, parent0, parent1 doltdb.RootValue, tableName string) (doltdb.RootValue, error) {
+	if parent1 == nil {
+		return parent0, nil
+	}
+	_, inParent0, err := parent0.GetTableHash(ctx, doltdb.TableName{Name: tableName})
+	if err != nil || inParent0 {
+		return parent0, err
+	}
+	_, inParent1, err := parent1.GetTableHash(ctx, doltdb.TableName{Name: tableName})
+	if err != nil {
+		return nil, err
+	}
+	if inParent1 {
+		return parent1, nil
+	}
+	return parent0, nil
+}
+
+func containsTableName(tableNames []string, tableName string) bool {
+	for _, name := range tableNames {
+		if name == tableName {
+			return true
+		}
+	}
+	return false
+}
+
 func (itr *logTableFunctionRowIter) Close(_ *sql.Context) error {
 	return nil
 }
@@ -33,7 +33,7 @@ const (
 
 // PermissionsStrings is a slice of strings representing the available branch_control.branch_control.Permissions. The order of the
 // strings should exactly match the order of the branch_control.Permissions according to their flag value.
-var PermissionsStrings = []string{"admin", "write", "read"}
+var PermissionsStrings = []string{"admin", "write", "read", "protected"}
 
 // accessSchema is the schema for the "dolt_branch_control" table.
 var accessSchema = sql.Schema{
@@ -205,7 +205,9 @@ func (tbl BranchControlTable) Insert(ctx *sql.Context, row sql.Row) error {
 	// We check if we're inserting a subset of an already-existing row. We only consider this a subset if the
 	// permissions are as permissible as the existing ones, or are more restrictive (i.e. write is a "subset permission"
 	// of admin). If we are, we deny the insertion as the existing row will already match against ALL possible values for this row.
-	if ok, modPerms := tbl.Match(database, branch, user, host); ok && perms.Consolidate() >= modPerms.Consolidate() {
+	// Protecting a branch is not a subset of an unprotected row, as it changes how pushes to matching branches behave.
+	if ok, modPerms := tbl.Match(database, branch, user, host); ok && perms.Consolidate() >= modPerms.Consolidate() &&
+		(perms&branch_control.Permissions_Protected == 0 || modPerms&branch_control.Permissions_Protected != 0) {
 		permBits := uint64(modPerms)
 		permStr, _ := accessSchema[4].Type.(sql.SetType).BitsToString(permBits)
 		return sql.NewUniqueKeyErr(
@@ -21,6 +21,7 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
@@ -141,6 +142,19 @@ func NewBranchItr(ctx *sql.Context, table *BranchesTable) (*BranchItr, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		// Branches that the user may not read are hidden entirely
+		readableRefs := branchRefs[:0]
+		for _, branch := range branchRefs {
+			err = dsess.CheckReadAccess(ctx, db.Name(), branch.GetPath())
+			if branch_control.ErrCannotReadBranch.Is(err) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			readableRefs = append(readableRefs, branch)
+		}
+		branchRefs = readableRefs
 	}
 
 	branchNames := make([]string, len(branchRefs))
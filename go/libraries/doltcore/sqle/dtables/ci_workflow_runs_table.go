@@ -0,0 +1,269 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+const (
+	WorkflowRunStatusPassed = "passed"
+	WorkflowRunStatusFailed = "failed"
+	WorkflowRunStatusError  = "error"
+
+	// defaultWorkflowRunHistorySize is the number of workflow runs kept for each database
+	defaultWorkflowRunHistorySize = 1000
+
+	// WorkflowRunHistoryFilename is the name of the file, in the server's cfg_dir, which the workflow run history is
+	// stored in.
+	WorkflowRunHistoryFilename = "ci_workflow_runs.json"
+)
+
+// WorkflowRunStepResult is the result of a single step of a workflow run, as reported in the step_results column of
+// the dolt_ci_workflow_runs table.
+type WorkflowRunStepResult struct {
+	Job         string   `json:"job"`
+	Step        string   `json:"step"`
+	SavedQuery  string   `json:"saved_query"`
+	Passed      bool     `json:"passed"`
+	RowCount    int64    `json:"row_count"`
+	ColumnCount int64    `json:"column_count"`
+	Failures    []string `json:"failures,omitempty"`
+	Error       string   `json:"error,omitempty"`
+}
+
+// WorkflowRun is a single entry in the workflow run history of a database.
+type WorkflowRun struct {
+	ID           string                  `json:"id"`
+	Database     string                  `json:"database"`
+	WorkflowName string                  `json:"workflow_name"`
+	Event        string                  `json:"event"`
+	Branch       string                  `json:"branch"`
+	CommitHash   string                  `json:"commit_hash"`
+	Status       string                  `json:"status"`
+	Error        string                  `json:"error,omitempty"`
+	Steps        []WorkflowRunStepResult `json:"steps"`
+	StartedAt    time.Time               `json:"started_at"`
+	CompletedAt  time.Time               `json:"completed_at"`
+}
+
+// WorkflowRunHistory keeps a bounded history of workflow runs for each database. The history is kept in memory unless
+// it is loaded from a file with Load, after which it is saved to that file each time a run is added, so that it
+// survives restarts of the server.
+type WorkflowRunHistory struct {
+	mu      sync.Mutex
+	maxRuns int
+	runs    map[string][]WorkflowRun
+	path    string
+}
+
+// NewWorkflowRunHistory returns a new WorkflowRunHistory that keeps at most |maxRuns| runs for each database.
+func NewWorkflowRunHistory(maxRuns int) *WorkflowRunHistory {
+	return &WorkflowRunHistory{
+		maxRuns: maxRuns,
+		runs:    make(map[string][]WorkflowRun),
+	}
+}
+
+// WorkflowRuns is the history of workflow runs executed by this process, which is exposed through the
+// dolt_ci_workflow_runs system table.
+var WorkflowRuns = NewWorkflowRunHistory(defaultWorkflowRunHistorySize)
+
+// Load replaces the history with the runs stored in the file at |path|, and saves the history to that file from then
+// on. The history is empty if the file does not exist.
+func (h *WorkflowRunHistory) Load(path string) error {
+	runs := make(map[string][]WorkflowRun)
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	} else if err == nil {
+		if err = json.Unmarshal(data, &runs); err != nil {
+			return fmt.Errorf("invalid workflow run history file %s: %w", path, err)
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for key, dbRuns := range runs {
+		if len(dbRuns) > h.maxRuns {
+			runs[key] = dbRuns[len(dbRuns)-h.maxRuns:]
+		}
+	}
+	h.runs = runs
+	h.path = path
+	return nil
+}
+
+// Add records |run| in the history of its database, evicting the oldest run if the history is full. If the history
+// was loaded from a file, it is saved before returning.
+func (h *WorkflowRunHistory) Add(run WorkflowRun) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	key := strings.ToLower(run.Database)
+	runs := append(h.runs[key], run)
+	if len(runs) > h.maxRuns {
+		runs = runs[len(runs)-h.maxRuns:]
+	}
+	h.runs[key] = runs
+	if h.path == "" {
+		return nil
+	}
+	return h.save()
+}
+
+// save writes the history to its file, replacing the file atomically.
+func (h *WorkflowRunHistory) save() error {
+	data, err := json.Marshal(h.runs)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(h.path), 0755); err != nil {
+		return err
+	}
+
+	tmp := h.path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, h.path)
+}
+
+// List returns a copy of the workflow runs recorded for |dbName|, oldest first.
+func (h *WorkflowRunHistory) List(dbName string) []WorkflowRun {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	runs := h.runs[strings.ToLower(dbName)]
+	res := make([]WorkflowRun, len(runs))
+	copy(res, runs)
+	return res
+}
+
+// WorkflowRunsTable is a sql.Table implementation that implements a system table which shows the history of workflow
+// runs triggered on this server.
+type WorkflowRunsTable struct {
+	dbName    string
+	tableName string
+	history   *WorkflowRunHistory
+}
+
+var _ sql.Table = (*WorkflowRunsTable)(nil)
+
+// NewWorkflowRunsTable creates a WorkflowRunsTable showing the runs recorded in |history| for the database named
+// |dbName|, which should not be revision qualified.
+func NewWorkflowRunsTable(dbName, tableName string, history *WorkflowRunHistory) sql.Table {
+	return &WorkflowRunsTable{dbName: dbName, tableName: tableName, history: history}
+}
+
+// Name is a sql.Table interface function which returns the name of the table
+func (wt *WorkflowRunsTable) Name() string {
+	return wt.tableName
+}
+
+// String is a sql.Table interface function which returns the name of the table
+func (wt *WorkflowRunsTable) String() string {
+	return wt.tableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the workflow runs system table
+func (wt *WorkflowRunsTable) Schema() sql.Schema {
+	return []*sql.Column{
+		{Name: "id", Type: types.Text, Source: wt.tableName, PrimaryKey: true, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "workflow_name", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "event", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "branch", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "commit_hash", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "status", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "error", Type: types.Text, Source: wt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: wt.dbName},
+		{Name: "step_results", Type: types.JSON, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "started_at", Type: types.Datetime, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+		{Name: "completed_at", Type: types.Datetime, Source: wt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: wt.dbName},
+	}
+}
+
+// Collation implements the sql.Table interface.
+func (wt *WorkflowRunsTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data.  Currently the data is unpartitioned.
+func (wt *WorkflowRunsTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition
+func (wt *WorkflowRunsTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	var runs []WorkflowRun
+	if wt.history != nil {
+		runs = wt.history.List(wt.dbName)
+	}
+	return &workflowRunsItr{runs: runs}, nil
+}
+
+// workflowRunsItr is a sql.RowIter implementation which iterates over each workflow run as if it's a row in the table.
+type workflowRunsItr struct {
+	runs []WorkflowRun
+	idx  int
+}
+
+// Next retrieves the next row. It will return io.EOF if it's the last row.
+func (itr *workflowRunsItr) Next(*sql.Context) (sql.Row, error) {
+	if itr.idx >= len(itr.runs) {
+		return nil, io.EOF
+	}
+
+	defer func() {
+		itr.idx++
+	}()
+
+	run := itr.runs[itr.idx]
+
+	steps := run.Steps
+	if steps == nil {
+		steps = []WorkflowRunStepResult{}
+	}
+	b, err := json.Marshal(steps)
+	if err != nil {
+		return nil, err
+	}
+	stepResults, _, err := types.JSON.Convert(string(b))
+	if err != nil {
+		return nil, err
+	}
+
+	var runErr interface{}
+	if run.Error != "" {
+		runErr = run.Error
+	}
+
+	return sql.NewRow(run.ID, run.WorkflowName, run.Event, run.Branch, run.CommitHash, run.Status, runErr, stepResults, run.StartedAt, run.CompletedAt), nil
+}
+
+// Close closes the iterator.
+func (itr *workflowRunsItr) Close(*sql.Context) error {
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dtables"
+)
+
+func TestWorkflowRunHistoryLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".doltcfg", dtables.WorkflowRunHistoryFilename)
+
+	history := dtables.NewWorkflowRunHistory(2)
+	require.NoError(t, history.Load(path))
+	require.Empty(t, history.List("db"))
+	for _, id := range []string{"1", "2", "3"} {
+		require.NoError(t, history.Add(dtables.WorkflowRun{ID: id, Database: "DB", Status: dtables.WorkflowRunStatusPassed}))
+	}
+	require.NoError(t, history.Add(dtables.WorkflowRun{ID: "4", Database: "other"}))
+
+	// a new history loads the runs saved by the old one
+	history = dtables.NewWorkflowRunHistory(2)
+	require.NoError(t, history.Load(path))
+	runs := history.List("db")
+	require.Len(t, runs, 2)
+	require.Equal(t, "2", runs[0].ID)
+	require.Equal(t, "3", runs[1].ID)
+	require.Equal(t, dtables.WorkflowRunStatusPassed, runs[1].Status)
+	require.Len(t, history.List("other"), 1)
+
+	require.NoError(t, os.WriteFile(path, []byte("not json"), 0644))
+	require.Error(t, dtables.NewWorkflowRunHistory(2).Load(path))
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"github.com/dolthub/go-mysql-server/sql"
+	sqlTypes "github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+)
+
+var _ sql.Table = (*CommitChecksTable)(nil)
+var _ sql.UpdatableTable = (*CommitChecksTable)(nil)
+var _ sql.DeletableTable = (*CommitChecksTable)(nil)
+var _ sql.InsertableTable = (*CommitChecksTable)(nil)
+var _ sql.ReplaceableTable = (*CommitChecksTable)(nil)
+var _ sql.IndexAddressableTable = (*CommitChecksTable)(nil)
+
+// CommitChecksTable is the system table that stores the SQL assertions that are run against the staged root before each
+// commit. A commit is rejected if any of its queries returns rows.
+type CommitChecksTable struct {
+	userSpaceSystemTable
+}
+
+func doltCommitChecksSchema() sql.Schema {
+	return []*sql.Column{
+		{Name: doltdb.CommitChecksNameCol, Type: sqlTypes.Text, Source: doltdb.CommitChecksTableName, PrimaryKey: true},
+		{Name: doltdb.CommitChecksQueryCol, Type: sqlTypes.LongText, Source: doltdb.CommitChecksTableName, PrimaryKey: false, Nullable: false},
+	}
+}
+
+// NewCommitChecksTable creates a CommitChecksTable
+func NewCommitChecksTable(_ *sql.Context, backingTable VersionableTable, schemaName string) sql.Table {
+	return newCommitChecksTable(backingTable, schemaName)
+}
+
+// NewEmptyCommitChecksTable creates a CommitChecksTable
+func NewEmptyCommitChecksTable(_ *sql.Context, schemaName string) sql.Table {
+	return newCommitChecksTable(nil, schemaName)
+}
+
+func newCommitChecksTable(backingTable VersionableTable, schemaName string) *CommitChecksTable {
+	return &CommitChecksTable{userSpaceSystemTable{
+		name:         doltdb.CommitChecksTableName,
+		schema:       doltCommitChecksSchema,
+		backingTable: backingTable,
+		schemaName:   schemaName,
+	}}
+}
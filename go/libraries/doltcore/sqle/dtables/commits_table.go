@@ -21,8 +21,10 @@ import (
 	"github.com/dolthub/go-mysql-server/sql"
 	"github.com/dolthub/go-mysql-server/sql/types"
 
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
 	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
 	"github.com/dolthub/dolt/go/store/datas"
 	"github.com/dolthub/dolt/go/store/hash"
@@ -78,6 +80,10 @@ func (ct *CommitsTable) Schema() sql.Schema {
 		{Name: "email", Type: types.Text, Source: ct.tableName, PrimaryKey: false, DatabaseSource: ct.dbName},
 		{Name: "date", Type: types.Datetime, Source: ct.tableName, PrimaryKey: false, DatabaseSource: ct.dbName},
 		{Name: "message", Type: types.Text, Source: ct.tableName, PrimaryKey: false, DatabaseSource: ct.dbName},
+		{Name: "committer_name", Type: types.Text, Source: ct.tableName, PrimaryKey: false, DatabaseSource: ct.dbName},
+		{Name: "committer_email", Type: types.Text, Source: ct.tableName, PrimaryKey: false, DatabaseSource: ct.dbName},
+		{Name: "committer_date", Type: types.Datetime, Source: ct.tableName, PrimaryKey: false, DatabaseSource: ct.dbName},
+		{Name: "trailers", Type: types.Text, Source: ct.tableName, PrimaryKey: false, DatabaseSource: ct.dbName},
 	}
 }
 
@@ -98,7 +104,7 @@ func (ct *CommitsTable) PartitionRows(ctx *sql.Context, p sql.Partition) (sql.Ro
 	case *doltdb.CommitPart:
 		return sql.RowsToRowIter(formatCommitTableRow(p.Hash(), p.Meta())), nil
 	default:
-		return NewCommitsRowItr(ctx, ct.ddb)
+		return NewCommitsRowItr(ctx, ct.dbName, ct.ddb)
 	}
 }
 
@@ -124,6 +130,10 @@ func (ct *CommitsTable) LookupPartitions(ctx *sql.Context, lookup sql.IndexLooku
 			return nil, fmt.Errorf("failed to parse commit lookup ranges: %s", sql.DebugString(lookup.Ranges))
 		}
 		hashes, commits, metas := index.HashesToCommits(ctx, ct.ddb, hashStrs, nil, false)
+		hashes, commits, metas, err := readableCommits(ctx, ct.dbName, ct.ddb, hashes, commits, metas)
+		if err != nil {
+			return nil, err
+		}
 		if len(hashes) == 0 {
 			return sql.PartitionsToPartitionIter(), nil
 		}
@@ -139,14 +149,50 @@ type CommitsRowItr struct {
 	itr doltdb.CommitItr
 }
 
-// NewCommitsRowItr creates a CommitsRowItr from the current environment.
-func NewCommitsRowItr(ctx *sql.Context, ddb *doltdb.DoltDB) (CommitsRowItr, error) {
-	itr, err := doltdb.CommitItrForAllBranches(ctx, ddb)
+// NewCommitsRowItr creates a CommitsRowItr over the commits reachable from the branches of the database that the
+// current user may read.
+func NewCommitsRowItr(ctx *sql.Context, dbName string, ddb *doltdb.DoltDB) (CommitsRowItr, error) {
+	branchRefs, err := ddb.GetBranches(ctx)
 	if err != nil {
 		return CommitsRowItr{}, err
 	}
 
-	return CommitsRowItr{itr: itr}, nil
+	rootCommits := make([]*doltdb.Commit, 0, len(branchRefs))
+	for _, branch := range branchRefs {
+		err = dsess.CheckReadAccess(ctx, dbName, branch.GetPath())
+		if branch_control.ErrCannotReadBranch.Is(err) {
+			continue
+		} else if err != nil {
+			return CommitsRowItr{}, err
+		}
+
+		cm, err := ddb.ResolveCommitRef(ctx, branch)
+		if err != nil {
+			return CommitsRowItr{}, err
+		}
+		rootCommits = append(rootCommits, cm)
+	}
+
+	return CommitsRowItr{itr: doltdb.CommitItrForRoots(ddb, rootCommits...)}, nil
+}
+
+// readableCommits filters the given commits down to those that the current user may read.
+func readableCommits(ctx *sql.Context, dbName string, ddb *doltdb.DoltDB, hashes []hash.Hash, commits []*doltdb.Commit, metas []*datas.CommitMeta) ([]hash.Hash, []*doltdb.Commit, []*datas.CommitMeta, error) {
+	if !dsess.BranchReadsEnforced() {
+		return hashes, commits, metas, nil
+	}
+	n := 0
+	for i := range hashes {
+		err := dsess.CheckReadAccessForCommit(ctx, ddb, dbName, commits[i])
+		if branch_control.ErrCannotReadCommit.Is(err) {
+			continue
+		} else if err != nil {
+			return nil, nil, nil, err
+		}
+		hashes[n], commits[n], metas[n] = hashes[i], commits[i], metas[i]
+		n++
+	}
+	return hashes[:n], commits[:n], metas[:n], nil
 }
 
 // Next retrieves the next row. It will return io.EOF if it's the last row.
@@ -174,6 +220,10 @@ func (itr CommitsRowItr) Close(*sql.Context) error {
 	return nil
 }
 
+// formatCommitTableRow returns the row for the commit |h| in the commits and log system tables. The committer, email
+// and date columns describe the author of the commit, and the committer_* columns who created it.
 func formatCommitTableRow(h hash.Hash, meta *datas.CommitMeta) sql.Row {
-	return sql.NewRow(h.String(), meta.Name, meta.Email, meta.Time(), meta.Description)
+	committerName, committerEmail := meta.Committer()
+	return sql.NewRow(h.String(), meta.Name, meta.Email, meta.Time(), meta.Description,
+		committerName, committerEmail, meta.CommitterTime(), datas.FormatTrailers(meta.Trailers))
 }
@@ -413,18 +413,20 @@ func (itr *prollyConflictRowIter) loadTableMaps(ctx *sql.Context, baseHash, thei
 			return err
 		}
 
-		var idx durable.Index
 		if !ok {
+			var idx durable.Index
 			idx, err = durable.NewEmptyPrimaryIndex(ctx, itr.vrw, itr.ns, itr.ourSch)
+			if err != nil {
+				return err
+			}
+			itr.baseRows = durable.ProllyMapFromIndex(idx)
 		} else {
-			idx, err = baseTbl.GetRowData(ctx)
-		}
-
-		if err != nil {
-			return err
+			// the base table is re-keyed if the merge changed the table's primary key
+			itr.baseRows, err = merge.RekeyedRowData(ctx, itr.tblName, baseTbl, itr.ourSch)
+			if err != nil {
+				return err
+			}
 		}
-
-		itr.baseRows = durable.ProllyMapFromIndex(idx)
 		itr.baseHash = baseHash
 	}
 
@@ -442,11 +444,10 @@ func (itr *prollyConflictRowIter) loadTableMaps(ctx *sql.Context, baseHash, thei
 			return fmt.Errorf("failed to find table %s in right root value", itr.tblName)
 		}
 
-		idx, err := theirTbl.GetRowData(ctx)
+		itr.theirRows, err = merge.RekeyedRowData(ctx, itr.tblName, theirTbl, itr.ourSch)
 		if err != nil {
 			return err
 		}
-		itr.theirRows = durable.ProllyMapFromIndex(idx)
 		itr.theirHash = theirHash
 	}
 
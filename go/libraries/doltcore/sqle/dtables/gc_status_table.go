@@ -0,0 +1,214 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+const (
+	GCStateIdle    = "idle"
+	GCStateRunning = "running"
+
+	GCModeShallow = "shallow"
+	GCModeDefault = "default"
+	GCModeFull    = "full"
+)
+
+// GCStatus is the state of automatic garbage collection for a single database.
+type GCStatus struct {
+	Database string
+	// JournalSize is the size of the database's chunk journal, in bytes, when it was last checked.
+	JournalSize uint64
+	// OldGenSize is the size of the database's old generation, in bytes, when it was last checked.
+	OldGenSize    uint64
+	LastCheckedAt time.Time
+	// Running is the mode of the GC currently running on the database, or empty if none is running.
+	Running         string
+	LastMode        string
+	LastStartedAt   time.Time
+	LastCompletedAt time.Time
+	LastError       string
+	Runs            uint64
+	Failures        uint64
+}
+
+// GCStatusTracker keeps the state of automatic garbage collection for each database served by this process.
+type GCStatusTracker struct {
+	mu       sync.Mutex
+	statuses map[string]*GCStatus
+}
+
+// NewGCStatusTracker returns a new, empty GCStatusTracker.
+func NewGCStatusTracker() *GCStatusTracker {
+	return &GCStatusTracker{statuses: make(map[string]*GCStatus)}
+}
+
+// GCStatuses is the state of automatic garbage collection run by this process, which is exposed through the
+// dolt_gc_status system table.
+var GCStatuses = NewGCStatusTracker()
+
+// Update calls |f| with the status of |dbName| while holding the tracker's lock, creating the status if the database
+// has none yet.
+func (t *GCStatusTracker) Update(dbName string, f func(status *GCStatus)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := strings.ToLower(dbName)
+	status, ok := t.statuses[key]
+	if !ok {
+		status = &GCStatus{Database: dbName}
+		t.statuses[key] = status
+	}
+	f(status)
+}
+
+// Remove forgets the status of |dbName|, which should be called when the database is dropped.
+func (t *GCStatusTracker) Remove(dbName string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.statuses, strings.ToLower(dbName))
+}
+
+// Get returns a copy of the status of |dbName|, and whether it has one.
+func (t *GCStatusTracker) Get(dbName string) (GCStatus, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	status, ok := t.statuses[strings.ToLower(dbName)]
+	if !ok {
+		return GCStatus{}, false
+	}
+	return *status, true
+}
+
+// List returns a copy of the status of every database, ordered by database name.
+func (t *GCStatusTracker) List() []GCStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	res := make([]GCStatus, 0, len(t.statuses))
+	for _, status := range t.statuses {
+		res = append(res, *status)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Database < res[j].Database
+	})
+	return res
+}
+
+// GCStatusTable is a sql.Table implementation that implements a system table which shows the state of automatic
+// garbage collection for a database.
+type GCStatusTable struct {
+	dbName    string
+	tableName string
+	tracker   *GCStatusTracker
+}
+
+var _ sql.Table = (*GCStatusTable)(nil)
+
+// NewGCStatusTable creates a GCStatusTable showing the status recorded in |tracker| for the database named |dbName|,
+// which should not be revision qualified.
+func NewGCStatusTable(dbName, tableName string, tracker *GCStatusTracker) sql.Table {
+	return &GCStatusTable{dbName: dbName, tableName: tableName, tracker: tracker}
+}
+
+// Name is a sql.Table interface function which returns the name of the table
+func (gt *GCStatusTable) Name() string {
+	return gt.tableName
+}
+
+// String is a sql.Table interface function which returns the name of the table
+func (gt *GCStatusTable) String() string {
+	return gt.tableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the gc status system table
+func (gt *GCStatusTable) Schema() sql.Schema {
+	return []*sql.Column{
+		{Name: "journal_size", Type: types.Uint64, Source: gt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: gt.dbName},
+		{Name: "oldgen_size", Type: types.Uint64, Source: gt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: gt.dbName},
+		{Name: "last_checked_at", Type: types.Datetime, Source: gt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: gt.dbName},
+		{Name: "state", Type: types.Text, Source: gt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: gt.dbName},
+		{Name: "running_mode", Type: types.Text, Source: gt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: gt.dbName},
+		{Name: "last_mode", Type: types.Text, Source: gt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: gt.dbName},
+		{Name: "last_started_at", Type: types.Datetime, Source: gt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: gt.dbName},
+		{Name: "last_completed_at", Type: types.Datetime, Source: gt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: gt.dbName},
+		{Name: "last_error", Type: types.Text, Source: gt.tableName, PrimaryKey: false, Nullable: true, DatabaseSource: gt.dbName},
+		{Name: "runs", Type: types.Uint64, Source: gt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: gt.dbName},
+		{Name: "failures", Type: types.Uint64, Source: gt.tableName, PrimaryKey: false, Nullable: false, DatabaseSource: gt.dbName},
+	}
+}
+
+// Collation implements the sql.Table interface.
+func (gt *GCStatusTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data.  Currently the data is unpartitioned.
+func (gt *GCStatusTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition. The table has a single
+// row once automatic garbage collection has checked the database, and no rows otherwise.
+func (gt *GCStatusTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	var rows []sql.Row
+	if gt.tracker != nil {
+		if status, ok := gt.tracker.Get(gt.dbName); ok {
+			rows = append(rows, gcStatusRow(status))
+		}
+	}
+	return sql.RowsToRowIter(rows...), nil
+}
+
+func gcStatusRow(status GCStatus) sql.Row {
+	state := GCStateIdle
+	if status.Running != "" {
+		state = GCStateRunning
+	}
+	return sql.NewRow(
+		status.JournalSize,
+		status.OldGenSize,
+		nullIfZeroTime(status.LastCheckedAt),
+		state,
+		nullIfEmpty(status.Running),
+		nullIfEmpty(status.LastMode),
+		nullIfZeroTime(status.LastStartedAt),
+		nullIfZeroTime(status.LastCompletedAt),
+		nullIfEmpty(status.LastError),
+		status.Runs,
+		status.Failures,
+	)
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func nullIfZeroTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
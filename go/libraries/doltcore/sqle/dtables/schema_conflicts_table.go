@@ -231,7 +231,10 @@ func getCreateTableStatement(table string, sch schema.Schema, fks []doltdb.Forei
 
 func getSchemaConflictDescription(ctx context.Context, table doltdb.TableName, base, ours, theirs schema.Schema) (string, error) {
 	_, conflict, _, _, err := merge.SchemaMerge(ctx, noms.Format_Default, ours, theirs, base, table)
-	if err != nil {
+	if merge.ErrMergeWithDifferentPks.Is(err) || merge.ErrMergeWithDifferentPksFromAncestor.Is(err) {
+		// Primary keys that differ are only recorded as a schema conflict when rows couldn't be re-keyed
+		return merge.NullPrimaryKeyConflictDescription(""), nil
+	} else if err != nil {
 		return "", err
 	}
 	return conflict.String(), nil
@@ -0,0 +1,126 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"io"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/dolthub/go-mysql-server/sql/types"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/branch_control"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+)
+
+var _ sql.Table = (*StashesTable)(nil)
+
+// StashesTable is a sql.Table implementation that implements a system table which shows the stash entries of a
+// database, along with the branch each entry was stashed from
+type StashesTable struct {
+	ddb       *doltdb.DoltDB
+	dbName    string
+	tableName string
+}
+
+// NewStashesTable creates a StashesTable
+func NewStashesTable(_ *sql.Context, ddb *doltdb.DoltDB, dbName, tableName string) sql.Table {
+	return &StashesTable{ddb: ddb, dbName: dbName, tableName: tableName}
+}
+
+// Name is a sql.Table interface function which returns the name of the table
+func (st *StashesTable) Name() string {
+	return st.tableName
+}
+
+// String is a sql.Table interface function which returns the name of the table
+func (st *StashesTable) String() string {
+	return st.tableName
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the stashes system table
+func (st *StashesTable) Schema() sql.Schema {
+	return []*sql.Column{
+		{Name: "name", Type: types.Text, Source: st.tableName, PrimaryKey: true, Nullable: false},
+		{Name: "stash_index", Type: types.Int64, Source: st.tableName, PrimaryKey: false, Nullable: false},
+		{Name: "branch", Type: types.Text, Source: st.tableName, PrimaryKey: false, Nullable: false},
+		{Name: "commit_hash", Type: types.Text, Source: st.tableName, PrimaryKey: false, Nullable: false},
+		{Name: "commit_message", Type: types.Text, Source: st.tableName, PrimaryKey: false, Nullable: false},
+	}
+}
+
+// Collation implements the sql.Table interface.
+func (st *StashesTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data.  Currently the data is unpartitioned.
+func (st *StashesTable) Partitions(*sql.Context) (sql.PartitionIter, error) {
+	return index.SinglePartitionIterFromNomsMap(nil), nil
+}
+
+// PartitionRows is a sql.Table interface function that gets a row iterator for a partition
+func (st *StashesTable) PartitionRows(ctx *sql.Context, _ sql.Partition) (sql.RowIter, error) {
+	stashes, err := st.ddb.GetStashes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &stashItr{stashes: stashes, dbName: st.dbName}, nil
+}
+
+// stashItr is a sql.RowIter implementation which iterates over each stash entry as if it's a row in the table. Entries
+// stashed from branches that the user may not read are skipped.
+type stashItr struct {
+	stashes []*doltdb.Stash
+	dbName  string
+	idx     int
+}
+
+// Next retrieves the next row. It will return io.EOF if it's the last row.
+func (itr *stashItr) Next(ctx *sql.Context) (sql.Row, error) {
+	for ; itr.idx < len(itr.stashes); itr.idx++ {
+		stash := itr.stashes[itr.idx]
+
+		// stash entries record the full ref of the branch they were made on
+		branch := stash.BranchName
+		if branchRef, err := ref.Parse(branch); err == nil {
+			branch = branchRef.GetPath()
+		}
+
+		err := dsess.CheckReadAccess(ctx, itr.dbName, branch)
+		if branch_control.ErrCannotReadBranch.Is(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		commitHash, err := stash.HeadCommit.HashOf()
+		if err != nil {
+			return nil, err
+		}
+
+		row := sql.NewRow(stash.Name, int64(itr.idx), branch, commitHash.String(), stash.Description)
+		itr.idx++
+		return row, nil
+	}
+	return nil, io.EOF
+}
+
+// Close closes the iterator.
+func (itr *stashItr) Close(*sql.Context) error {
+	return nil
+}
@@ -0,0 +1,274 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dtables
+
+import (
+	"fmt"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/index"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+var _ sql.Table = (*userSpaceSystemTable)(nil)
+var _ sql.UpdatableTable = (*userSpaceSystemTable)(nil)
+var _ sql.DeletableTable = (*userSpaceSystemTable)(nil)
+var _ sql.InsertableTable = (*userSpaceSystemTable)(nil)
+var _ sql.ReplaceableTable = (*userSpaceSystemTable)(nil)
+var _ sql.IndexAddressableTable = (*userSpaceSystemTable)(nil)
+
+// userSpaceSystemTable is a system table whose rows are stored in a table of the same name on the working root,
+// which is created the first time a row is written to the system table. It implements the dolt_ignore and
+// dolt_commit_checks system tables.
+type userSpaceSystemTable struct {
+	name         string
+	schema       func() sql.Schema
+	backingTable VersionableTable
+	schemaName   string
+}
+
+func (t *userSpaceSystemTable) Name() string {
+	return t.name
+}
+
+func (t *userSpaceSystemTable) String() string {
+	return t.name
+}
+
+// Schema is a sql.Table interface function that gets the sql.Schema of the system table.
+func (t *userSpaceSystemTable) Schema() sql.Schema {
+	return t.schema()
+}
+
+func (t *userSpaceSystemTable) Collation() sql.CollationID {
+	return sql.Collation_Default
+}
+
+// Partitions is a sql.Table interface function that returns a partition of the data.
+func (t *userSpaceSystemTable) Partitions(context *sql.Context) (sql.PartitionIter, error) {
+	if t.backingTable == nil {
+		// no backing table; return an empty iter.
+		return index.SinglePartitionIterFromNomsMap(nil), nil
+	}
+	return t.backingTable.Partitions(context)
+}
+
+func (t *userSpaceSystemTable) PartitionRows(context *sql.Context, partition sql.Partition) (sql.RowIter, error) {
+	if t.backingTable == nil {
+		// no backing table; return an empty iter.
+		return sql.RowsToRowIter(), nil
+	}
+
+	return t.backingTable.PartitionRows(context, partition)
+}
+
+// Replacer returns a RowReplacer for this table. The RowReplacer will have Insert and optionally Delete called once
+// for each row, followed by a call to Close() when all rows have been processed.
+func (t *userSpaceSystemTable) Replacer(ctx *sql.Context) sql.RowReplacer {
+	return newUserSpaceSystemTableWriter(t)
+}
+
+// Updater returns a RowUpdater for this table. The RowUpdater will have Update called once for each row to be
+// updated, followed by a call to Close() when all rows have been processed.
+func (t *userSpaceSystemTable) Updater(ctx *sql.Context) sql.RowUpdater {
+	return newUserSpaceSystemTableWriter(t)
+}
+
+// Inserter returns an Inserter for this table. The Inserter will get one call to Insert() for each row to be
+// inserted, and will end with a call to Close() to finalize the insert operation.
+func (t *userSpaceSystemTable) Inserter(*sql.Context) sql.RowInserter {
+	return newUserSpaceSystemTableWriter(t)
+}
+
+// Deleter returns a RowDeleter for this table. The RowDeleter will get one call to Delete for each row to be deleted,
+// and will end with a call to Close() to finalize the delete operation.
+func (t *userSpaceSystemTable) Deleter(*sql.Context) sql.RowDeleter {
+	return newUserSpaceSystemTableWriter(t)
+}
+
+func (t *userSpaceSystemTable) LockedToRoot(ctx *sql.Context, root doltdb.RootValue) (sql.IndexAddressableTable, error) {
+	if t.backingTable == nil {
+		return t, nil
+	}
+	return t.backingTable.LockedToRoot(ctx, root)
+}
+
+// IndexedAccess implements IndexAddressableTable, but userSpaceSystemTable has no indexes.
+// Thus, this should never be called.
+func (t *userSpaceSystemTable) IndexedAccess(lookup sql.IndexLookup) sql.IndexedTable {
+	panic("Unreachable")
+}
+
+// GetIndexes implements IndexAddressableTable, but userSpaceSystemTable has no indexes.
+func (t *userSpaceSystemTable) GetIndexes(ctx *sql.Context) ([]sql.Index, error) {
+	return nil, nil
+}
+
+func (t *userSpaceSystemTable) PreciseMatch() bool {
+	return true
+}
+
+var _ sql.RowReplacer = (*userSpaceSystemTableWriter)(nil)
+var _ sql.RowUpdater = (*userSpaceSystemTableWriter)(nil)
+var _ sql.RowInserter = (*userSpaceSystemTableWriter)(nil)
+var _ sql.RowDeleter = (*userSpaceSystemTableWriter)(nil)
+
+type userSpaceSystemTableWriter struct {
+	t                       *userSpaceSystemTable
+	errDuringStatementBegin error
+	prevHash                *hash.Hash
+	tableWriter             dsess.TableWriter
+}
+
+func newUserSpaceSystemTableWriter(t *userSpaceSystemTable) *userSpaceSystemTableWriter {
+	return &userSpaceSystemTableWriter{t, nil, nil, nil}
+}
+
+// Insert inserts the row given, returning an error if it cannot. Insert will be called once for each row to process
+// for the insert operation, which may involve many rows. After all rows in an operation have been processed, Close
+// is called.
+func (w *userSpaceSystemTableWriter) Insert(ctx *sql.Context, r sql.Row) error {
+	if err := w.errDuringStatementBegin; err != nil {
+		return err
+	}
+	return w.tableWriter.Insert(ctx, r)
+}
+
+// Update the given row. Provides both the old and new rows.
+func (w *userSpaceSystemTableWriter) Update(ctx *sql.Context, old sql.Row, new sql.Row) error {
+	if err := w.errDuringStatementBegin; err != nil {
+		return err
+	}
+	return w.tableWriter.Update(ctx, old, new)
+}
+
+// Delete deletes the given row. Returns ErrDeleteRowNotFound if the row was not found. Delete will be called once for
+// each row to process for the delete operation, which may involve many rows. After all rows have been processed,
+// Close is called.
+func (w *userSpaceSystemTableWriter) Delete(ctx *sql.Context, r sql.Row) error {
+	if err := w.errDuringStatementBegin; err != nil {
+		return err
+	}
+	return w.tableWriter.Delete(ctx, r)
+}
+
+// StatementBegin is called before the first operation of a statement. Integrators should mark the state of the data
+// in some way that it may be returned to in the case of an error.
+func (w *userSpaceSystemTableWriter) StatementBegin(ctx *sql.Context) {
+	dbName := ctx.GetCurrentDatabase()
+	dSess := dsess.DSessFromSess(ctx.Session)
+
+	// TODO: this needs to use a revision qualified name
+	roots, _ := dSess.GetRoots(ctx, dbName)
+	dbState, ok, err := dSess.LookupDbState(ctx, dbName)
+	if err != nil {
+		w.errDuringStatementBegin = err
+		return
+	}
+	if !ok {
+		w.errDuringStatementBegin = fmt.Errorf("no root value found in session")
+		return
+	}
+
+	prevHash, err := roots.Working.HashOf()
+	if err != nil {
+		w.errDuringStatementBegin = err
+		return
+	}
+
+	w.prevHash = &prevHash
+
+	tname := doltdb.TableName{Name: w.t.name, Schema: w.t.schemaName}
+	found, err := roots.Working.HasTable(ctx, tname)
+	if err != nil {
+		w.errDuringStatementBegin = err
+		return
+	}
+
+	if !found {
+		sch := sql.NewPrimaryKeySchema(w.t.Schema())
+		doltSch, err := sqlutil.ToDoltSchema(ctx, roots.Working, tname, sch, roots.Head, sql.Collation_Default)
+		if err != nil {
+			w.errDuringStatementBegin = err
+			return
+		}
+
+		// underlying table doesn't exist. Record this, then create the table.
+		newRootValue, err := doltdb.CreateEmptyTable(ctx, roots.Working, tname, doltSch)
+
+		if err != nil {
+			w.errDuringStatementBegin = err
+			return
+		}
+
+		if dbState.WorkingSet() == nil {
+			w.errDuringStatementBegin = doltdb.ErrOperationNotSupportedInDetachedHead
+			return
+		}
+
+		// We use WriteSession.SetWorkingSet instead of DoltSession.SetWorkingRoot because we want to avoid modifying the root
+		// until the end of the transaction, but we still want the WriteSession to be able to find the newly
+		// created table.
+		if ws := dbState.WriteSession(); ws != nil {
+			err = ws.SetWorkingSet(ctx, dbState.WorkingSet().WithWorkingRoot(newRootValue))
+			if err != nil {
+				w.errDuringStatementBegin = err
+				return
+			}
+		}
+
+		dSess.SetWorkingRoot(ctx, dbName, newRootValue)
+	}
+
+	if ws := dbState.WriteSession(); ws != nil {
+		tableWriter, err := ws.GetTableWriter(ctx, tname, dbName, dSess.SetWorkingRoot, false)
+		if err != nil {
+			w.errDuringStatementBegin = err
+			return
+		}
+		w.tableWriter = tableWriter
+		tableWriter.StatementBegin(ctx)
+	}
+}
+
+// DiscardChanges is called if a statement encounters an error, and all current changes since the statement beginning
+// should be discarded.
+func (w *userSpaceSystemTableWriter) DiscardChanges(ctx *sql.Context, errorEncountered error) error {
+	if w.tableWriter != nil {
+		return w.tableWriter.DiscardChanges(ctx, errorEncountered)
+	}
+	return nil
+}
+
+// StatementComplete is called after the last operation of the statement, indicating that it has successfully completed.
+// The mark set in StatementBegin may be removed, and a new one should be created on the next StatementBegin.
+func (w *userSpaceSystemTableWriter) StatementComplete(ctx *sql.Context) error {
+	if w.tableWriter != nil {
+		return w.tableWriter.StatementComplete(ctx)
+	}
+	return nil
+}
+
+// Close finalizes the delete operation, persisting the result.
+func (w *userSpaceSystemTableWriter) Close(ctx *sql.Context) error {
+	if w.tableWriter != nil {
+		return w.tableWriter.Close(ctx)
+	}
+	return nil
+}
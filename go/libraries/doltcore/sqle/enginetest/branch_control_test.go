@@ -1361,6 +1361,456 @@ var BranchControlTests = []BranchControlTest{
 			},
 		},
 	},
+	{
+		Name: "Enforced read permissions",
+		SetUpScript: []string{
+			"DELETE FROM dolt_branch_control WHERE user = '%';",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"CREATE USER testuser@localhost;",
+			"GRANT ALL ON *.* TO testuser@localhost;",
+			"REVOKE SUPER ON *.* FROM testuser@localhost;",
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 BIGINT);",
+			"INSERT INTO test VALUES (1, 1);",
+			"CALL DOLT_COMMIT('-Am', 'setup commit');",
+			"CALL DOLT_BRANCH('other');",
+			"CALL DOLT_BRANCH('secret');",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'main', 'testuser', 'localhost', 'read'), ('%', 'other', 'testuser', 'localhost', 'write');",
+		},
+		Assertions: []BranchControlTestAssertion{
+			{ // Reads are not restricted until enforcement is enabled
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT * FROM `mydb/secret`.test;",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET @@GLOBAL.dolt_enforce_branch_control_reads = 1;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT * FROM test;",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT * FROM `mydb/other`.test;",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "SELECT * FROM `mydb/secret`.test;",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "USE `mydb/secret`;",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT * FROM test AS OF 'other';",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "SELECT * FROM test AS OF 'secret';",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "SELECT * FROM dolt_diff('main', 'secret', 'test');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "SELECT * FROM dolt_diff('main...secret', 'test');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "SELECT count(*) FROM dolt_log('secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT count(*) FROM dolt_log('other');",
+				Expected: []sql.Row{{3}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT name FROM dolt_branches ORDER BY name;",
+				Expected: []sql.Row{{"main"}, {"other"}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT name FROM dolt_branches ORDER BY name;",
+				Expected: []sql.Row{{"main"}, {"other"}, {"secret"}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "INSERT INTO dolt_branch_control VALUES ('%', 'secret', 'testuser', 'localhost', 'read');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT * FROM `mydb/secret`.test;",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "INSERT INTO `mydb/secret`.test VALUES (2, 2);",
+				ExpectedErr: branch_control.ErrIncorrectPermissions,
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET @@GLOBAL.dolt_enforce_branch_control_reads = 0;",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "Enforced read permissions for procedures that read other branches",
+		SetUpScript: []string{
+			"DELETE FROM dolt_branch_control WHERE user = '%';",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"CREATE USER testuser@localhost;",
+			"GRANT ALL ON *.* TO testuser@localhost;",
+			"REVOKE SUPER ON *.* FROM testuser@localhost;",
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 BIGINT);",
+			"INSERT INTO test VALUES (1, 1);",
+			"CALL DOLT_COMMIT('-Am', 'setup commit');",
+			"CALL DOLT_BRANCH('other');",
+			"CALL DOLT_CHECKOUT('-b', 'secret');",
+			"INSERT INTO test VALUES (2, 2);",
+			"CALL DOLT_COMMIT('-am', 'secret commit');",
+			"CALL DOLT_CHECKOUT('main');",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'main', 'testuser', 'localhost', 'read'), ('%', 'other', 'testuser', 'localhost', 'write'), ('%', 'new%', 'testuser', 'localhost', 'write');",
+		},
+		Assertions: []BranchControlTestAssertion{
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET @@GLOBAL.dolt_enforce_branch_control_reads = 1;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "CALL DOLT_CHECKOUT('other');",
+				Expected: []sql.Row{{0, "Switched to branch 'other'"}},
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_BRANCH('new1', 'secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_BRANCH('new1', 'secret~1');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_BRANCH('-c', 'secret', 'new1');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_CHECKOUT('secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_CHECKOUT('-b', 'new1', 'secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_CHECKOUT('secret', '--', 'test');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_MERGE('secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_CHERRY_PICK('secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_CHERRY_PICK('main..secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_REVERT('secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_RESET('secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_RESET('--soft', 'secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_RESET('--hard', 'secret');",
+				ExpectedErr: branch_control.ErrCannotReadBranch,
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT name FROM dolt_branches ORDER BY name;",
+				Expected: []sql.Row{{"main"}, {"other"}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "CALL DOLT_BRANCH('new1', 'main');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "CALL DOLT_BRANCH('-c', 'main', 'new2');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT name FROM dolt_branches ORDER BY name;",
+				Expected: []sql.Row{{"main"}, {"new1"}, {"new2"}, {"other"}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET @@GLOBAL.dolt_enforce_branch_control_reads = 0;",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "Enforced read permissions for commits, tags, and the commit and stash tables",
+		SetUpScript: []string{
+			"DELETE FROM dolt_branch_control WHERE user = '%';",
+			"INSERT INTO dolt_branch_control VALUES ('%', '%', 'root', 'localhost', 'admin');",
+			"CREATE USER testuser@localhost;",
+			"GRANT ALL ON *.* TO testuser@localhost;",
+			"REVOKE SUPER ON *.* FROM testuser@localhost;",
+			"CREATE TABLE test (pk BIGINT PRIMARY KEY, v1 BIGINT);",
+			"INSERT INTO test VALUES (1, 1);",
+			"CALL DOLT_COMMIT('-Am', 'setup commit');",
+			"CALL DOLT_TAG('main_tag', 'main');",
+			"CALL DOLT_CHECKOUT('-b', 'secret');",
+			"INSERT INTO test VALUES (2, 2);",
+			"CALL DOLT_COMMIT('-am', 'secret commit');",
+			"CALL DOLT_TAG('secret_tag', 'secret');",
+			"SET @secret_hash = hashof('secret');",
+			"INSERT INTO test VALUES (3, 3);",
+			"CALL DOLT_STASH('push');",
+			"CALL DOLT_CHECKOUT('main');",
+			"INSERT INTO test VALUES (4, 4);",
+			"CALL DOLT_STASH('push');",
+			"INSERT INTO dolt_branch_control VALUES ('%', 'main', 'testuser', 'localhost', 'write'), ('%', 'new%', 'testuser', 'localhost', 'write');",
+		},
+		Assertions: []BranchControlTestAssertion{
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET @@GLOBAL.dolt_enforce_branch_control_reads = 1;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT * FROM test AS OF 'main_tag';",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "SELECT * FROM test AS OF 'secret_tag';",
+				ExpectedErr: branch_control.ErrCannotReadCommit,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "SELECT * FROM test AS OF @secret_hash;",
+				ExpectedErr: branch_control.ErrCannotReadCommit,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "SELECT * FROM `mydb/secret_tag`.test;",
+				ExpectedErr: branch_control.ErrCannotReadCommit,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "USE `mydb/secret_tag`;",
+				ExpectedErr: branch_control.ErrCannotReadCommit,
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT * FROM `mydb/main_tag`.test;",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "SELECT * FROM dolt_diff('main', 'secret_tag', 'test');",
+				ExpectedErr: branch_control.ErrCannotReadCommit,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "SELECT * FROM dolt_diff('main', @secret_hash, 'test');",
+				ExpectedErr: branch_control.ErrCannotReadCommit,
+			},
+			{
+				User:        "testuser",
+				Host:        "localhost",
+				Query:       "CALL DOLT_BRANCH('new1', 'secret_tag');",
+				ExpectedErr: branch_control.ErrCannotReadCommit,
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT count(*) FROM dolt_commits WHERE message = 'secret commit';",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT count(*) FROM dolt_commits WHERE commit_hash = @secret_hash;",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT count(*) FROM dolt_commits WHERE message = 'setup commit';",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT name, branch FROM dolt_stashes;",
+				Expected: []sql.Row{{"stash@{0}", "main"}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT count(*) FROM dolt_commits WHERE message = 'secret commit';",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SELECT name, branch FROM dolt_stashes ORDER BY name;",
+				Expected: []sql.Row{{"stash@{0}", "main"}, {"stash@{1}", "secret"}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "INSERT INTO dolt_branch_control VALUES ('%', 'secret', 'testuser', 'localhost', 'read');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT * FROM test AS OF 'secret_tag' ORDER BY pk;",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "SELECT count(*) FROM dolt_commits WHERE message = 'secret commit';",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "SET @@GLOBAL.dolt_enforce_branch_control_reads = 0;",
+				Expected: []sql.Row{{}},
+			},
+		},
+	},
+	{
+		Name: "Protected branches may be added under broader rows",
+		SetUpScript: []string{
+			"CREATE USER testuser@localhost;",
+			"GRANT ALL ON *.* TO testuser@localhost;",
+		},
+		Assertions: []BranchControlTestAssertion{
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "INSERT INTO dolt_branch_control VALUES ('%', 'main', '%', '%', 'write,protected');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				User:        "root",
+				Host:        "localhost",
+				Query:       "INSERT INTO dolt_branch_control VALUES ('%', 'main', 'testuser', '%', 'write,protected');",
+				ExpectedErr: sql.ErrPrimaryKeyViolation,
+			},
+			{
+				User:  "root",
+				Host:  "localhost",
+				Query: "SELECT * FROM dolt_branch_control ORDER BY branch;",
+				Expected: []sql.Row{
+					{"%", "%", "%", "%", "write"},
+					{"%", "main", "%", "%", "write,protected"},
+				},
+			},
+			{ // Protection only applies to pushes, so normal writes are still allowed
+				User:     "testuser",
+				Host:     "localhost",
+				Query:    "CREATE TABLE test (pk BIGINT PRIMARY KEY);",
+				Expected: []sql.Row{{types.NewOkResult(0)}},
+			},
+		},
+	},
 }
 
 func TestBranchControl(t *testing.T) {
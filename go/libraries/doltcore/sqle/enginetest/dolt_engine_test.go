@@ -1254,6 +1254,16 @@ func TestDoltRevertPrepared(t *testing.T) {
 	RunDoltRevertPreparedTests(t, h)
 }
 
+func TestDoltStash(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltStashTests(t, h)
+}
+
+func TestDoltStashPrepared(t *testing.T) {
+	h := newDoltEnginetestHarness(t)
+	RunDoltStashPreparedTests(t, h)
+}
+
 func TestDoltAutoIncrement(t *testing.T) {
 	h := newDoltEnginetestHarness(t)
 	RunDoltAutoIncrementTests(t, h)
@@ -917,6 +917,28 @@ func RunDoltRevertPreparedTests(t *testing.T, h DoltEnginetestHarness) {
 	}
 }
 
+func RunDoltStashTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltStashTests {
+		// stashes are not reset between scripts. Use a new harness for each script
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			enginetest.TestScript(t, h, script)
+		}()
+	}
+}
+
+func RunDoltStashPreparedTests(t *testing.T, h DoltEnginetestHarness) {
+	for _, script := range DoltStashTests {
+		// stashes are not reset between scripts. Use a new harness for each script
+		func() {
+			h := h.NewHarness(t)
+			defer h.Close()
+			enginetest.TestScriptPrepared(t, h, script)
+		}()
+	}
+}
+
 func RunDoltAutoIncrementTests(t *testing.T, h DoltEnginetestHarness) {
 	for _, script := range DoltAutoIncrementTests {
 		// doing commits on different branches is antagonistic to engine reuse, use a new engine on each script
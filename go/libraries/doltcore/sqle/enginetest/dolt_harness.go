@@ -397,8 +397,9 @@ func (d *DoltHarness) newSessionWithClient(client sql.Client) *dsess.DoltSession
 	pro := d.session.Provider()
 
 	dSession, err := dsess.NewDoltSession(sql.NewBaseSessionWithClientServer("address", client, 1), pro.(dsess.DoltDatabaseProvider), localConfig, d.branchControl, d.statsPro, writer.NewWriteSession)
-	dSession.SetCurrentDatabase("mydb")
 	require.NoError(d.t, err)
+	dSession.SetCurrentDatabase("mydb")
+	dSession.SetQueryEngine(d.engine)
 	return dSession
 }
 
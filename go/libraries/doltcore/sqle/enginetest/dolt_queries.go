@@ -1490,6 +1490,40 @@ var DoltUserPrivTests = []queries.UserPrivilegeTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_commit_checks run with the privileges of the committing user",
+		SetUpScript: []string{
+			"CREATE TABLE mydb.secret (pk int PRIMARY KEY, v varchar(20));",
+			"INSERT INTO mydb.secret VALUES (1, 'password');",
+			"CREATE TABLE mydb.pub (pk int PRIMARY KEY);",
+			"INSERT INTO mydb.dolt_commit_checks VALUES ('leak', 'SELECT v FROM secret WHERE pk < 0');",
+			"CALL DOLT_COMMIT('-Am', 'add tables');",
+			"CREATE USER tester@localhost;",
+			"GRANT SELECT, INSERT, UPDATE, DELETE ON mydb.pub TO tester@localhost;",
+			"GRANT EXECUTE ON mydb.* TO tester@localhost;",
+		},
+		Assertions: []queries.UserPrivilegeTestAssertion{
+			{
+				User:     "tester",
+				Host:     "localhost",
+				Query:    "INSERT INTO mydb.pub VALUES (1);",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				// The check can't read the secret table, so it can't be used to read its rows
+				User:           "tester",
+				Host:           "localhost",
+				Query:          "CALL DOLT_COMMIT('-am', 'add pub row');",
+				ExpectedErrStr: "commit check 'leak' could not be run: command denied to user 'tester'@'localhost'",
+			},
+			{
+				User:     "root",
+				Host:     "localhost",
+				Query:    "CALL DOLT_COMMIT('-am', 'add pub row');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+		},
+	},
 	{
 		Name: "table function privilege checking",
 		SetUpScript: []string{
@@ -4656,6 +4690,171 @@ var LogTableFunctionScriptTests = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "filtering by author, message, date, renamed tables and rows",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 int)",
+			"call dolt_add('.')",
+			"call dolt_commit('-m', 'created table t [1]', '--author', 'Alice <alice@example.com>')",
+			"insert into t values (1, 10), (2, 20), (42, 420)",
+			"call dolt_commit('-am', 'inserted rows [2]', '--author', 'Bob <bob@example.com>')",
+			"update t set c1 = 421 where pk = 42",
+			"call dolt_commit('-am', 'fix: updated row 42 [3]', '--author', 'Alice <alice@example.com>')",
+			"update t set c1 = 21 where pk = 2",
+			"call dolt_commit('-am', 'updated row 2 [4]', '--author', 'Bob <bob@example.com>')",
+			"rename table t to u",
+			"call dolt_add('.')",
+			"call dolt_commit('-m', 'renamed t to u [5]')",
+			"update u set c1 = 422 where pk = 42",
+			"call dolt_commit('-am', 'fix: updated row 42 again [6]', '--author', 'Bob <bob@example.com>')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "select message from dolt_log('--author', 'Alice');",
+				Expected: []sql.Row{
+					{"fix: updated row 42 [3]"},
+					{"created table t [1]"},
+				},
+			},
+			{
+				Query: "select message from dolt_log('--author', 'bob@example\\.com');",
+				Expected: []sql.Row{
+					{"fix: updated row 42 again [6]"},
+					{"updated row 2 [4]"},
+					{"inserted rows [2]"},
+				},
+			},
+			{
+				Query: "select message from dolt_log('--grep', '^fix:');",
+				Expected: []sql.Row{
+					{"fix: updated row 42 again [6]"},
+					{"fix: updated row 42 [3]"},
+				},
+			},
+			{
+				Query: "select message from dolt_log('--author', 'Bob', '--grep', 'row 42');",
+				Expected: []sql.Row{
+					{"fix: updated row 42 again [6]"},
+				},
+			},
+			{
+				Query:    "select count(*) from dolt_log('--since', '2000-01-01');",
+				Expected: []sql.Row{{7}},
+			},
+			{
+				Query:    "select count(*) from dolt_log('--since', '2999-01-01');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select count(*) from dolt_log('--until', '2000-01-01');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query: "select message from dolt_log('--tables', 'u');",
+				Expected: []sql.Row{
+					{"fix: updated row 42 again [6]"},
+					{"renamed t to u [5]"},
+				},
+			},
+			{
+				Query: "select message from dolt_log('--tables', 'u', '--follow');",
+				Expected: []sql.Row{
+					{"fix: updated row 42 again [6]"},
+					{"renamed t to u [5]"},
+					{"updated row 2 [4]"},
+					{"fix: updated row 42 [3]"},
+					{"inserted rows [2]"},
+					{"created table t [1]"},
+				},
+			},
+			{
+				Query: "select message from dolt_log('--tables', 'u', '--where', 'pk = 42', '--follow');",
+				Expected: []sql.Row{
+					{"fix: updated row 42 again [6]"},
+					{"renamed t to u [5]"},
+					{"fix: updated row 42 [3]"},
+					{"inserted rows [2]"},
+				},
+			},
+			{
+				Query: "select message from dolt_log('--tables', 'u', '--follow', '--where', 'pk in (1, 2)');",
+				Expected: []sql.Row{
+					{"renamed t to u [5]"},
+					{"updated row 2 [4]"},
+					{"inserted rows [2]"},
+				},
+			},
+			{
+				Query: "select message from dolt_log('--tables', 'u', '--follow', '--where', 'c1 = 420');",
+				Expected: []sql.Row{
+					{"fix: updated row 42 [3]"},
+					{"inserted rows [2]"},
+				},
+			},
+			{
+				Query: "select message from dolt_log('--tables', 'u', '--where', 'pk > 2 and c1 > 421');",
+				Expected: []sql.Row{
+					{"fix: updated row 42 again [6]"},
+				},
+			},
+			{
+				Query:       "select * from dolt_log('--where', 'pk = 42');",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				Query:       "select * from dolt_log('--follow');",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				Query:       "select * from dolt_log('--tables', 'u,v', '--follow');",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				Query:       "select * from dolt_log('--author', '(');",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+			{
+				Query:       "select * from dolt_log('--since', 'yesterday');",
+				ExpectedErr: sql.ErrInvalidArgumentDetails,
+			},
+		},
+	},
+	{
+		Name: "following a table renamed on a merged branch",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 int)",
+			"create table other (pk int primary key)",
+			"call dolt_add('.')",
+			"call dolt_commit('-m', 'created tables')",
+			"call dolt_checkout('-b', 'branch1')",
+			"insert into t values (1, 10)",
+			"call dolt_commit('-am', 'inserted row 1')",
+			"rename table t to u",
+			"call dolt_add('.')",
+			"call dolt_commit('-m', 'renamed t to u')",
+			"call dolt_checkout('main')",
+			"insert into other values (1)",
+			"call dolt_commit('-am', 'inserted into other')",
+			"call dolt_merge('branch1', '-m', 'merged branch1')",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "select message from dolt_log('--tables', 'u', '--follow') order by message;",
+				Expected: []sql.Row{
+					{"created tables"},
+					{"inserted row 1"},
+					{"renamed t to u"},
+				},
+			},
+			{
+				Query: "select message from dolt_log('--tables', 'u', '--where', 'pk = 1', '--follow') order by message;",
+				Expected: []sql.Row{
+					{"inserted row 1"},
+					{"renamed t to u"},
+				},
+			},
+		},
+	},
 	{
 		Name: "min parents, merges, show parents, decorate",
 		SetUpScript: []string{
@@ -6061,7 +6260,77 @@ var DoltCherryPickTests = []queries.ScriptTest{
 			},
 			{
 				Query:          "CALL dolt_cherry_pick('HEAD');",
-				ExpectedErrStr: "cherry-picking a merge commit is not supported",
+				ExpectedErrStr: "cherry-picking a merge commit is not supported without specifying a parent number with -m",
+			},
+		},
+	},
+	{
+		Name: "cherry-pick merge commits with a mainline parent",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(100));",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_branch('release');",
+			"call dolt_checkout('-b', 'feature');",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-am', 'adding row 1');",
+			"insert into t values (3, 'three');",
+			"call dolt_commit('-am', 'adding row 3');",
+			"call dolt_checkout('main');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"call dolt_merge('feature', '-m', 'merge feature');",
+			"call dolt_checkout('release');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_cherry_pick('-m', '0', 'main');",
+				ExpectedErrStr: "error: invalid parent number 0, parents are numbered starting from 1",
+			},
+			{
+				Query:    "call dolt_cherry_pick('-m', '1', 'main');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, "one"}, {3, "three"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"merge feature"}},
+			},
+			{
+				// Assert that our new commit only has one parent (i.e. not a merge commit)
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD');",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "call dolt_reset('--hard', 'HEAD~1');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "call dolt_cherry_pick('--mainline', '2', 'main');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{2, "two"}},
+			},
+			{
+				Query:    "call dolt_reset('--hard', 'HEAD~1');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				// -m only applies to the merge commit in the range, and the other commit is cherry-picked as usual
+				Query:    "call dolt_cherry_pick('-m', '1', 'feature..main');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, "one"}, {2, "two"}, {3, "three"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 2;",
+				Expected: []sql.Row{{"merge feature"}, {"adding row 2"}},
 			},
 		},
 	},
@@ -6093,20 +6362,29 @@ var DoltCherryPickTests = []queries.ScriptTest{
 		},
 	},
 	{
-		Name: "error cases: different primary keys",
+		Name: "different primary keys",
 		SetUpScript: []string{
 			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
 			"call dolt_commit('-Am', 'create table t');",
 			"call dolt_checkout('-b', 'branch1');",
 			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (pk, v);",
-			"call dolt_commit('-am', 'adding row 1');",
+			"call dolt_commit('-am', 'changing primary key');",
 			"set @commit1 = hashof('HEAD');",
 			"call dolt_checkout('main');",
 		},
 		Assertions: []queries.ScriptTestAssertion{
 			{
-				Query:          "CALL Dolt_Cherry_Pick(@commit1);",
-				ExpectedErrStr: "error: cannot merge because table t has different primary keys",
+				Query:    "CALL Dolt_Cherry_Pick(@commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select column_name from information_schema.key_column_usage where table_name = 't' and constraint_name = 'PRIMARY' order by ordinal_position;",
+				Expected: []sql.Row{{"pk"}, {"v"}},
+			},
+			{
+				Query:    "SELECT * FROM t;",
+				Expected: []sql.Row{{1, "one"}},
 			},
 		},
 	},
@@ -6598,6 +6876,305 @@ var DoltCherryPickTests = []queries.ScriptTest{
 			},*/
 		},
 	},
+	{
+		Name: "cherry-pick multiple commits and ranges",
+		SetUpScript: []string{
+			"create table t (pk int primary key, v varchar(100));",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-am', 'adding row 1');",
+			"set @commit1 = hashof('HEAD');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"set @commit2 = hashof('HEAD');",
+			"insert into t values (3, 'three');",
+			"call dolt_commit('-am', 'adding row 3');",
+			"set @commit3 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+			"call dolt_branch('branch2');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(@commit3, @commit1);",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, "one"}, {3, "three"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 2;",
+				Expected: []sql.Row{{"adding row 1"}, {"adding row 3"}},
+			},
+			{
+				Query:    "call dolt_checkout('branch2');",
+				Expected: []sql.Row{{0, "Switched to branch 'branch2'"}},
+			},
+			{
+				Query:    "call dolt_cherry_pick('main..branch1');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select message from dolt_log limit 3;",
+				Expected: []sql.Row{{"adding row 3"}, {"adding row 2"}, {"adding row 1"}},
+			},
+			{
+				Query:          "call dolt_cherry_pick('branch1..branch1');",
+				ExpectedErrStr: "error: empty commit set passed",
+			},
+			{
+				Query:          "call dolt_cherry_pick('main...branch1');",
+				ExpectedErrStr: "error: cherry-picking a symmetric difference is not supported: main...branch1",
+			},
+			{
+				Query:          "call dolt_cherry_pick('--continue');",
+				ExpectedErrStr: "error: There is no cherry-pick in progress",
+			},
+		},
+	},
+	{
+		Name: "cherry-pick multiple commits: continue after resolving conflicts",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"update t set v = 'uno' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> uno');",
+			"set @commit1 = hashof('HEAD');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"set @commit2 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+			"update t set v = 'ein' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> ein');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(@commit1, @commit2);",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:          "call dolt_cherry_pick('--continue');",
+				ExpectedErrStr: "error: cannot continue cherry-pick with unresolved conflicts or constraint violations in tables: t",
+			},
+			{
+				Query:    "call dolt_conflicts_resolve('--theirs', 't');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query: "call dolt_cherry_pick('--continue');",
+				ExpectedErrStr: "error: cannot continue a cherry-pick with unstaged changes. " +
+					"Use dolt_add() to stage the resolved tables, then continue the cherry-pick with dolt_cherry_pick('--continue')",
+			},
+			{
+				Query:    "call dolt_add('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "call dolt_cherry_pick('--continue');",
+				Expected: []sql.Row{{doltCommit, 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, "uno"}, {2, "two"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 3;",
+				Expected: []sql.Row{{"adding row 2"}, {"updating row 1 -> uno"}, {"updating row 1 -> ein"}},
+			},
+			{
+				// Assert that the resolved commit only has one parent (i.e. not a merge commit)
+				Query:    "select count(*) from dolt_commit_ancestors where commit_hash = hashof('HEAD~1');",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:    "select count(*) from dolt_merge_status where is_merging;",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "cherry-pick multiple commits: abort resets to the HEAD from before the sequence",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"set @commit1 = hashof('HEAD');",
+			"update t set v = 'uno' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> uno');",
+			"set @commit2 = hashof('HEAD');",
+			"insert into t values (3, 'three');",
+			"call dolt_commit('-am', 'adding row 3');",
+			"set @commit3 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+			"update t set v = 'ein' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> ein');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(@commit1, @commit2, @commit3);",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"adding row 2"}},
+			},
+			{
+				Query:    "call dolt_cherry_pick('--abort');",
+				Expected: []sql.Row{{"", 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, "ein"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 2;",
+				Expected: []sql.Row{{"updating row 1 -> ein"}, {"create table t"}},
+			},
+			{
+				Query:    "select count(*) from dolt_status;",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:          "call dolt_cherry_pick('--continue');",
+				ExpectedErrStr: "error: There is no cherry-pick in progress",
+			},
+		},
+	},
+	{
+		Name: "cherry-pick multiple commits: abort after continuing resets to the HEAD from before the sequence",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"update t set v = 'uno' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> uno');",
+			"set @commit1 = hashof('HEAD');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"set @commit2 = hashof('HEAD');",
+			"insert into t values (3, 'three');",
+			"call dolt_commit('-am', 'adding row 3');",
+			"set @commit3 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+			"update t set v = 'ein' where pk = 1;",
+			"insert into t values (3, 'drei');",
+			"call dolt_commit('-am', 'updating row 1 -> ein');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_cherry_pick(@commit1, @commit2, @commit3);",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "call dolt_conflicts_resolve('--theirs', 't');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "call dolt_add('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				// The second commit applies cleanly, and the third conflicts with row 3 on main
+				Query:    "call dolt_cherry_pick('--continue');",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "select message from dolt_log limit 2;",
+				Expected: []sql.Row{{"adding row 2"}, {"updating row 1 -> uno"}},
+			},
+			{
+				Query:    "call dolt_cherry_pick('--abort');",
+				Expected: []sql.Row{{"", 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, "ein"}, {3, "drei"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"updating row 1 -> ein"}},
+			},
+			{
+				Query:    "select count(*) from dolt_merge_status where is_merging;",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "cherry-pick multiple commits: skip the conflicting commit and continue with the remaining commits",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"create table t (pk int primary key, v varchar(100));",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-Am', 'create table t');",
+			"call dolt_checkout('-b', 'branch1');",
+			"update t set v = 'uno' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> uno');",
+			"set @commit1 = hashof('HEAD');",
+			"insert into t values (2, 'two');",
+			"call dolt_commit('-am', 'adding row 2');",
+			"set @commit2 = hashof('HEAD');",
+			"update t set v = 'eins' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> eins');",
+			"set @commit3 = hashof('HEAD');",
+			"call dolt_checkout('main');",
+			"update t set v = 'ein' where pk = 1;",
+			"call dolt_commit('-am', 'updating row 1 -> ein');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_cherry_pick('--skip');",
+				ExpectedErrStr: "error: There is no cherry-pick in progress",
+			},
+			{
+				Query:    "call dolt_cherry_pick(@commit1, @commit2, @commit3);",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:          "call dolt_cherry_pick('--skip', @commit2);",
+				ExpectedErrStr: "error: --skip does not take any commits",
+			},
+			{
+				Query:          "call dolt_cherry_pick('--continue', '--skip');",
+				ExpectedErrStr: "error: --continue and --skip cannot be used together",
+			},
+			{
+				// commit2 applies cleanly, and commit3 conflicts again
+				Query:    "call dolt_cherry_pick('--skip');",
+				Expected: []sql.Row{{"", 1, 0, 0}},
+			},
+			{
+				Query:    "select message from dolt_log limit 2;",
+				Expected: []sql.Row{{"adding row 2"}, {"updating row 1 -> ein"}},
+			},
+			{
+				Query:    "call dolt_cherry_pick('--skip');",
+				Expected: []sql.Row{{"", 0, 0, 0}},
+			},
+			{
+				Query:    "select * from t order by pk;",
+				Expected: []sql.Row{{1, "ein"}, {2, "two"}},
+			},
+			{
+				Query:    "select count(*) from dolt_merge_status where is_merging;",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select count(*) from dolt_status;",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
 }
 
 var DoltCommitTests = []queries.ScriptTest{
@@ -7006,6 +7583,175 @@ var DoltCommitTests = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_commit_checks rejects commits when a check returns rows",
+		SetUpScript: []string{
+			"CREATE TABLE accounts (id int PRIMARY KEY, balance int);",
+			"INSERT INTO dolt_commit_checks VALUES ('no negative balances', 'SELECT id FROM accounts WHERE balance < 0');",
+			"CALL DOLT_COMMIT('-Am', 'add accounts');",
+			"INSERT INTO accounts VALUES (1, 10), (2, -5);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "SELECT * FROM dolt_commit_checks;",
+				Expected: []sql.Row{{"no negative balances", "SELECT id FROM accounts WHERE balance < 0"}},
+			},
+			{
+				Query:          "CALL DOLT_COMMIT('-am', 'add balances');",
+				ExpectedErrStr: "commit check 'no negative balances' failed: query `SELECT id FROM accounts WHERE balance < 0` returned row [2]",
+			},
+			{
+				Query:    "UPDATE accounts SET balance = 0 WHERE id = 2;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "CALL DOLT_COMMIT('-am', 'add balances');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:    "SELECT message FROM dolt_log LIMIT 1;",
+				Expected: []sql.Row{{"add balances"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_commit_checks run against the staged root",
+		SetUpScript: []string{
+			"CREATE TABLE staged_checks (pk int PRIMARY KEY, v int);",
+			"INSERT INTO dolt_commit_checks VALUES ('positive values', 'SELECT * FROM staged_checks WHERE v <= 0');",
+			"CALL DOLT_COMMIT('-Am', 'add t');",
+			"INSERT INTO staged_checks VALUES (1, 1);",
+			"CALL DOLT_ADD('staged_checks');",
+			"UPDATE staged_checks SET v = -1 WHERE pk = 1;",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_COMMIT('-m', 'commit staged row');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:          "CALL DOLT_COMMIT('-am', 'commit working row');",
+				ExpectedErrStr: "commit check 'positive values' failed: query `SELECT * FROM staged_checks WHERE v <= 0` returned row [1,-1]",
+			},
+			{
+				Query:    "SELECT * FROM staged_checks;",
+				Expected: []sql.Row{{1, -1}},
+			},
+			{
+				Query:    "SELECT * FROM staged_checks AS OF 'HEAD';",
+				Expected: []sql.Row{{1, 1}},
+			},
+		},
+	},
+	{
+		Name: "dolt_commit_checks can't be skipped by the commit that deletes or changes them",
+		SetUpScript: []string{
+			"CREATE TABLE skipped_checks (pk int PRIMARY KEY, v int);",
+			"INSERT INTO dolt_commit_checks VALUES ('no skipped negatives', 'SELECT * FROM skipped_checks WHERE v <= 0');",
+			"CALL DOLT_COMMIT('-Am', 'add check');",
+			"INSERT INTO skipped_checks VALUES (1, -1);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "DELETE FROM dolt_commit_checks WHERE name = 'no skipped negatives';",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:          "CALL DOLT_COMMIT('-am', 'delete check');",
+				ExpectedErrStr: "commit check 'no skipped negatives' failed: query `SELECT * FROM skipped_checks WHERE v <= 0` returned row [1,-1]",
+			},
+			{
+				Query:    "INSERT INTO dolt_commit_checks VALUES ('no skipped negatives', 'SELECT * FROM skipped_checks WHERE v < -1');",
+				Expected: []sql.Row{{types.NewOkResult(1)}},
+			},
+			{
+				Query:          "CALL DOLT_COMMIT('-am', 'weaken check');",
+				ExpectedErrStr: "commit check 'no skipped negatives' failed: query `SELECT * FROM skipped_checks WHERE v <= 0` returned row [1,-1]",
+			},
+			{
+				Query:    "UPDATE skipped_checks SET v = 1;",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:    "CALL DOLT_COMMIT('-am', 'weaken check');",
+				Expected: []sql.Row{{doltCommit}},
+			},
+			{
+				Query:    "SELECT query FROM dolt_commit_checks WHERE name = 'no skipped negatives';",
+				Expected: []sql.Row{{"SELECT * FROM skipped_checks WHERE v < -1"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_commit_checks reject merge commits",
+		SetUpScript: []string{
+			"CREATE TABLE parent (id int PRIMARY KEY);",
+			"CREATE TABLE child (id int PRIMARY KEY, parent_id int);",
+			"CALL DOLT_COMMIT('-Am', 'add tables');",
+			"CALL DOLT_BRANCH('other');",
+			"INSERT INTO dolt_commit_checks VALUES ('orphans', 'SELECT c.id FROM child c LEFT JOIN parent p ON c.parent_id = p.id WHERE p.id IS NULL');",
+			"INSERT INTO parent VALUES (1);",
+			"CALL DOLT_COMMIT('-Am', 'add check');",
+			"CALL DOLT_CHECKOUT('other');",
+			"INSERT INTO child VALUES (1, 1), (2, 2);",
+			"CALL DOLT_COMMIT('-am', 'add children');",
+			"CALL DOLT_CHECKOUT('main');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL DOLT_MERGE('other');",
+				ExpectedErrStr: "commit check 'orphans' failed: query `SELECT c.id FROM child c LEFT JOIN parent p ON c.parent_id = p.id WHERE p.id IS NULL` returned row [2]",
+			},
+		},
+	},
+	{
+		Name: "dolt_commit_checks must be SELECT statements",
+		SetUpScript: []string{
+			"CREATE TABLE select_checks (pk int PRIMARY KEY);",
+			"INSERT INTO dolt_commit_checks VALUES ('recurse', 'CALL DOLT_COMMIT(\"--allow-empty\", \"-m\", \"nested\")');",
+			"CALL DOLT_ADD('.');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL DOLT_COMMIT('-m', 'rejected');",
+				ExpectedErrStr: "commit check 'recurse' must be a SELECT statement",
+			},
+			{
+				Query:    "UPDATE dolt_commit_checks SET query = 'SELECT * FROM select_checks INTO @x' WHERE name = 'recurse';",
+				Expected: []sql.Row{{types.OkResult{RowsAffected: 1, Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:          "CALL DOLT_COMMIT('-am', 'rejected');",
+				ExpectedErrStr: "commit check 'recurse' must be a SELECT statement",
+			},
+			{
+				Query:    "SELECT COUNT(*) FROM dolt_log WHERE message IN ('rejected', 'nested');",
+				Expected: []sql.Row{{0}},
+			},
+		},
+	},
+	{
+		Name: "CALL DOLT_COMMIT('--trailer') can be repeated and keeps commas in trailer values",
+		SetUpScript: []string{
+			"CREATE table trailers_t (pk int primary key);",
+			"CALL DOLT_ADD('trailers_t');",
+			"CALL DOLT_COMMIT('--trailer', 'Reviewed-by: Jane Doe <jane@doe.com>', '-m', 'add table trailers_t', '--trailer', 'Ticket=DB-1, DB-2');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "select message, trailers from dolt_log limit 1",
+				Expected: []sql.Row{{"add table trailers_t", "Reviewed-by: Jane Doe <jane@doe.com>\nTicket: DB-1, DB-2"}},
+			},
+			{
+				Query:    "select commit_hash = parents, trailers from dolt_log('--parents') limit 1",
+				Expected: []sql.Row{{false, "Reviewed-by: Jane Doe <jane@doe.com>\nTicket: DB-1, DB-2"}},
+			},
+			{
+				Query:          "CALL DOLT_COMMIT('--allow-empty', '-m', 'positional', '--trailer', 'Ticket: DB-3', 'extra');",
+				ExpectedErrStr: "error: commit does not take positional arguments, but found 1: extra",
+			},
+		},
+	},
 }
 
 var DoltIndexPrefixScripts = []queries.ScriptTest{
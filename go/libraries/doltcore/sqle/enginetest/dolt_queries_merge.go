@@ -2159,6 +2159,122 @@ var MergeScripts = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "Merge a primary key change on one side with row changes on the other",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int PRIMARY KEY, code varchar(20) NOT NULL, v int);",
+			"INSERT INTO t VALUES (1, 'a', 1), (2, 'b', 2), (3, 'c', 3);",
+			"CALL DOLT_COMMIT('-Am', 'setup');",
+
+			"CALL DOLT_CHECKOUT('-b', 'right');",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (code);",
+			"UPDATE t SET v = 20 WHERE code = 'b';",
+			"CALL DOLT_COMMIT('-am', 'right commit');",
+
+			"CALL DOLT_CHECKOUT('main');",
+			"UPDATE t SET v = 10 WHERE id = 1;",
+			"DELETE FROM t WHERE id = 3;",
+			"INSERT INTO t VALUES (4, 'd', 4);",
+			"CALL DOLT_COMMIT('-am', 'left commit');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('right');",
+				Expected: []sql.Row{{doltCommit, 0, 0, "merge successful"}},
+			},
+			{
+				Query:    "select column_name from information_schema.key_column_usage where table_name = 't' and constraint_name = 'PRIMARY';",
+				Expected: []sql.Row{{"code"}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY code;",
+				Expected: []sql.Row{{1, "a", 10}, {2, "b", 20}, {4, "d", 4}},
+			},
+		},
+	},
+	{
+		Name: "Merge a primary key change on one side with a row that collides on the new key",
+		SetUpScript: []string{
+			"SET dolt_force_transaction_commit = on;",
+			"CREATE TABLE t (id int PRIMARY KEY, code varchar(20) NOT NULL, v int);",
+			"INSERT INTO t VALUES (1, 'a', 1), (2, 'b', 2);",
+			"CALL DOLT_COMMIT('-Am', 'setup');",
+
+			"CALL DOLT_CHECKOUT('-b', 'right');",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (code);",
+			"CALL DOLT_COMMIT('-am', 'right commit');",
+
+			"CALL DOLT_CHECKOUT('main');",
+			"INSERT INTO t VALUES (3, 'a', 3);",
+			"CALL DOLT_COMMIT('-am', 'left commit');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('right');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY code;",
+				Expected: []sql.Row{{1, "a", 1}, {2, "b", 2}},
+			},
+			{
+				Query:    "SELECT violation_type, id, code, v FROM dolt_constraint_violations_t;",
+				Expected: []sql.Row{{"unique index", 3, "a", 3}},
+			},
+		},
+	},
+	{
+		Name: "Merge a primary key change on one side with a NULL in the new key on the other",
+		SetUpScript: []string{
+			"set @@autocommit=0;",
+			"CREATE TABLE t (id int PRIMARY KEY, code varchar(20), v int);",
+			"INSERT INTO t VALUES (1, 'a', 1), (2, 'b', 2);",
+			"CALL DOLT_COMMIT('-Am', 'setup');",
+
+			"CALL DOLT_CHECKOUT('-b', 'right');",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (code);",
+			"CALL DOLT_COMMIT('-am', 'right commit');",
+
+			"CALL DOLT_CHECKOUT('main');",
+			"INSERT INTO t VALUES (3, NULL, 3);",
+			"CALL DOLT_COMMIT('-am', 'left commit');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "CALL DOLT_MERGE('right');",
+				Expected: []sql.Row{{"", 0, 1, "conflicts found"}},
+			},
+			{
+				Query:    "SELECT table_name, description FROM dolt_schema_conflicts;",
+				Expected: []sql.Row{{"t", "the primary key was changed in one branch, and rows in the other branch have NULL values in its columns"}},
+			},
+			{
+				Query:    "SELECT * FROM t ORDER BY id;",
+				Expected: []sql.Row{{1, "a", 1}, {2, "b", 2}, {3, nil, 3}},
+			},
+		},
+	},
+	{
+		Name: "Merge errors if both sides change the primary key differently",
+		SetUpScript: []string{
+			"CREATE TABLE t (id int PRIMARY KEY, code varchar(20) NOT NULL, v int NOT NULL);",
+			"CALL DOLT_COMMIT('-Am', 'setup');",
+
+			"CALL DOLT_CHECKOUT('-b', 'right');",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (code);",
+			"CALL DOLT_COMMIT('-am', 'right commit');",
+
+			"CALL DOLT_CHECKOUT('main');",
+			"ALTER TABLE t DROP PRIMARY KEY, ADD PRIMARY KEY (v);",
+			"CALL DOLT_COMMIT('-am', 'left commit');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "CALL DOLT_MERGE('right');",
+				ExpectedErrStr: "error: cannot merge because table t has different primary keys",
+			},
+		},
+	},
 	{
 		Name:        "`Delete from table` should keep artifacts - conflicts",
 		SetUpScript: createConflictsSetupScript,
@@ -710,6 +710,163 @@ var DoltRebaseScriptTests = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		Name: "dolt_rebase: edit action stops the rebase to amend a commit",
+		SetUpScript: []string{
+			"create table t (pk int primary key, c1 varchar(100));",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_branch('branch1');",
+
+			"insert into t values (0, 'zero');",
+			"call dolt_commit('-am', 'inserting row 0');",
+
+			"call dolt_checkout('branch1');",
+			"insert into t values (1, 'one');",
+			"call dolt_commit('-am', 'inserting row 1');",
+			"insert into t values (2, 'twwo');",
+			"call dolt_commit('-am', 'inserting row 2');",
+			"insert into t values (3, 'three');",
+			"call dolt_commit('-am', 'inserting row 3');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "call dolt_rebase('-i', 'main');",
+				Expected: []sql.Row{{0, "interactive rebase started on branch dolt_rebase_branch1; " +
+					"adjust the rebase plan in the dolt_rebase table, then " +
+					"continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query: "update dolt_rebase set action='edit' where rebase_order = 2;",
+				Expected: []sql.Row{{gmstypes.OkResult{RowsAffected: uint64(1), Info: plan.UpdateInfo{
+					Matched: 1,
+					Updated: 1,
+				}}}},
+			},
+			{
+				Query:            "call dolt_rebase('--continue');",
+				SkipResultsCheck: true,
+			},
+			{
+				// The rebase stops on the working branch, right after the edited commit is applied
+				Query:    "select active_branch();",
+				Expected: []sql.Row{{"dolt_rebase_branch1"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"inserting row 2"}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{0, "zero"}, {1, "one"}, {2, "twwo"}},
+			},
+			{
+				Query:    "update t set c1='two' where pk=2;",
+				Expected: []sql.Row{{gmstypes.OkResult{RowsAffected: uint64(1), Info: plan.UpdateInfo{Matched: 1, Updated: 1}}}},
+			},
+			{
+				Query:          "call dolt_rebase('--continue');",
+				ExpectedErrStr: dprocedures.ErrRebaseUnstagedChanges.New().Error(),
+			},
+			{
+				Query:    "call dolt_add('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/branch1"}},
+			},
+			{
+				// The staged changes were amended into the edited commit, instead of making a new commit
+				Query: "select message from dolt_log;",
+				Expected: []sql.Row{
+					{"inserting row 3"},
+					{"inserting row 2"},
+					{"inserting row 1"},
+					{"inserting row 0"},
+					{"creating table t"},
+					{"Initialize data repository"}},
+			},
+			{
+				Query:    "select * from t as of 'HEAD~1';",
+				Expected: []sql.Row{{0, "zero"}, {1, "one"}, {2, "two"}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{0, "zero"}, {1, "one"}, {2, "two"}, {3, "three"}},
+			},
+		},
+	},
+	{
+		Name: "dolt_rebase: break action stops the rebase between steps",
+		SetUpScript: []string{
+			"create table t (pk int primary key);",
+			"call dolt_commit('-Am', 'creating table t');",
+			"call dolt_branch('branch1');",
+
+			"insert into t values (0);",
+			"call dolt_commit('-am', 'inserting row 0');",
+
+			"call dolt_checkout('branch1');",
+			"insert into t values (1);",
+			"call dolt_commit('-am', 'inserting row 1');",
+			"insert into t values (2);",
+			"call dolt_commit('-am', 'inserting row 2');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "call dolt_rebase('-i', 'main');",
+				Expected: []sql.Row{{0, "interactive rebase started on branch dolt_rebase_branch1; " +
+					"adjust the rebase plan in the dolt_rebase table, then " +
+					"continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query:    "insert into dolt_rebase values (1.5, 'break', '', '');",
+				Expected: []sql.Row{{gmstypes.NewOkResult(1)}},
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Rebase stopped at break; continue rebasing by calling dolt_rebase('--continue')"}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"inserting row 1"}},
+			},
+			{
+				Query:    "insert into t values (100);",
+				Expected: []sql.Row{{gmstypes.NewOkResult(1)}},
+			},
+			{
+				Query:    "call dolt_add('t');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:          "call dolt_rebase('--continue');",
+				ExpectedErrStr: dprocedures.ErrRebaseBreakStagedChanges.New().Error(),
+			},
+			{
+				Query:            "call dolt_commit('-m', 'inserting row 100');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "call dolt_rebase('--continue');",
+				Expected: []sql.Row{{0, "Successfully rebased and updated refs/heads/branch1"}},
+			},
+			{
+				Query: "select message from dolt_log;",
+				Expected: []sql.Row{
+					{"inserting row 2"},
+					{"inserting row 100"},
+					{"inserting row 1"},
+					{"inserting row 0"},
+					{"creating table t"},
+					{"Initialize data repository"}},
+			},
+			{
+				Query:    "select * from t;",
+				Expected: []sql.Row{{0}, {1}, {2}, {100}},
+			},
+		},
+	},
 	{
 		Name: "dolt_rebase: negative rebase order",
 		SetUpScript: []string{
@@ -179,4 +179,50 @@ var RevertScripts = []queries.ScriptTest{
 			},
 		},
 	},
+	{
+		SkipPrepared: true, // https://github.com/dolthub/dolt/issues/6300
+		Name:         "dolt_revert() reverts a merge commit relative to a mainline parent",
+		SetUpScript: []string{
+			"create table test (pk int primary key, c0 int)",
+			"insert into test values (1,1),(2,2),(3,3);",
+			"call dolt_commit('-Am', 'seed table');",
+			"call dolt_checkout('-b', 'feature');",
+			"insert into test values (4,4);",
+			"call dolt_commit('-am', 'add row 4 on feature');",
+			"call dolt_checkout('main');",
+			"insert into test values (5,5);",
+			"call dolt_commit('-am', 'add row 5 on main');",
+			"call dolt_merge('feature', '-m', 'merge feature');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_revert('-m', '0', 'HEAD');",
+				ExpectedErrStr: "error: invalid parent number 0, parents are numbered starting from 1",
+			},
+			{
+				Query:    "call dolt_revert('-m', '1', 'HEAD');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from test order by pk;",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}, {5, 5}},
+			},
+			{
+				Query:    "select message from dolt_log limit 1;",
+				Expected: []sql.Row{{"Revert \"merge feature\""}},
+			},
+			{
+				Query:    "call dolt_reset('--hard', 'HEAD~1');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "call dolt_revert('--mainline', '2', 'HEAD');",
+				Expected: []sql.Row{{0}},
+			},
+			{
+				Query:    "select * from test order by pk;",
+				Expected: []sql.Row{{1, 1}, {2, 2}, {3, 3}, {4, 4}},
+			},
+		},
+	},
 }
@@ -0,0 +1,294 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package enginetest
+
+import (
+	"github.com/dolthub/go-mysql-server/enginetest/queries"
+	"github.com/dolthub/go-mysql-server/sql"
+)
+
+var DoltStashTests = []queries.ScriptTest{
+	{
+		Name: "dolt_stash: push and pop working set changes",
+		SetUpScript: []string{
+			"create table test (pk int primary key, c0 int)",
+			"insert into test values (1,1);",
+			"call dolt_commit('-Am', 'seed table');",
+			"insert into test values (2,2);",
+			"call dolt_stash('push');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "select * from test;",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query:    "select * from dolt_status;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "select name, stash_index, branch, commit_message from dolt_stashes;",
+				Expected: []sql.Row{{"stash@{0}", 0, "main", "seed table"}},
+			},
+			{
+				Query:    "select count(*) from dolt_stashes join dolt_log on dolt_stashes.commit_hash = dolt_log.commit_hash;",
+				Expected: []sql.Row{{1}},
+			},
+			{
+				Query:            "call dolt_stash('pop');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "select * from test;",
+				Expected: []sql.Row{{1, 1}, {2, 2}},
+			},
+			{
+				Query:    "select * from dolt_status;",
+				Expected: []sql.Row{{"test", false, "modified"}},
+			},
+			{
+				Query:    "select * from dolt_stashes;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt_stash: nothing to stash",
+		SetUpScript: []string{
+			"create table test (pk int primary key, c0 int)",
+			"call dolt_commit('-Am', 'seed table');",
+			"create table untracked (pk int primary key);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_stash();",
+				Expected: []sql.Row{{0, "No local changes to save"}},
+			},
+			{
+				Query:    "call dolt_stash('push');",
+				Expected: []sql.Row{{0, "No local changes to save"}},
+			},
+			{
+				Query:    "call dolt_stash('list');",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt_stash: untracked tables are stashed with --include-untracked",
+		SetUpScript: []string{
+			"create table test (pk int primary key, c0 int)",
+			"call dolt_commit('-Am', 'seed table');",
+			"create table untracked (pk int primary key);",
+			"create table added (pk int primary key);",
+			"call dolt_add('added');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:            "call dolt_stash('push', '-u');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "show tables;",
+				Expected: []sql.Row{{"test"}},
+			},
+			{
+				Query:            "call dolt_stash('pop', 'stash@{0}');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query: "select * from dolt_status order by table_name;",
+				Expected: []sql.Row{
+					{"added", true, "new table"},
+					{"untracked", false, "new table"},
+				},
+			},
+		},
+	},
+	{
+		Name: "dolt_stash: list, drop and clear stashes from multiple branches",
+		SetUpScript: []string{
+			"create table test (pk int primary key, c0 int)",
+			"call dolt_commit('-Am', 'seed table');",
+			"insert into test values (1,1);",
+			"call dolt_stash('push');",
+			"call dolt_checkout('-b', 'other');",
+			"insert into test values (2,2);",
+			"call dolt_commit('-am', 'insert on other');",
+			"insert into test values (3,3);",
+			"call dolt_stash('push');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "select name, stash_index, branch, commit_message from dolt_stashes order by stash_index;",
+				Expected: []sql.Row{
+					{"stash@{0}", 0, "other", "insert on other"},
+					{"stash@{1}", 1, "main", "seed table"},
+				},
+			},
+			{
+				Query:    "select name from dolt_stashes where branch = 'main';",
+				Expected: []sql.Row{{"stash@{1}"}},
+			},
+			{
+				Query:            "call dolt_stash('list');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:            "call dolt_stash('drop', 'stash@{1}');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "select name, branch from dolt_stashes;",
+				Expected: []sql.Row{{"stash@{0}", "other"}},
+			},
+			{
+				Query:    "call dolt_stash('clear');",
+				Expected: []sql.Row{{0, ""}},
+			},
+			{
+				Query:    "select * from dolt_stashes;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+	{
+		Name: "dolt_stash: pop keeps the stash when it conflicts with the working set",
+		SetUpScript: []string{
+			"create table test (pk int primary key, c0 int)",
+			"call dolt_commit('-Am', 'seed table');",
+			"insert into test values (1,1);",
+			"call dolt_stash('push');",
+			"insert into test values (1,2);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query: "call dolt_stash('pop');",
+				ExpectedErrStr: "error: Your local changes to the following tables would be overwritten by applying stash 0: {'test'}. " +
+					"Please commit your changes or stash them before you merge. The stash entry is kept in case you need it again.",
+			},
+			{
+				Query:    "select * from test;",
+				Expected: []sql.Row{{1, 2}},
+			},
+			{
+				Query:    "select count(*) from dolt_stashes;",
+				Expected: []sql.Row{{1}},
+			},
+		},
+	},
+	{
+		Name: "dolt_stash: push with a message",
+		SetUpScript: []string{
+			"create table test (pk int primary key, c0 int)",
+			"call dolt_commit('-Am', 'seed table');",
+			"insert into test values (1,1);",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "call dolt_stash('push', '-m', 'wip inserts');",
+				Expected: []sql.Row{{0, "Saved working directory and index state On refs/heads/main: wip inserts"}},
+			},
+			{
+				Query:    "call dolt_stash('list');",
+				Expected: []sql.Row{{0, "stash@{0}: On refs/heads/main: wip inserts"}},
+			},
+			{
+				Query:          "call dolt_stash('drop', '-m', 'msg');",
+				ExpectedErrStr: "error: --message can only be used with push",
+			},
+		},
+	},
+	{
+		Name: "dolt_stash: invalid arguments",
+		SetUpScript: []string{
+			"create table test (pk int primary key, c0 int)",
+			"call dolt_commit('-Am', 'seed table');",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:          "call dolt_stash('pop');",
+				ExpectedErrStr: "No stash entries found.",
+			},
+			{
+				Query:          "call dolt_stash('drop', 'stash@{x}');",
+				ExpectedErrStr: "error: x is not a valid reference",
+			},
+			{
+				Query:          "call dolt_stash('apply-all');",
+				ExpectedErrStr: "error: invalid stash subcommand 'apply-all', expected one of push, pop, drop, clear or list",
+			},
+			{
+				Query:          "call dolt_stash('pop', '-u');",
+				ExpectedErrStr: "error: --include-untracked and --all can only be used with push",
+			},
+			{
+				Query:          "call dolt_stash('list', 'stash@{0}');",
+				ExpectedErrStr: "error: stash list does not take a stash reference",
+			},
+		},
+	},
+	{
+		Name: "dolt_stash: stashes can't be changed inside an explicit transaction",
+		SetUpScript: []string{
+			"create table test (pk int primary key, c0 int)",
+			"call dolt_commit('-Am', 'seed table');",
+			"insert into test values (1, 1);",
+			"call dolt_stash();",
+		},
+		Assertions: []queries.ScriptTestAssertion{
+			{
+				Query:    "start transaction;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:          "call dolt_stash('pop');",
+				ExpectedErrStr: "error: dolt_stash cannot change stashes inside an explicit transaction; commit or roll back the transaction first, and run dolt_stash with @@autocommit enabled",
+			},
+			{
+				Query:    "select name from dolt_stashes;",
+				Expected: []sql.Row{{"stash@{0}"}},
+			},
+			{
+				Query:    "rollback;",
+				Expected: []sql.Row{},
+			},
+			{
+				Query:    "set autocommit = 0;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:          "call dolt_stash('drop');",
+				ExpectedErrStr: "error: dolt_stash cannot change stashes inside an explicit transaction; commit or roll back the transaction first, and run dolt_stash with @@autocommit enabled",
+			},
+			{
+				Query:    "set autocommit = 1;",
+				Expected: []sql.Row{{}},
+			},
+			{
+				Query:            "call dolt_stash('pop');",
+				SkipResultsCheck: true,
+			},
+			{
+				Query:    "select * from test;",
+				Expected: []sql.Row{{1, 1}},
+			},
+			{
+				Query:    "select * from dolt_stashes;",
+				Expected: []sql.Row{},
+			},
+		},
+	},
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
 	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlfmt"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
 )
 
 // ResolveDefaultExpression returns a sql.Expression for the column default or generated expression for the
@@ -71,6 +72,57 @@ func ResolveCheckExpression(ctx *sql.Context, tableName string, sch schema.Schem
 	return nil, fmt.Errorf("unable to find check expression")
 }
 
+// ResolveFilterExpression returns a sql.Expression for the WHERE clause |filter| evaluated against rows of a table with
+// the schema provided. The fields of the returned expression index into rows in the order of |sch|'s columns.
+func ResolveFilterExpression(_ *sql.Context, tableName string, sch schema.Schema, filter string) (sql.Expression, error) {
+	pkSch, err := sqlutil.FromDoltSchema("mydb", tableName, sch)
+	if err != nil {
+		return nil, err
+	}
+
+	mockDatabase := memory.NewDatabase("mydb")
+	mockDatabase.AddTable(tableName, memory.NewLocalTable(mockDatabase, tableName, pkSch, nil))
+	mockProvider := memory.NewDBProvider(mockDatabase)
+	catalog := analyzer.NewCatalog(mockProvider)
+	parseCtx := sql.NewEmptyContext()
+	parseCtx.SetCurrentDatabase("mydb")
+
+	query := fmt.Sprintf("SELECT * FROM %s WHERE %s", sql.QuoteIdentifier(tableName), filter)
+	b := planbuilder.New(parseCtx, catalog, nil, nil)
+	pseudoAnalyzedQuery, _, _, _, err := b.Parse(query, nil, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// The only acceptable shape is a projection of a filtered scan of the mock table. Anything else means |filter| was
+	// more than a single boolean expression.
+	project, ok := pseudoAnalyzedQuery.(*plan.Project)
+	if !ok {
+		return nil, fmt.Errorf("invalid filter: %s", filter)
+	}
+	filterNode, ok := project.Child.(*plan.Filter)
+	if !ok {
+		return nil, fmt.Errorf("invalid filter: %s", filter)
+	}
+
+	// The fields of an unanalyzed plan are indexed by column id, so they are indexed by their position in |sch| instead
+	expr, _, err := transform.Expr(filterNode.Expression, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
+		gf, ok := e.(*expression.GetField)
+		if !ok {
+			return e, transform.SameTree, nil
+		}
+		idx := pkSch.Schema.IndexOfColName(gf.Name())
+		if idx < 0 {
+			return nil, transform.SameTree, fmt.Errorf("unable to find column %s in filter: %s", gf.Name(), filter)
+		}
+		return gf.WithIndex(idx), transform.NewTree, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
 func stripTableNamesFromExpression(expr sql.Expression) sql.Expression {
 	e, _, _ := transform.Expr(expr, func(e sql.Expression) (sql.Expression, transform.TreeIdentity, error) {
 		if col, ok := e.(*expression.GetField); ok {
@@ -100,5 +100,8 @@ func WithUserPasswordAuth(args remotesrv.ServerArgs, authnz remotesrv.AccessCont
 		AccessController: authnz,
 	}
 	args.Options = append(args.Options, si.Options()...)
+	if cac, ok := authnz.(remotesrv.CommitAccessControl); ok {
+		args.CommitAccessControl = cac
+	}
 	return args
 }
@@ -753,6 +753,10 @@ func BasicSelectTests() []SelectTest {
 					"bigbillieb@fake.horse",
 					time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC).In(LoadedLocalLocation()),
 					"Initialize data repository",
+					"billy bob",
+					"bigbillieb@fake.horse",
+					ignoreVal,
+					"",
 				},
 			},
 			ExpectedSqlSchema: sql.Schema{
@@ -761,6 +765,10 @@ func BasicSelectTests() []SelectTest {
 				&sql.Column{Name: "email", Type: gmstypes.Text},
 				&sql.Column{Name: "date", Type: gmstypes.Datetime},
 				&sql.Column{Name: "message", Type: gmstypes.Text},
+				&sql.Column{Name: "committer_name", Type: gmstypes.Text},
+				&sql.Column{Name: "committer_email", Type: gmstypes.Text},
+				&sql.Column{Name: "committer_date", Type: gmstypes.Datetime},
+				&sql.Column{Name: "trailers", Type: gmstypes.Text},
 			},
 		},
 		{
@@ -204,6 +204,34 @@ var DoltSystemVariables = []sql.SystemVariable{
 		Type:    types.NewSystemBoolType(dsess.ShowSystemTables),
 		Default: int8(0),
 	},
+	&sql.MysqlSystemVariable{ // The branch whose binlog events are streamed to a replica; empty means @@log_bin_branch.
+		Name:    dsess.DoltBinlogBranch,
+		Dynamic: true,
+		Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Both),
+		Type:    types.NewSystemStringType(dsess.DoltBinlogBranch),
+		Default: "",
+	},
+	&sql.MysqlSystemVariable{ // Comma-separated user:branch pairs choosing the branch streamed to each replication user.
+		Name:    dsess.DoltBinlogReplicaBranches,
+		Dynamic: true,
+		Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+		Type:    types.NewSystemStringType(dsess.DoltBinlogReplicaBranches),
+		Default: "",
+	},
+	&sql.MysqlSystemVariable{ // The directory dolt_fetch() may read bundle files from; empty disables fetching from bundles.
+		Name:    dsess.DoltBundleDir,
+		Dynamic: false,
+		Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+		Type:    types.NewSystemStringType(dsess.DoltBundleDir),
+		Default: "",
+	},
+	&sql.MysqlSystemVariable{ // If true, reading from a branch requires a matching entry in dolt_branch_control.
+		Name:    dsess.EnforceBranchControlReads,
+		Dynamic: true,
+		Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+		Type:    types.NewSystemBoolType(dsess.EnforceBranchControlReads),
+		Default: int8(0),
+	},
 	&sql.MysqlSystemVariable{
 		Name:    "dolt_dont_merge_json",
 		Dynamic: true,
@@ -438,6 +466,34 @@ func AddDoltSystemVariables() {
 			Type:    types.NewSystemBoolType(dsess.ShowSystemTables),
 			Default: int8(0),
 		},
+		&sql.MysqlSystemVariable{ // The branch whose binlog events are streamed to a replica; empty means @@log_bin_branch.
+			Name:    dsess.DoltBinlogBranch,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Both),
+			Type:    types.NewSystemStringType(dsess.DoltBinlogBranch),
+			Default: "",
+		},
+		&sql.MysqlSystemVariable{ // Comma-separated user:branch pairs choosing the branch streamed to each replication user.
+			Name:    dsess.DoltBinlogReplicaBranches,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+			Type:    types.NewSystemStringType(dsess.DoltBinlogReplicaBranches),
+			Default: "",
+		},
+		&sql.MysqlSystemVariable{ // The directory dolt_fetch() may read bundle files from; empty disables fetching from bundles.
+			Name:    dsess.DoltBundleDir,
+			Dynamic: false,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+			Type:    types.NewSystemStringType(dsess.DoltBundleDir),
+			Default: "",
+		},
+		&sql.MysqlSystemVariable{ // If true, reading from a branch requires a matching entry in dolt_branch_control.
+			Name:    dsess.EnforceBranchControlReads,
+			Dynamic: true,
+			Scope:   sql.GetMysqlScope(sql.SystemVariableScope_Global),
+			Type:    types.NewSystemBoolType(dsess.EnforceBranchControlReads),
+			Default: int8(0),
+		},
 		&sql.MysqlSystemVariable{
 			Name:    "dolt_dont_merge_json",
 			Dynamic: true,
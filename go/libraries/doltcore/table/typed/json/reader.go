@@ -41,6 +41,8 @@ type JSONReader struct {
 	jsonStream *jstream.Decoder
 	rowChan    chan *jstream.MetaValue
 	sampleRow  sql.Row
+	// lineDelimited is true when the rows are top level objects, one per line, rather than elements of a "rows" array
+	lineDelimited bool
 }
 
 var _ table.SqlTableReader = (*JSONReader)(nil)
@@ -54,17 +56,46 @@ func OpenJSONReader(vrw types.ValueReadWriter, path string, fs filesys.ReadableF
 	return NewJSONReader(vrw, r, sch)
 }
 
+// OpenJSONLReader opens a reader for a file of newline delimited JSON, where each line holds a single row object.
+func OpenJSONLReader(vrw types.ValueReadWriter, path string, fs filesys.ReadableFS, sch schema.Schema) (*JSONReader, error) {
+	r, err := fs.OpenForRead(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJSONLReader(vrw, r, sch)
+}
+
 // The bytes of the supplied reader are treated as UTF-8. If there is a UTF8,
 // UTF16LE or UTF16BE BOM at the first bytes read, then it is stripped and the
 // remaining contents of the reader are treated as that encoding.
 func NewJSONReader(vrw types.ValueReadWriter, r io.ReadCloser, sch schema.Schema) (*JSONReader, error) {
+	// extract JSON values at a depth level of 1
+	return newJSONReader(vrw, r, sch, 2)
+}
+
+// NewJSONLReader returns a reader for newline delimited JSON, where each line of |r| holds a single row object. Rows
+// are decoded as they are read, so memory use doesn't grow with the size of the input. Encodings are handled the same
+// way as in NewJSONReader.
+func NewJSONLReader(vrw types.ValueReadWriter, r io.ReadCloser, sch schema.Schema) (*JSONReader, error) {
+	// extract each top level JSON value
+	rd, err := newJSONReader(vrw, r, sch, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	rd.lineDelimited = true
+	return rd, nil
+}
+
+func newJSONReader(vrw types.ValueReadWriter, r io.ReadCloser, sch schema.Schema, emitDepth int) (*JSONReader, error) {
 	if sch == nil {
 		return nil, errors.New("schema must be provided to JsonReader")
 	}
 
 	textReader := transform.NewReader(r, unicode.BOMOverride(unicode.UTF8.NewDecoder()))
 
-	decoder := jstream.NewDecoder(textReader, 2) // extract JSON values at a depth level of 1
+	decoder := jstream.NewDecoder(textReader, emitDepth)
 
 	return &JSONReader{vrw: vrw, closer: r, sch: sch, jsonStream: decoder}, nil
 }
@@ -120,6 +151,9 @@ func (r *JSONReader) ReadSqlRow(ctx context.Context) (sql.Row, error) {
 
 	mapVal, ok := metaRow.Value.(map[string]interface{})
 	if !ok {
+		if r.lineDelimited {
+			return nil, fmt.Errorf("unexpected JSON format received, expected one json row object per line")
+		}
 		return nil, fmt.Errorf("unexpected JSON format received, expected format: { \"rows\": [ json_row_objects... ] } ")
 	}
 
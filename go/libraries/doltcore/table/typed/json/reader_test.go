@@ -118,6 +118,41 @@ func TestReader(t *testing.T) {
 	})
 }
 
+func TestJSONLReader(t *testing.T) {
+	testJSONL := `{"id": 0, "first name": "tim", "last name": "sehn"}
+{"id": 1, "first name": "brian", "last name": "hendriks"}
+`
+
+	fs := filesys.EmptyInMemFS("/")
+	require.NoError(t, fs.WriteFile("file.jsonl", []byte(testJSONL), os.ModePerm))
+
+	testGoodJSON(t, func(vrw types.ValueReadWriter, sch schema.Schema) (*JSONReader, error) {
+		return OpenJSONLReader(vrw, "file.jsonl", fs, sch)
+	})
+
+	t.Run("CRLF line endings", func(t *testing.T) {
+		crlf := bytes.ReplaceAll([]byte(testJSONL), []byte("\n"), []byte("\r\n"))
+		testGoodJSON(t, func(vrw types.ValueReadWriter, sch schema.Schema) (*JSONReader, error) {
+			return NewJSONLReader(vrw, io.NopCloser(bytes.NewReader(crlf)), sch)
+		})
+	})
+}
+
+func TestJSONLReaderNotAnObject(t *testing.T) {
+	sch, err := schema.SchemaFromCols(schema.NewColCollection(
+		schema.Column{Name: "id", Tag: 0, Kind: types.IntKind, IsPartOfPK: true, TypeInfo: typeinfo.Int64Type},
+	))
+	require.NoError(t, err)
+
+	reader, err := NewJSONLReader(types.NewMemoryValueStore(), io.NopCloser(bytes.NewReader([]byte("{\"id\": 0}\n[1, 2]\n"))), sch)
+	require.NoError(t, err)
+
+	_, err = reader.ReadSqlRow(context.Background())
+	require.NoError(t, err)
+	_, err = reader.ReadSqlRow(context.Background())
+	assert.ErrorContains(t, err, "expected one json row object per line")
+}
+
 func TestReaderBOMHandling(t *testing.T) {
 	testJSON := `{
 		"rows": [
@@ -45,6 +45,7 @@ type RowWriter struct {
 	header      string
 	footer      string
 	separator   string
+	terminator  string
 	bWr         *bufio.Writer
 	sch         schema.Schema
 	sqlSch      sql.Schema
@@ -59,6 +60,18 @@ func NewJSONWriter(wr io.WriteCloser, outSch schema.Schema) (*RowWriter, error)
 	return NewJSONWriterWithHeader(wr, outSch, jsonHeader, jsonFooter, ",")
 }
 
+// NewJSONLWriter returns a new writer that encodes rows as newline delimited JSON, writing each row as a JSON object on
+// its own line. Each row is terminated by a newline, so that nothing at all is written when there are no rows.
+func NewJSONLWriter(wr io.WriteCloser, outSch schema.Schema) (*RowWriter, error) {
+	w, err := NewJSONWriterWithHeader(wr, outSch, "", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	w.terminator = "\n"
+	return w, nil
+}
+
 // NewJSONSqlWriter returns a new writer that encodes rows as a single JSON object with a single key: "rows", which is a
 // slice of all rows. To customize the output of the JSON object emitted, use |NewJSONWriterWithHeader|
 func NewJSONSqlWriter(wr io.WriteCloser, sch sql.Schema) (*RowWriter, error) {
@@ -124,6 +137,11 @@ func (j *RowWriter) WriteSqlRow(ctx context.Context, row sql.Row) error {
 	if newErr != nil {
 		return newErr
 	}
+	if j.terminator != "" {
+		if _, err := j.bWr.WriteString(j.terminator); err != nil {
+			return err
+		}
+	}
 	j.rowsWritten++
 
 	return nil
@@ -139,43 +157,10 @@ func (j *RowWriter) jsonDataForSchema(row sql.Row) ([]byte, error) {
 			return false, nil
 		}
 
-		switch col.TypeInfo.GetTypeIdentifier() {
-		case typeinfo.DatetimeTypeIdentifier,
-			typeinfo.DecimalTypeIdentifier,
-			typeinfo.EnumTypeIdentifier,
-			typeinfo.InlineBlobTypeIdentifier,
-			typeinfo.SetTypeIdentifier,
-			typeinfo.TimeTypeIdentifier,
-			typeinfo.TupleTypeIdentifier,
-			typeinfo.UuidTypeIdentifier,
-			typeinfo.VarBinaryTypeIdentifier:
-			sqlVal, err := col.TypeInfo.ToSqlType().SQL(sqlContext, nil, val)
-			if err != nil {
-				return true, err
-			}
-			val = sqlVal.ToString()
-		case typeinfo.JSONTypeIdentifier:
-			sqlVal, err := col.TypeInfo.ToSqlType().SQL(sqlContext, nil, val)
-			if err != nil {
-				return true, err
-			}
-			str := sqlVal.ToString()
-
-			// This is kind of silly: we are unmarshalling JSON just to marshall it back again
-			// But it makes marshalling much simpler
-			err = json.Unmarshal([]byte(str), &val)
-			if err != nil {
-				return false, err
-			}
-		default:
-			sqlType := col.TypeInfo.ToSqlType()
-			converted, _, err := sqlType.Convert(val)
-			if err != nil {
-				return true, err
-			}
-			val = converted
+		val, err = ColumnJSONValue(col, val)
+		if err != nil {
+			return true, err
 		}
-
 		colValMap[col.Name] = val
 
 		return false, nil
@@ -190,6 +175,49 @@ func (j *RowWriter) jsonDataForSchema(row sql.Row) ([]byte, error) {
 	return jsonRowData, nil
 }
 
+// ColumnJSONValue returns the value that represents |val|, a non-nil value of the column |col|, when |col| is
+// written as JSON.
+func ColumnJSONValue(col schema.Column, val interface{}) (interface{}, error) {
+	switch col.TypeInfo.GetTypeIdentifier() {
+	case typeinfo.DatetimeTypeIdentifier,
+		typeinfo.DecimalTypeIdentifier,
+		typeinfo.EnumTypeIdentifier,
+		typeinfo.InlineBlobTypeIdentifier,
+		typeinfo.SetTypeIdentifier,
+		typeinfo.TimeTypeIdentifier,
+		typeinfo.TupleTypeIdentifier,
+		typeinfo.UuidTypeIdentifier,
+		typeinfo.VarBinaryTypeIdentifier:
+		sqlVal, err := col.TypeInfo.ToSqlType().SQL(sqlContext, nil, val)
+		if err != nil {
+			return nil, err
+		}
+		return sqlVal.ToString(), nil
+	case typeinfo.JSONTypeIdentifier:
+		sqlVal, err := col.TypeInfo.ToSqlType().SQL(sqlContext, nil, val)
+		if err != nil {
+			return nil, err
+		}
+		str := sqlVal.ToString()
+
+		// This is kind of silly: we are unmarshalling JSON just to marshall it back again
+		// But it makes marshalling much simpler
+		var jsonVal interface{}
+		err = json.Unmarshal([]byte(str), &jsonVal)
+		if err != nil {
+			return nil, err
+		}
+		return jsonVal, nil
+	default:
+		sqlType := col.TypeInfo.ToSqlType()
+		converted, _, err := sqlType.Convert(val)
+		if err != nil {
+			return nil, err
+		}
+		return converted, nil
+	}
+}
+
 // jsonDataForSqlSchema returns a JSON representation of the given row, using the sql schema for serialization hints
 func (j *RowWriter) jsonDataForSqlSchema(row sql.Row) ([]byte, error) {
 	colValMap := make(map[string]interface{}, len(j.sqlSch))
@@ -0,0 +1,89 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+func TestJSONLWriter(t *testing.T) {
+	ctx := context.Background()
+	sch, err := schema.SchemaFromCols(schema.NewColCollection(
+		schema.Column{Name: "id", Tag: 0, Kind: types.IntKind, IsPartOfPK: true, TypeInfo: typeinfo.Int64Type},
+		schema.Column{Name: "name", Tag: 1, Kind: types.StringKind, TypeInfo: typeinfo.StringDefaultType},
+	))
+	require.NoError(t, err)
+
+	rows := []sql.Row{
+		{int64(0), "tim"},
+		{int64(1), "multi\nline"},
+		{int64(2), nil},
+	}
+
+	var buf bytes.Buffer
+	wr, err := NewJSONLWriter(iohelp.NopWrCloser(&buf), sch)
+	require.NoError(t, err)
+	for _, r := range rows {
+		require.NoError(t, wr.WriteSqlRow(ctx, r))
+	}
+	require.NoError(t, wr.Close(ctx))
+
+	expected := `{"id":0,"name":"tim"}
+{"id":1,"name":"multi\nline"}
+{"id":2}
+`
+	assert.Equal(t, expected, buf.String())
+
+	rd, err := NewJSONLReader(types.NewMemoryValueStore(), io.NopCloser(&buf), sch)
+	require.NoError(t, err)
+	for _, expectedRow := range rows {
+		r, err := rd.ReadSqlRow(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, expectedRow, r)
+	}
+	_, err = rd.ReadSqlRow(ctx)
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestJSONLWriterNoRows(t *testing.T) {
+	ctx := context.Background()
+	sch, err := schema.SchemaFromCols(schema.NewColCollection(
+		schema.Column{Name: "id", Tag: 0, Kind: types.IntKind, IsPartOfPK: true, TypeInfo: typeinfo.Int64Type},
+	))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	wr, err := NewJSONLWriter(iohelp.NopWrCloser(&buf), sch)
+	require.NoError(t, err)
+	require.NoError(t, wr.Close(ctx))
+	assert.Empty(t, buf.String())
+
+	rd, err := NewJSONLReader(types.NewMemoryValueStore(), io.NopCloser(&buf), sch)
+	require.NoError(t, err)
+	_, err = rd.ReadSqlRow(ctx)
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,275 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xlsx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/dolthub/vitess/go/sqltypes"
+	"github.com/shopspring/decimal"
+	"github.com/tealeg/xlsx"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/sqlutil"
+	"github.com/dolthub/dolt/go/libraries/doltcore/table"
+)
+
+// maxSheetNameLen is the longest sheet name excel allows
+const maxSheetNameLen = 31
+
+// Workbook is an xlsx workbook that sheets of table rows can be written to. Nothing is written out until Write is
+// called, so a single workbook can hold the contents of multiple tables.
+type Workbook struct {
+	file *xlsx.File
+	// sheetNames holds the lower cased names of the sheets in the workbook, since excel compares them case-insensitively
+	sheetNames map[string]struct{}
+}
+
+// NewWorkbook returns a new, empty Workbook
+func NewWorkbook() *Workbook {
+	return &Workbook{file: xlsx.NewFile(), sheetNames: make(map[string]struct{})}
+}
+
+// NewSheetWriter adds a sheet to the workbook for a table with the schema given and returns a writer for its rows.
+// The sheet name is derived from |sheetName|, with any characters excel doesn't allow replaced and the name truncated
+// to the maximum length excel supports. If the workbook already has a sheet with that name, ignoring case, a numeric
+// suffix is added to make the name unique, e.g. "employees_2".
+func (wb *Workbook) NewSheetWriter(sheetName string, outSch schema.Schema) (*XLSXWriter, error) {
+	sheet, err := wb.file.AddSheet(wb.uniqueSheetName(SheetNameForTable(sheetName)))
+	if err != nil {
+		return nil, err
+	}
+
+	xlsxw := &XLSXWriter{
+		sheet: sheet,
+		sch:   outSch,
+	}
+
+	header := sheet.AddRow()
+	bold := xlsx.NewStyle()
+	bold.Font.Bold = true
+	bold.ApplyFont = true
+	for _, col := range outSch.GetAllCols().GetColumns() {
+		cell := header.AddCell()
+		cell.SetString(col.Name)
+		cell.SetStyle(bold)
+	}
+
+	return xlsxw, nil
+}
+
+// uniqueSheetName returns |name|, or |name| truncated and followed by the lowest numeric suffix that makes it unique if
+// the workbook already has a sheet with that name, and reserves the name returned.
+func (wb *Workbook) uniqueSheetName(name string) string {
+	unique := name
+	for i := 2; ; i++ {
+		if _, ok := wb.sheetNames[strings.ToLower(unique)]; !ok {
+			break
+		}
+		suffix := fmt.Sprintf("_%d", i)
+		runes := []rune(name)
+		if len(runes)+len(suffix) > maxSheetNameLen {
+			runes = runes[:maxSheetNameLen-len(suffix)]
+		}
+		unique = string(runes) + suffix
+	}
+	wb.sheetNames[strings.ToLower(unique)] = struct{}{}
+	return unique
+}
+
+// Write writes the workbook, including all of its sheets, to |wr|
+func (wb *Workbook) Write(wr io.Writer) error {
+	return wb.file.Write(wr)
+}
+
+// SheetNameForTable returns the name of the sheet that a table named |tableName| is written to. Excel forbids the
+// characters : \ / ? * [ ] in sheet names and limits them to 31 characters.
+func SheetNameForTable(tableName string) string {
+	name := strings.Map(func(r rune) rune {
+		switch r {
+		case ':', '\\', '/', '?', '*', '[', ']':
+			return '_'
+		}
+		return r
+	}, tableName)
+
+	runes := []rune(name)
+	if len(runes) > maxSheetNameLen {
+		name = string(runes[:maxSheetNameLen])
+	}
+
+	return name
+}
+
+// XLSXWriter implements table.SqlRowWriter. It writes rows to a single sheet of an xlsx workbook, beginning with a
+// header row of column names. Numeric, date and datetime values are written as typed cells rather than as strings,
+// and decimals are written with their exact digits.
+type XLSXWriter struct {
+	sheet  *xlsx.Sheet
+	sch    schema.Schema
+	wb     *Workbook
+	closer io.WriteCloser
+	closed bool
+}
+
+var _ table.SqlRowWriter = (*XLSXWriter)(nil)
+
+// NewXLSXWriter returns a writer for a workbook containing a single sheet that is written to |wr| when the writer is
+// closed.
+func NewXLSXWriter(wr io.WriteCloser, outSch schema.Schema, info *XLSXFileInfo) (*XLSXWriter, error) {
+	wb := NewWorkbook()
+	xlsxw, err := wb.NewSheetWriter(info.SheetName, outSch)
+	if err != nil {
+		wr.Close()
+		return nil, err
+	}
+
+	xlsxw.wb = wb
+	xlsxw.closer = wr
+	return xlsxw, nil
+}
+
+// WriteSqlRow adds a row to the sheet
+func (xlsxw *XLSXWriter) WriteSqlRow(ctx context.Context, r sql.Row) error {
+	if xlsxw.closed {
+		return errors.New("Already closed.")
+	}
+
+	xlRow := xlsxw.sheet.AddRow()
+	for i, val := range r {
+		// every column gets a cell, even when null, so that each row lines up with the header
+		cell := xlRow.AddCell()
+		if val == nil {
+			continue
+		}
+
+		colType := xlsxw.sch.GetAllCols().GetByIndex(i).TypeInfo.ToSqlType()
+		err := setCellValue(cell, colType, val)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close writes the workbook if this writer owns it, and releases resources being held
+func (xlsxw *XLSXWriter) Close(ctx context.Context) error {
+	if xlsxw.closed {
+		return errors.New("Already closed.")
+	}
+	xlsxw.closed = true
+
+	if xlsxw.closer == nil {
+		return nil
+	}
+
+	err := xlsxw.wb.Write(xlsxw.closer)
+	errCl := xlsxw.closer.Close()
+	if err != nil {
+		return err
+	}
+	return errCl
+}
+
+// setCellValue sets the value of |cell| to |val|, keeping the value typed when excel has an equivalent type
+func setCellValue(cell *xlsx.Cell, colType sql.Type, val interface{}) error {
+	switch {
+	case gmstypes.IsInteger(colType), gmstypes.IsBit(colType):
+		if setIntCellValue(cell, val) {
+			return nil
+		}
+	case gmstypes.IsFloat(colType):
+		switch v := val.(type) {
+		case float32:
+			cell.SetFloat(float64(v))
+			return nil
+		case float64:
+			cell.SetFloat(v)
+			return nil
+		}
+	case gmstypes.IsDecimal(colType):
+		if d, ok := val.(decimal.Decimal); ok {
+			// SetFloat makes the cell numeric, and the value is replaced with the exact digits of the decimal, which
+			// a float64 can't always represent
+			cell.SetFloat(0)
+			cell.Value = d.String()
+			return nil
+		}
+	case gmstypes.IsTime(colType):
+		if t, ok := val.(time.Time); ok {
+			if colType.Type() == sqltypes.Date {
+				cell.SetDate(t)
+			} else {
+				cell.SetDateTime(t)
+			}
+			return nil
+		}
+	}
+
+	str, err := sqlutil.SqlColToStr(colType, val)
+	if err != nil {
+		return err
+	}
+	cell.SetString(str)
+	return nil
+}
+
+// setIntCellValue sets |cell| to the integer |val|, returning false if |val| isn't an integer
+func setIntCellValue(cell *xlsx.Cell, val interface{}) bool {
+	switch v := val.(type) {
+	case int:
+		cell.SetInt64(int64(v))
+	case int8:
+		cell.SetInt64(int64(v))
+	case int16:
+		cell.SetInt64(int64(v))
+	case int32:
+		cell.SetInt64(int64(v))
+	case int64:
+		cell.SetInt64(v)
+	case uint:
+		setUintCellValue(cell, uint64(v))
+	case uint8:
+		cell.SetInt64(int64(v))
+	case uint16:
+		cell.SetInt64(int64(v))
+	case uint32:
+		cell.SetInt64(int64(v))
+	case uint64:
+		setUintCellValue(cell, v)
+	default:
+		return false
+	}
+	return true
+}
+
+// setUintCellValue writes unsigned values too large for an int64 as strings so they don't lose precision
+func setUintCellValue(cell *xlsx.Cell, v uint64) {
+	if v > math.MaxInt64 {
+		cell.SetString(strconv.FormatUint(v, 10))
+	} else {
+		cell.SetInt64(int64(v))
+	}
+}
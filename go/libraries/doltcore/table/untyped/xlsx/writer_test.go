@@ -0,0 +1,132 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xlsx
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	gmstypes "github.com/dolthub/go-mysql-server/sql/types"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tealeg/xlsx"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema"
+	"github.com/dolthub/dolt/go/libraries/doltcore/schema/typeinfo"
+	"github.com/dolthub/dolt/go/libraries/utils/iohelp"
+	"github.com/dolthub/dolt/go/store/types"
+)
+
+var writerSch = schema.MustSchemaFromCols(schema.NewColCollection(
+	schema.Column{Name: "id", Tag: 0, Kind: types.IntKind, IsPartOfPK: true, TypeInfo: typeinfo.Int64Type},
+	schema.Column{Name: "name", Tag: 1, Kind: types.StringKind, TypeInfo: typeinfo.StringDefaultType},
+	schema.Column{Name: "score", Tag: 2, Kind: types.FloatKind, TypeInfo: typeinfo.Float64Type},
+	schema.Column{Name: "born", Tag: 3, Kind: types.TimestampKind, TypeInfo: typeinfo.DateType},
+))
+
+func TestXLSXWriter(t *testing.T) {
+	ctx := context.Background()
+	born := time.Date(1990, 4, 12, 0, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	wr, err := NewXLSXWriter(iohelp.NopWrCloser(&buf), writerSch, NewXLSXInfo("people"))
+	require.NoError(t, err)
+	require.NoError(t, wr.WriteSqlRow(ctx, sql.Row{int64(1), "bill", 3.5, born}))
+	require.NoError(t, wr.WriteSqlRow(ctx, sql.Row{int64(2), nil, nil, nil}))
+	require.NoError(t, wr.Close(ctx))
+	assert.Error(t, wr.Close(ctx))
+
+	file, err := xlsx.OpenBinary(buf.Bytes())
+	require.NoError(t, err)
+	require.Len(t, file.Sheets, 1)
+	sheet := file.Sheets[0]
+	assert.Equal(t, "people", sheet.Name)
+	require.Len(t, sheet.Rows, 3)
+
+	var header []string
+	for _, cell := range sheet.Rows[0].Cells {
+		header = append(header, cell.String())
+	}
+	assert.Equal(t, []string{"id", "name", "score", "born"}, header)
+
+	cells := sheet.Rows[1].Cells
+	assert.Equal(t, xlsx.CellTypeNumeric, cells[0].Type())
+	assert.Equal(t, "1", cells[0].Value)
+	assert.Equal(t, xlsx.CellTypeString, cells[1].Type())
+	assert.Equal(t, "bill", cells[1].Value)
+	assert.Equal(t, xlsx.CellTypeNumeric, cells[2].Type())
+	assert.Equal(t, "3.5", cells[2].Value)
+	assert.True(t, cells[3].IsTime())
+	readBorn, err := cells[3].GetTime(false)
+	require.NoError(t, err)
+	assert.True(t, born.Equal(readBorn))
+
+	// null values still get a cell so that rows line up with the header
+	assert.Len(t, sheet.Rows[2].Cells, 4)
+	assert.Equal(t, "", sheet.Rows[2].Cells[1].Value)
+}
+
+func TestWorkbookMultipleSheets(t *testing.T) {
+	ctx := context.Background()
+
+	wb := NewWorkbook()
+	for _, name := range []string{"first", "second"} {
+		wr, err := wb.NewSheetWriter(name, writerSch)
+		require.NoError(t, err)
+		require.NoError(t, wr.WriteSqlRow(ctx, sql.Row{int64(1), name, 1.0, nil}))
+		require.NoError(t, wr.Close(ctx))
+	}
+
+	// sheet names that collide, ignoring case, get a numeric suffix
+	wr, err := wb.NewSheetWriter("First", writerSch)
+	require.NoError(t, err)
+	require.NoError(t, wr.Close(ctx))
+	long := "this_table_name_is_much_too_long_for_excel"
+	for i := 0; i < 2; i++ {
+		wr, err = wb.NewSheetWriter(long, writerSch)
+		require.NoError(t, err)
+		require.NoError(t, wr.Close(ctx))
+	}
+	var names []string
+	for _, sheet := range wb.file.Sheets {
+		names = append(names, sheet.Name)
+	}
+	assert.Equal(t, []string{"first", "second", "First_2", "this_table_name_is_much_too_lon", "this_table_name_is_much_too_l_2"}, names)
+
+	var buf bytes.Buffer
+	require.NoError(t, wb.Write(&buf))
+
+	data, err := getXlsxRowsFromBinary(buf.Bytes(), "second")
+	require.NoError(t, err)
+	assert.Equal(t, [][][]string{{{"id", "name", "score", "born"}, {"1", "second", "1", ""}}}, data)
+}
+
+func TestSetCellValueDecimal(t *testing.T) {
+	var c xlsx.Cell
+	d := decimal.RequireFromString("12345678901234567890.0123456789")
+	require.NoError(t, setCellValue(&c, gmstypes.MustCreateDecimalType(30, 10), d))
+	assert.Equal(t, xlsx.CellTypeNumeric, c.Type())
+	assert.Equal(t, "12345678901234567890.0123456789", c.Value)
+}
+
+func TestSheetNameForTable(t *testing.T) {
+	assert.Equal(t, "employees", SheetNameForTable("employees"))
+	assert.Equal(t, "a_b_c", SheetNameForTable("a/b:c"))
+	assert.Equal(t, "this_table_name_is_much_too_lon", SheetNameForTable("this_table_name_is_much_too_long_for_excel"))
+}
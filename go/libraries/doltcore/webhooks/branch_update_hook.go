@@ -0,0 +1,364 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/diff"
+	"github.com/dolthub/dolt/go/libraries/doltcore/doltdb"
+	"github.com/dolthub/dolt/go/libraries/doltcore/env"
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle"
+	"github.com/dolthub/dolt/go/libraries/doltcore/sqle/dsess"
+	"github.com/dolthub/dolt/go/store/datas"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+const branchUpdateThreadName = "webhook_branch_updates"
+
+// BranchUpdate is the JSON payload sent to webhooks when the head of a branch is updated.
+type BranchUpdate struct {
+	Database      string         `json:"database"`
+	Ref           string         `json:"ref"`
+	Branch        string         `json:"branch"`
+	OldCommit     string         `json:"old_commit"`
+	NewCommit     string         `json:"new_commit"`
+	Author        Author         `json:"author"`
+	Message       string         `json:"message"`
+	Timestamp     time.Time      `json:"timestamp"`
+	ChangedTables []ChangedTable `json:"changed_tables"`
+}
+
+// Author is the author of the new head commit of a BranchUpdate.
+type Author struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// ChangedTable describes a table which differs between the old and new head commits of a BranchUpdate.
+type ChangedTable struct {
+	Name         string `json:"name"`
+	FromName     string `json:"from_name,omitempty"`
+	DiffType     string `json:"diff_type"`
+	DataChange   bool   `json:"data_change"`
+	SchemaChange bool   `json:"schema_change"`
+}
+
+type branchHead struct {
+	branch ref.BranchRef
+	addr   hash.Hash
+}
+
+// BranchUpdateHook is a doltdb.CommitHook that notifies webhooks whenever the head of a branch is updated, for example
+// by a commit, a merge or a reset. Notifications are built and delivered asynchronously on background threads, so that
+// they do not block the update.
+//
+// Queuing a branch update never blocks, even while notifications are slow to build or deliver. Only the latest pending
+// head of each branch is kept: a head replaces one for the same branch which has not been notified yet, and the
+// notification for the later head covers the changes since the last head that was notified.
+type BranchUpdateHook struct {
+	dbName   string
+	ddb      *doltdb.DoltDB
+	webhooks []*Webhook
+	// heads are the last known heads of each branch, only accessed by the background thread
+	heads map[string]hash.Hash
+	out   io.Writer
+
+	mu      sync.Mutex
+	pending map[string]branchHead
+	order   []string
+	ready   chan struct{}
+}
+
+var _ doltdb.CommitHook = (*BranchUpdateHook)(nil)
+
+// NewBranchUpdateHook creates a BranchUpdateHook which notifies |webhooks| of updates to the branches of |ddb|, the
+// database named |dbName|, and starts the background thread that builds the notifications.
+func NewBranchUpdateHook(ctx context.Context, bThreads *sql.BackgroundThreads, dbName string, ddb *doltdb.DoltDB, webhooks []*Webhook) (*BranchUpdateHook, error) {
+	branches, err := ddb.GetBranchesWithHashes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &BranchUpdateHook{
+		dbName:   dbName,
+		ddb:      ddb,
+		webhooks: webhooks,
+		heads:    make(map[string]hash.Hash, len(branches)),
+		pending:  make(map[string]branchHead),
+		ready:    make(chan struct{}, 1),
+	}
+	for _, b := range branches {
+		h.heads[b.Ref.GetPath()] = b.Hash
+	}
+
+	err = bThreads.Add(fmt.Sprintf("%s_%s", branchUpdateThreadName, dbName), func(ctx context.Context) {
+		for {
+			select {
+			case <-h.ready:
+				for head, ok := h.next(); ok && ctx.Err() == nil; head, ok = h.next() {
+					h.notify(ctx, head)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// Execute implements doltdb.CommitHook. Updates to branch heads are queued to be sent to the matching webhooks, without
+// blocking the update.
+func (h *BranchUpdateHook) Execute(ctx context.Context, ds datas.Dataset, _ datas.Database) (func(context.Context) error, error) {
+	addr, ok := ds.MaybeHeadAddr()
+	if !ok {
+		return nil, nil
+	}
+
+	if !ref.IsRef(ds.ID()) {
+		return nil, nil
+	}
+	dref, err := ref.Parse(ds.ID())
+	if err != nil {
+		return nil, err
+	}
+	if dref.GetType() != ref.BranchRefType {
+		return nil, nil
+	}
+
+	h.enqueue(branchHead{branch: dref.(ref.BranchRef), addr: addr})
+	return nil, nil
+}
+
+// enqueue queues |head| to be notified, replacing any pending head of the same branch. It never blocks.
+func (h *BranchUpdateHook) enqueue(head branchHead) {
+	h.mu.Lock()
+	branch := head.branch.GetPath()
+	if _, ok := h.pending[branch]; !ok {
+		h.order = append(h.order, branch)
+	}
+	h.pending[branch] = head
+	h.mu.Unlock()
+
+	select {
+	case h.ready <- struct{}{}:
+	default:
+	}
+}
+
+// next removes and returns the oldest pending branch head, or false if there is none.
+func (h *BranchUpdateHook) next() (branchHead, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.order) == 0 {
+		return branchHead{}, false
+	}
+	branch := h.order[0]
+	h.order = h.order[1:]
+	head := h.pending[branch]
+	delete(h.pending, branch)
+	return head, true
+}
+
+// HandleError implements doltdb.CommitHook
+func (h *BranchUpdateHook) HandleError(ctx context.Context, err error) error {
+	if h.out != nil {
+		h.out.Write([]byte(fmt.Sprintf("webhooks: error queuing notifications of database %s: %s\n", h.dbName, err.Error())))
+	}
+	return nil
+}
+
+// SetLogger implements doltdb.CommitHook
+func (h *BranchUpdateHook) SetLogger(ctx context.Context, wr io.Writer) error {
+	h.out = wr
+	return nil
+}
+
+// ExecuteForWorkingSets implements doltdb.CommitHook
+func (*BranchUpdateHook) ExecuteForWorkingSets() bool {
+	return false
+}
+
+func (h *BranchUpdateHook) logf(format string, args ...interface{}) {
+	if h.out != nil {
+		h.out.Write([]byte(fmt.Sprintf(format, args...)))
+	}
+}
+
+func (h *BranchUpdateHook) notify(ctx context.Context, head branchHead) {
+	branch := head.branch.GetPath()
+	oldAddr := h.heads[branch]
+	h.heads[branch] = head.addr
+	if oldAddr == head.addr {
+		return
+	}
+
+	var matching []*Webhook
+	for _, w := range h.webhooks {
+		if w.MatchesBranch(branch) {
+			matching = append(matching, w)
+		}
+	}
+	if len(matching) == 0 {
+		return
+	}
+
+	update, err := h.newBranchUpdate(ctx, head, oldAddr)
+	if err != nil {
+		h.logf("error building webhook notification for branch %s: %s\n", branch, err.Error())
+		return
+	}
+	body, err := json.Marshal(update)
+	if err != nil {
+		h.logf("error building webhook notification for branch %s: %s\n", branch, err.Error())
+		return
+	}
+
+	for _, w := range matching {
+		w.Send(body)
+	}
+}
+
+// newBranchUpdate builds the BranchUpdate for the head of |head.branch| moving from |oldAddr| to |head.addr|. If the
+// old head is not known, for example because the branch was just created, the changed tables are those changed by
+// the new head commit relative to its first parent.
+func (h *BranchUpdateHook) newBranchUpdate(ctx context.Context, head branchHead, oldAddr hash.Hash) (*BranchUpdate, error) {
+	cm, err := h.readCommit(ctx, head.addr)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := cm.GetCommitMeta(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := &BranchUpdate{
+		Database:      h.dbName,
+		Ref:           head.branch.String(),
+		Branch:        head.branch.GetPath(),
+		NewCommit:     head.addr.String(),
+		Author:        Author{Name: meta.Name, Email: meta.Email},
+		Message:       meta.Description,
+		Timestamp:     meta.Time(),
+		ChangedTables: []ChangedTable{},
+	}
+	if !oldAddr.IsEmpty() {
+		update.OldCommit = oldAddr.String()
+	}
+
+	var fromCm *doltdb.Commit
+	if !oldAddr.IsEmpty() {
+		fromCm, err = h.readCommit(ctx, oldAddr)
+		if err != nil {
+			return nil, err
+		}
+	} else if cm.NumParents() > 0 {
+		optCmt, err := cm.GetParent(ctx, 0)
+		if err != nil {
+			return nil, err
+		}
+		fromCm, _ = optCmt.ToCommit()
+	}
+	if fromCm == nil {
+		return update, nil
+	}
+
+	fromRoot, err := fromCm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	toRoot, err := cm.GetRootValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	update.ChangedTables, err = changedTables(ctx, fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return update, nil
+}
+
+func (h *BranchUpdateHook) readCommit(ctx context.Context, addr hash.Hash) (*doltdb.Commit, error) {
+	optCmt, err := h.ddb.ReadCommit(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := optCmt.ToCommit()
+	if !ok {
+		return nil, doltdb.ErrGhostCommitEncountered
+	}
+	return cm, nil
+}
+
+func changedTables(ctx context.Context, fromRoot, toRoot doltdb.RootValue) ([]ChangedTable, error) {
+	deltas, err := diff.GetTableDeltas(ctx, fromRoot, toRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make([]ChangedTable, 0, len(deltas))
+	for _, delta := range deltas {
+		hasChanges, err := delta.HasChanges()
+		if err != nil {
+			return nil, err
+		}
+		if !hasChanges {
+			continue
+		}
+
+		summary, err := delta.GetSummary(ctx)
+		if err != nil {
+			return nil, err
+		}
+		ct := ChangedTable{
+			Name:         summary.TableName.String(),
+			DiffType:     summary.DiffType,
+			DataChange:   summary.DataChange,
+			SchemaChange: summary.SchemaChange,
+		}
+		if delta.IsRename() {
+			ct.FromName = summary.FromTableName.String()
+		}
+		changed = append(changed, ct)
+	}
+	return changed, nil
+}
+
+// NewBranchUpdateInitDatabaseHook returns a sqle.InitDatabaseHook that installs a BranchUpdateHook notifying
+// |webhooks| on each database created after the server has started.
+func NewBranchUpdateInitDatabaseHook(bThreads *sql.BackgroundThreads, webhooks []*Webhook, out io.Writer) sqle.InitDatabaseHook {
+	return func(ctx *sql.Context, _ *sqle.DoltDatabaseProvider, name string, denv *env.DoltEnv, _ dsess.SqlDatabase) error {
+		hook, err := NewBranchUpdateHook(ctx, bThreads, name, denv.DoltDB, webhooks)
+		if err != nil {
+			return err
+		}
+		_ = hook.SetLogger(ctx, out)
+		denv.DoltDB.PrependCommitHook(ctx, hook)
+		return nil
+	}
+}
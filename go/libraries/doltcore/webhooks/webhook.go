@@ -0,0 +1,188 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+)
+
+const (
+	// EventHeader is the HTTP header naming the kind of event a webhook request is for
+	EventHeader = "X-Dolt-Event"
+	// SignatureHeader is the HTTP header containing the HMAC-SHA256 signature of the request body, formatted as
+	// "sha256=<hex digest>". It is only set for webhooks configured with a secret.
+	SignatureHeader = "X-Dolt-Signature-256"
+
+	// BranchUpdateEvent is the event sent when the head of a branch is updated
+	BranchUpdateEvent = "branch_update"
+
+	webhookBufferSize    = 256
+	webhookThreadName    = "webhook"
+	webhookTimeout       = 10 * time.Second
+	webhookMaxRetryDelay = 30 * time.Second
+)
+
+// webhookRetryDelay is the delay before the first retry of a failed delivery. The delay doubles for each subsequent
+// retry, up to webhookMaxRetryDelay.
+var webhookRetryDelay = 500 * time.Millisecond
+
+// Webhook delivers notifications to a single configured URL. Deliveries are made one at a time on a background thread,
+// in the order they were sent, and a failed delivery is retried with exponential backoff until it succeeds or the
+// webhook's retries are exhausted.
+type Webhook struct {
+	cfg    servercfg.WebhookConfig
+	client *http.Client
+	ch     chan []byte
+	out    io.Writer
+}
+
+// NewWebhook creates a Webhook for |cfg| and starts the background thread that delivers its notifications. Failed
+// deliveries are logged to |out|, which may be nil.
+func NewWebhook(bThreads *sql.BackgroundThreads, cfg servercfg.WebhookConfig, out io.Writer) (*Webhook, error) {
+	w := &Webhook{
+		cfg:    cfg,
+		client: &http.Client{Timeout: webhookTimeout},
+		ch:     make(chan []byte, webhookBufferSize),
+		out:    out,
+	}
+
+	err := bThreads.Add(fmt.Sprintf("%s_%s", webhookThreadName, cfg.Name()), func(ctx context.Context) {
+		for {
+			select {
+			case body := <-w.ch:
+				w.deliver(ctx, body)
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// NewWebhooks creates a Webhook for each of |cfgs|.
+func NewWebhooks(bThreads *sql.BackgroundThreads, cfgs []servercfg.WebhookConfig, out io.Writer) ([]*Webhook, error) {
+	webhooks := make([]*Webhook, len(cfgs))
+	for i, cfg := range cfgs {
+		w, err := NewWebhook(bThreads, cfg, out)
+		if err != nil {
+			return nil, err
+		}
+		webhooks[i] = w
+	}
+	return webhooks, nil
+}
+
+// MatchesBranch returns whether this webhook should be notified of updates to |branch|. A webhook without any branch
+// filters matches every branch, and branch filters may be glob patterns, such as "release/*".
+func (w *Webhook) MatchesBranch(branch string) bool {
+	if len(w.cfg.Branches()) == 0 {
+		return true
+	}
+	for _, pattern := range w.cfg.Branches() {
+		if pattern == branch {
+			return true
+		}
+		if matched, err := path.Match(pattern, branch); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Send queues the JSON payload |body| to be delivered. The payload is dropped if too many deliveries are already
+// pending, so that a slow or unreachable endpoint cannot block the server.
+func (w *Webhook) Send(body []byte) {
+	select {
+	case w.ch <- body:
+	default:
+		w.logf("webhook %s: too many pending deliveries, dropping notification\n", w.cfg.Name())
+	}
+}
+
+func (w *Webhook) deliver(ctx context.Context, body []byte) {
+	delay := webhookRetryDelay
+	for attempt := 0; ; attempt++ {
+		err := w.post(ctx, body)
+		if err == nil {
+			return
+		}
+		if attempt >= w.cfg.MaxRetries() {
+			w.logf("webhook %s: delivery failed after %d attempts: %s\n", w.cfg.Name(), attempt+1, err.Error())
+			return
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		delay = min(delay*2, webhookMaxRetryDelay)
+	}
+}
+
+func (w *Webhook) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.cfg.URL(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "dolt-webhook")
+	req.Header.Set(EventHeader, BranchUpdateEvent)
+	if w.cfg.Secret() != "" {
+		req.Header.Set(SignatureHeader, Sign(w.cfg.Secret(), body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s responded with status %s", w.cfg.URL(), resp.Status)
+	}
+	return nil
+}
+
+func (w *Webhook) logf(format string, args ...interface{}) {
+	if w.out != nil {
+		w.out.Write([]byte(fmt.Sprintf(format, args...)))
+	}
+}
+
+// Sign returns the value of the SignatureHeader for |body| signed with |secret|.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
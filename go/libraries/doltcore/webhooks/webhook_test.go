@@ -0,0 +1,132 @@
+// Copyright 2025 Dolthub, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dolthub/go-mysql-server/sql"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dolthub/dolt/go/libraries/doltcore/ref"
+	"github.com/dolthub/dolt/go/libraries/doltcore/servercfg"
+	"github.com/dolthub/dolt/go/store/hash"
+)
+
+func ptr[T any](t T) *T {
+	return &t
+}
+
+func TestWebhookMatchesBranch(t *testing.T) {
+	allBranches := &Webhook{cfg: servercfg.WebhookYAMLConfig{URL_: ptr("http://localhost/hook")}}
+	require.True(t, allBranches.MatchesBranch("main"))
+	require.True(t, allBranches.MatchesBranch("feature"))
+
+	someBranches := &Webhook{cfg: servercfg.WebhookYAMLConfig{
+		URL_:      ptr("http://localhost/hook"),
+		Branches_: []string{"main", "release/*"},
+	}}
+	require.True(t, someBranches.MatchesBranch("main"))
+	require.True(t, someBranches.MatchesBranch("release/1.0"))
+	require.False(t, someBranches.MatchesBranch("feature"))
+	require.False(t, someBranches.MatchesBranch("release/1.0/hotfix"))
+}
+
+func TestWebhookDelivery(t *testing.T) {
+	defer func(d time.Duration) { webhookRetryDelay = d }(webhookRetryDelay)
+	webhookRetryDelay = time.Millisecond
+
+	type request struct {
+		body      string
+		event     string
+		signature string
+	}
+	var mu sync.Mutex
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, request{
+			body:      string(body),
+			event:     r.Header.Get(EventHeader),
+			signature: r.Header.Get(SignatureHeader),
+		})
+		// fail the first attempt of every delivery
+		if len(requests)%2 == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	bThreads := sql.NewBackgroundThreads()
+	defer bThreads.Shutdown()
+
+	w, err := NewWebhook(bThreads, servercfg.WebhookYAMLConfig{
+		Name_:       ptr("test"),
+		URL_:        ptr(server.URL),
+		Secret_:     ptr("shh"),
+		MaxRetries_: ptr(1),
+	}, nil)
+	require.NoError(t, err)
+
+	body := []byte(`{"branch":"main"}`)
+	w.Send(body)
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(requests) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, r := range requests {
+		require.Equal(t, string(body), r.body)
+		require.Equal(t, BranchUpdateEvent, r.event)
+		require.Equal(t, Sign("shh", body), r.signature)
+	}
+}
+
+func TestSign(t *testing.T) {
+	// echo -n 'hello' | openssl dgst -sha256 -hmac 'secret'
+	require.Equal(t, "sha256=88aab3ede8d3adf94d26ab90d3bafd4a2083070c3bcce9c014ee04a443847c0b", Sign("secret", []byte("hello")))
+}
+
+func TestBranchUpdateHookEnqueue(t *testing.T) {
+	h := &BranchUpdateHook{pending: make(map[string]branchHead), ready: make(chan struct{}, 1)}
+	h1, h2, h3 := hash.Of([]byte("one")), hash.Of([]byte("two")), hash.Of([]byte("three"))
+
+	// enqueue never blocks, and a pending head is replaced by a later head of the same branch
+	h.enqueue(branchHead{branch: ref.NewBranchRef("main"), addr: h1})
+	h.enqueue(branchHead{branch: ref.NewBranchRef("feature"), addr: h2})
+	h.enqueue(branchHead{branch: ref.NewBranchRef("main"), addr: h3})
+	require.Len(t, h.ready, 1)
+
+	head, ok := h.next()
+	require.True(t, ok)
+	require.Equal(t, "main", head.branch.GetPath())
+	require.Equal(t, h3, head.addr)
+	head, ok = h.next()
+	require.True(t, ok)
+	require.Equal(t, "feature", head.branch.GetPath())
+	_, ok = h.next()
+	require.False(t, ok)
+}
@@ -22,10 +22,10 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var forceOpt = &Option{"force", "f", "", OptionalFlag, "force desc", nil, false}
-var messageOpt = &Option{"message", "m", "msg", OptionalValue, "msg desc", nil, false}
-var fileTypeOpt = &Option{"file-type", "", "", OptionalValue, "file type", nil, false}
-var notOpt = &Option{"not", "", "", OptionalValue, "not desc", nil, true}
+var forceOpt = &Option{"force", "f", "", OptionalFlag, "force desc", nil, false, false}
+var messageOpt = &Option{"message", "m", "msg", OptionalValue, "msg desc", nil, false, false}
+var fileTypeOpt = &Option{"file-type", "", "", OptionalValue, "file type", nil, false, false}
+var notOpt = &Option{"not", "", "", OptionalValue, "not desc", nil, true, false}
 
 func TestParsing(t *testing.T) {
 	tests := []struct {
@@ -323,3 +323,29 @@ func TestDropValue(t *testing.T) {
 	}
 
 }
+
+func TestRepeatableString(t *testing.T) {
+	ap := NewArgParserWithVariableArgs("test")
+	ap.SupportsRepeatableString("trailer", "", "trailer", "A repeatable string")
+	ap.SupportsStringList("list", "", "list", "A string list")
+
+	apr, err := ap.Parse([]string{"--trailer", "Reviewed-by: a, b", "arg1", "--trailer=Ticket: 1", "--list", "x,y"})
+	require.NoError(t, err)
+
+	trailers, ok := apr.GetValueList("trailer")
+	require.True(t, ok)
+	require.Equal(t, []string{"Reviewed-by: a, b", "Ticket: 1"}, trailers)
+	list, ok := apr.GetValueList("list")
+	require.True(t, ok)
+	require.Equal(t, []string{"x", "y"}, list)
+	require.Equal(t, []string{"arg1"}, apr.Args)
+
+	apr, err = ap.Parse([]string{"--trailer", "Ticket: 1", "arg1"})
+	require.NoError(t, err)
+	trailers, _ = apr.GetValueList("trailer")
+	require.Equal(t, []string{"Ticket: 1"}, trailers)
+	require.Equal(t, []string{"arg1"}, apr.Args)
+
+	_, err = ap.Parse([]string{"--list", "x", "--list", "y"})
+	require.Error(t, err)
+}
@@ -65,4 +65,6 @@ type Option struct {
 	Validator ValidationFunc
 	// Allows more than one arg to an Option.
 	AllowMultipleOptions bool
+	// Allows the Option to be given more than once, with one value each time.
+	AllowRepeats bool
 }
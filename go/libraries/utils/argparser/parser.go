@@ -115,7 +115,7 @@ func (ap *ArgParser) SupportOption(opt *Option) {
 
 // SupportsFlag adds support for a new flag (argument with no value). See SupportOpt for details on params.
 func (ap *ArgParser) SupportsFlag(name, abbrev, desc string) *ArgParser {
-	opt := &Option{name, abbrev, "", OptionalFlag, desc, nil, false}
+	opt := &Option{name, abbrev, "", OptionalFlag, desc, nil, false, false}
 	ap.SupportOption(opt)
 
 	return ap
@@ -135,7 +135,7 @@ func (ap *ArgParser) SupportsAlias(alias, original string) *ArgParser {
 
 // SupportsString adds support for a new string argument with the description given. See SupportOpt for details on params.
 func (ap *ArgParser) SupportsString(name, abbrev, valDesc, desc string) *ArgParser {
-	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, nil, false}
+	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, nil, false, false}
 	ap.SupportOption(opt)
 
 	return ap
@@ -143,7 +143,16 @@ func (ap *ArgParser) SupportsString(name, abbrev, valDesc, desc string) *ArgPars
 
 // SupportsStringList adds support for a new string list argument with the description given. See SupportOpt for details on params.
 func (ap *ArgParser) SupportsStringList(name, abbrev, valDesc, desc string) *ArgParser {
-	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, nil, true}
+	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, nil, true, false}
+	ap.SupportOption(opt)
+
+	return ap
+}
+
+// SupportsRepeatableString adds support for a new string argument with the description given, which can be provided
+// more than once. Use GetValueList to get all of its values. See SupportOpt for details on params.
+func (ap *ArgParser) SupportsRepeatableString(name, abbrev, valDesc, desc string) *ArgParser {
+	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, nil, false, true}
 	ap.SupportOption(opt)
 
 	return ap
@@ -151,7 +160,7 @@ func (ap *ArgParser) SupportsStringList(name, abbrev, valDesc, desc string) *Arg
 
 // SupportsOptionalString adds support for a new string argument with the description given and optional empty value.
 func (ap *ArgParser) SupportsOptionalString(name, abbrev, valDesc, desc string) *ArgParser {
-	opt := &Option{name, abbrev, valDesc, OptionalEmptyValue, desc, nil, false}
+	opt := &Option{name, abbrev, valDesc, OptionalEmptyValue, desc, nil, false, false}
 	ap.SupportOption(opt)
 
 	return ap
@@ -159,7 +168,7 @@ func (ap *ArgParser) SupportsOptionalString(name, abbrev, valDesc, desc string)
 
 // SupportsValidatedString adds support for a new string argument with the description given and defined validation function.
 func (ap *ArgParser) SupportsValidatedString(name, abbrev, valDesc, desc string, validator ValidationFunc) *ArgParser {
-	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, validator, false}
+	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, validator, false, false}
 	ap.SupportOption(opt)
 
 	return ap
@@ -167,7 +176,7 @@ func (ap *ArgParser) SupportsValidatedString(name, abbrev, valDesc, desc string,
 
 // SupportsUint adds support for a new uint argument with the description given. See SupportOpt for details on params.
 func (ap *ArgParser) SupportsUint(name, abbrev, valDesc, desc string) *ArgParser {
-	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, isUintStr, false}
+	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, isUintStr, false, false}
 	ap.SupportOption(opt)
 
 	return ap
@@ -175,7 +184,7 @@ func (ap *ArgParser) SupportsUint(name, abbrev, valDesc, desc string) *ArgParser
 
 // SupportsInt adds support for a new int argument with the description given. See SupportOpt for details on params.
 func (ap *ArgParser) SupportsInt(name, abbrev, valDesc, desc string) *ArgParser {
-	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, isIntStr, false}
+	opt := &Option{name, abbrev, valDesc, OptionalValue, desc, isIntStr, false, false}
 	ap.SupportOption(opt)
 
 	return ap
@@ -398,7 +407,8 @@ func (ap *ArgParser) parseToken(args []string, index int, positionalArgs []strin
 		return 0, nil, nil, UnknownArgumentParam{name: arg}
 	}
 
-	if _, exists := namedArgs[opt.Name]; exists {
+	prevValue, exists := namedArgs[opt.Name]
+	if exists && !opt.AllowRepeats {
 		//already provided
 		return 0, nil, nil, errors.New("error: multiple values provided for `" + opt.Name + "'")
 	}
@@ -443,6 +453,10 @@ func (ap *ArgParser) parseToken(args []string, index int, positionalArgs []strin
 		value = new(string)
 	}
 
+	if exists {
+		*value = prevValue + repeatedValueSeparator + *value
+	}
+
 	namedArgs[opt.Name] = *value
 	return index, positionalArgs, namedArgs, nil
 }
@@ -26,6 +26,10 @@ const (
 	NO_POSITIONAL_ARGS = -1
 )
 
+// repeatedValueSeparator separates the values of an option that was given more than once. It can't be part of an
+// argument passed on the command line, so unlike the comma used for lists, it never splits a single value.
+const repeatedValueSeparator = "\x00"
+
 type ArgParseResults struct {
 	options map[string]string
 	Args    []string
@@ -110,8 +114,15 @@ func (res *ArgParseResults) GetValue(name string) (string, bool) {
 	return val, ok
 }
 
+// GetValueList returns the values of the option |name|. The value of a list option is split on commas, and a
+// repeatable option returns the values of all of its occurrences.
 func (res *ArgParseResults) GetValueList(name string) ([]string, bool) {
 	val, ok := res.options[name]
+	if res.parser != nil {
+		if opt, found := res.parser.nameOrAbbrevToOpt[name]; found && opt.AllowRepeats {
+			return strings.Split(val, repeatedValueSeparator), ok
+		}
+	}
 	return strings.Split(val, ","), ok
 }
 
@@ -31,6 +31,7 @@ var ConfigOptions = map[string]struct{}{
 	PushAutoSetupRemote:   {},
 	ProfileKey:            {},
 	VersionCheckDisabled:  {},
+	HistoryRetentionDays:  {},
 }
 
 const UserEmailKey = "user.email"
@@ -65,6 +66,8 @@ const ProfileKey = "profile"
 
 const VersionCheckDisabled = "versioncheck.disabled"
 
+const HistoryRetentionDays = "gc.history_retention_days"
+
 const SignCommitsKey = "commit.gpgsign"
 
 const GPGSigningKeyKey = "user.signingkey"
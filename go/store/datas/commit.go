@@ -180,6 +180,32 @@ func commit_flatbuffer(vaddr hash.Hash, opts CommitOptions, heights []uint64, pa
 		sigoff = builder.CreateString(opts.Meta.Signature)
 	}
 
+	// The committer and trailers are only written when present, so that commits without them serialize exactly as
+	// they did before these fields were added.
+	var committerNameOff, committerEmailOff flatbuffers.UOffsetT
+	if opts.Meta.HasDistinctCommitter() {
+		committerNameOff = builder.CreateString(opts.Meta.CommitterName)
+		committerEmailOff = builder.CreateString(opts.Meta.CommitterEmail)
+	}
+
+	var trailersOff flatbuffers.UOffsetT
+	if len(opts.Meta.Trailers) > 0 {
+		offs := make([]flatbuffers.UOffsetT, len(opts.Meta.Trailers))
+		for i, t := range opts.Meta.Trailers {
+			keyOff := builder.CreateString(t.Key)
+			valueOff := builder.CreateString(t.Value)
+			serial.CommitTrailerStart(builder)
+			serial.CommitTrailerAddKey(builder, keyOff)
+			serial.CommitTrailerAddValue(builder, valueOff)
+			offs[i] = serial.CommitTrailerEnd(builder)
+		}
+		serial.CommitStartTrailersVector(builder, len(offs))
+		for i := len(offs) - 1; i >= 0; i-- {
+			builder.PrependUOffsetT(offs[i])
+		}
+		trailersOff = builder.EndVector(len(offs))
+	}
+
 	serial.CommitStart(builder)
 	serial.CommitAddRoot(builder, vaddroff)
 	serial.CommitAddHeight(builder, maxheight+1)
@@ -191,6 +217,9 @@ func commit_flatbuffer(vaddr hash.Hash, opts CommitOptions, heights []uint64, pa
 	serial.CommitAddTimestampMillis(builder, opts.Meta.Timestamp)
 	serial.CommitAddUserTimestampMillis(builder, opts.Meta.UserTimestamp)
 	serial.CommitAddSignature(builder, sigoff)
+	serial.CommitAddCommitterName(builder, committerNameOff)
+	serial.CommitAddCommitterEmail(builder, committerEmailOff)
+	serial.CommitAddTrailers(builder, trailersOff)
 
 	bytes := serial.FinishMessage(builder, serial.CommitEnd(builder), []byte(serial.CommitFileID))
 	return bytes, maxheight + 1
@@ -590,6 +619,18 @@ func GetCommitMeta(ctx context.Context, cv types.Value) (*CommitMeta, error) {
 		ret.Timestamp = cmsg.TimestampMillis()
 		ret.UserTimestamp = cmsg.UserTimestampMillis()
 		ret.Signature = string(cmsg.Signature())
+		ret.CommitterName = string(cmsg.CommitterName())
+		ret.CommitterEmail = string(cmsg.CommitterEmail())
+		if n := cmsg.TrailersLength(); n > 0 {
+			ret.Trailers = make([]CommitTrailer, n)
+			var t serial.CommitTrailer
+			for i := 0; i < n; i++ {
+				if _, err := cmsg.TryTrailers(&t, i); err != nil {
+					return nil, err
+				}
+				ret.Trailers[i] = CommitTrailer{Key: string(t.Key()), Value: string(t.Value())}
+			}
+		}
 		return ret, nil
 	}
 	c, ok := cv.(types.Struct)
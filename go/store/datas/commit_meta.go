@@ -36,6 +36,10 @@ const (
 	commitMetaVersionKey   = "metaversion"
 	commitMetaSignature    = "signature"
 
+	commitMetaCommitterNameKey  = "committer_name"
+	commitMetaCommitterEmailKey = "committer_email"
+	commitMetaTrailersKey       = "trailers"
+
 	commitMetaStName  = "metadata"
 	commitMetaVersion = "1.0"
 )
@@ -54,7 +58,8 @@ var AuthorDate = time.Now
 var CustomAuthorDate bool
 var AuthorLoc = time.Local
 
-// CommitMeta contains all the metadata that is associated with a commit within a data repo.
+// CommitMeta contains all the metadata that is associated with a commit within a data repo. Name, Email and
+// UserTimestamp describe the author of the commit, and Timestamp is the time the commit was created.
 type CommitMeta struct {
 	Name          string
 	Email         string
@@ -62,6 +67,73 @@ type CommitMeta struct {
 	Description   string
 	UserTimestamp int64
 	Signature     string
+
+	// CommitterName and CommitterEmail identify who created the commit when that is not the author, for example
+	// when the commit was cherry-picked or rebased. When empty, the author is the committer.
+	CommitterName  string
+	CommitterEmail string
+
+	// Trailers are structured key/value pairs attached to the commit message, such as "Reviewed-by" or "Ticket".
+	Trailers []CommitTrailer
+}
+
+// CommitTrailer is a single key/value trailer attached to a commit.
+type CommitTrailer struct {
+	Key   string
+	Value string
+}
+
+// String returns the trailer in the "Key: value" form.
+func (t CommitTrailer) String() string {
+	return t.Key + ": " + t.Value
+}
+
+// ParseCommitTrailer parses a trailer given in either the "Key: value" or the "Key=value" form.
+func ParseCommitTrailer(str string) (CommitTrailer, error) {
+	idx := strings.IndexAny(str, ":=")
+	if idx < 0 {
+		return CommitTrailer{}, fmt.Errorf("invalid trailer '%s': trailers must be given as 'Key: value' or 'Key=value'", str)
+	}
+
+	key := strings.TrimSpace(str[:idx])
+	value := strings.TrimSpace(str[idx+1:])
+	if key == "" || strings.ContainsAny(key, " \t\n") {
+		return CommitTrailer{}, fmt.Errorf("invalid trailer '%s': trailer keys must be a single non-empty word", str)
+	}
+	if value == "" {
+		return CommitTrailer{}, fmt.Errorf("invalid trailer '%s': trailer value is empty", str)
+	}
+	if strings.Contains(value, "\n") {
+		return CommitTrailer{}, fmt.Errorf("invalid trailer '%s': trailer values cannot span multiple lines", str)
+	}
+
+	return CommitTrailer{Key: key, Value: value}, nil
+}
+
+// FormatTrailers returns |trailers| as newline separated "Key: value" lines.
+func FormatTrailers(trailers []CommitTrailer) string {
+	lines := make([]string, len(trailers))
+	for i, t := range trailers {
+		lines[i] = t.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ParseTrailers parses newline separated trailers, as returned by FormatTrailers.
+func ParseTrailers(str string) ([]CommitTrailer, error) {
+	if str == "" {
+		return nil, nil
+	}
+
+	var trailers []CommitTrailer
+	for _, line := range strings.Split(str, "\n") {
+		t, err := ParseCommitTrailer(line)
+		if err != nil {
+			return nil, err
+		}
+		trailers = append(trailers, t)
+	}
+	return trailers, nil
 }
 
 // NewCommitMeta creates a CommitMeta instance from a name, email, and description and uses the current time for the
@@ -120,7 +192,13 @@ func NewCommitMetaWithUserTS(name, email, desc string, userTS time.Time) (*Commi
 	committerDateMillis := uint64(CommitterDate().UnixMilli())
 	authorDateMillis := userTS.UnixMilli()
 
-	return &CommitMeta{n, e, committerDateMillis, d, authorDateMillis, ""}, nil
+	return &CommitMeta{
+		Name:          n,
+		Email:         e,
+		Timestamp:     committerDateMillis,
+		Description:   d,
+		UserTimestamp: authorDateMillis,
+	}, nil
 }
 
 func getRequiredFromSt(st types.Struct, k string) (types.Value, error) {
@@ -174,14 +252,37 @@ func CommitMetaFromNomsSt(st types.Struct) (*CommitMeta, error) {
 		signature = types.String("")
 	}
 
-	return &CommitMeta{
-		string(n.(types.String)),
-		string(e.(types.String)),
-		uint64(ts.(types.Uint)),
-		string(d.(types.String)),
-		int64(userTS.(types.Int)),
-		string(signature.(types.String)),
-	}, nil
+	cm := &CommitMeta{
+		Name:          string(n.(types.String)),
+		Email:         string(e.(types.String)),
+		Timestamp:     uint64(ts.(types.Uint)),
+		Description:   string(d.(types.String)),
+		UserTimestamp: int64(userTS.(types.Int)),
+		Signature:     string(signature.(types.String)),
+	}
+
+	if committerName, ok, err := st.MaybeGet(commitMetaCommitterNameKey); err != nil {
+		return nil, err
+	} else if ok {
+		cm.CommitterName = string(committerName.(types.String))
+	}
+
+	if committerEmail, ok, err := st.MaybeGet(commitMetaCommitterEmailKey); err != nil {
+		return nil, err
+	} else if ok {
+		cm.CommitterEmail = string(committerEmail.(types.String))
+	}
+
+	if trailers, ok, err := st.MaybeGet(commitMetaTrailersKey); err != nil {
+		return nil, err
+	} else if ok {
+		cm.Trailers, err = ParseTrailers(string(trailers.(types.String)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cm, nil
 }
 
 func (cm *CommitMeta) toNomsStruct(nbf *types.NomsBinFormat) (types.Struct, error) {
@@ -195,6 +296,14 @@ func (cm *CommitMeta) toNomsStruct(nbf *types.NomsBinFormat) (types.Struct, erro
 		commitMetaSignature:    types.String(cm.Signature),
 	}
 
+	if cm.HasDistinctCommitter() {
+		metadata[commitMetaCommitterNameKey] = types.String(cm.CommitterName)
+		metadata[commitMetaCommitterEmailKey] = types.String(cm.CommitterEmail)
+	}
+	if len(cm.Trailers) > 0 {
+		metadata[commitMetaTrailersKey] = types.String(FormatTrailers(cm.Trailers))
+	}
+
 	return types.NewStruct(nbf, commitMetaStName, metadata)
 }
 
@@ -203,6 +312,27 @@ func (cm *CommitMeta) Time() time.Time {
 	return time.UnixMilli(cm.UserTimestamp)
 }
 
+// Committer returns the name and email of whoever created the commit, which is the author unless a distinct committer
+// was recorded.
+func (cm *CommitMeta) Committer() (name, email string) {
+	if cm.CommitterName == "" {
+		return cm.Name, cm.Email
+	}
+	return cm.CommitterName, cm.CommitterEmail
+}
+
+// HasDistinctCommitter returns whether the commit was created by someone other than its author.
+func (cm *CommitMeta) HasDistinctCommitter() bool {
+	name, email := cm.Committer()
+	return name != cm.Name || email != cm.Email
+}
+
+// CommitterTime returns the time at which the commit was created, which may differ from the authored time returned
+// by Time.
+func (cm *CommitMeta) CommitterTime() time.Time {
+	return time.UnixMilli(int64(cm.Timestamp))
+}
+
 // FormatTS takes the internal timestamp and turns it into a human readable string in the time.RubyDate format
 // which looks like: "Mon Jan 02 15:04:05 -0700 2006"
 func (cm *CommitMeta) FormatTS() string {
@@ -15,11 +15,14 @@
 package datas
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/dolthub/dolt/go/store/hash"
 	"github.com/dolthub/dolt/go/store/types"
 )
 
@@ -37,3 +40,101 @@ func TestCommitMetaToAndFromNomsStruct(t *testing.T) {
 
 	t.Log(cm.String())
 }
+
+func newCommitMetaWithCommitterAndTrailers(t *testing.T) *CommitMeta {
+	cm, err := NewCommitMeta("Bill Billerson", "bigbillieb@fake.horse", "This is a test commit")
+	require.NoError(t, err)
+	cm.CommitterName = "Jane Committer"
+	cm.CommitterEmail = "jane@fake.horse"
+	cm.Trailers = []CommitTrailer{
+		{Key: "Reviewed-by", Value: "Ann Reviewer <ann@fake.horse>"},
+		{Key: "Ticket", Value: "DB-123"},
+	}
+	return cm
+}
+
+func TestCommitMetaCommitterAndTrailersToAndFromNomsStruct(t *testing.T) {
+	cm := newCommitMetaWithCommitterAndTrailers(t)
+	cmSt, err := cm.toNomsStruct(types.Format_Default)
+	require.NoError(t, err)
+	result, err := CommitMetaFromNomsSt(cmSt)
+	require.NoError(t, err)
+	assert.Equal(t, cm, result)
+}
+
+func TestCommitMetaCommitterAndTrailersToAndFromFlatbuffer(t *testing.T) {
+	ctx := context.Background()
+
+	cm := newCommitMetaWithCommitterAndTrailers(t)
+	msg, _ := commit_flatbuffer(hash.Hash{}, CommitOptions{Meta: cm}, nil, hash.Hash{})
+	result, err := GetCommitMeta(ctx, types.SerialMessage(msg))
+	require.NoError(t, err)
+	assert.Equal(t, cm, result)
+
+	// A committer that is the author is not stored, and commits without a committer or trailers serialize exactly
+	// as they did before those fields were added.
+	plain, err := NewCommitMeta("Bill Billerson", "bigbillieb@fake.horse", "This is a test commit")
+	require.NoError(t, err)
+	withAuthorAsCommitter := *plain
+	withAuthorAsCommitter.CommitterName = plain.Name
+	withAuthorAsCommitter.CommitterEmail = plain.Email
+	plainMsg, _ := commit_flatbuffer(hash.Hash{}, CommitOptions{Meta: plain}, nil, hash.Hash{})
+	authorAsCommitterMsg, _ := commit_flatbuffer(hash.Hash{}, CommitOptions{Meta: &withAuthorAsCommitter}, nil, hash.Hash{})
+	assert.Equal(t, plainMsg, authorAsCommitterMsg)
+
+	result, err = GetCommitMeta(ctx, types.SerialMessage(plainMsg))
+	require.NoError(t, err)
+	assert.False(t, result.HasDistinctCommitter())
+	name, email := result.Committer()
+	assert.Equal(t, plain.Name, name)
+	assert.Equal(t, plain.Email, email)
+	assert.Empty(t, result.Trailers)
+}
+
+func TestParseCommitTrailer(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected CommitTrailer
+		err      bool
+	}{
+		{input: "Reviewed-by: Jane Doe <jane@doe.com>", expected: CommitTrailer{Key: "Reviewed-by", Value: "Jane Doe <jane@doe.com>"}},
+		{input: "Ticket=DB-123", expected: CommitTrailer{Key: "Ticket", Value: "DB-123"}},
+		{input: "  Ticket :  DB-123  ", expected: CommitTrailer{Key: "Ticket", Value: "DB-123"}},
+		{input: "Link: https://example.com/a=b", expected: CommitTrailer{Key: "Link", Value: "https://example.com/a=b"}},
+		{input: "no separator", err: true},
+		{input: ": value", err: true},
+		{input: "Two words: value", err: true},
+		{input: "Ticket:", err: true},
+		{input: "Ticket: one\ntwo", err: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			trailer, err := ParseCommitTrailer(test.input)
+			if test.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, trailer)
+		})
+	}
+}
+
+func TestFormatAndParseTrailers(t *testing.T) {
+	trailers := []CommitTrailer{
+		{Key: "Reviewed-by", Value: "Jane Doe <jane@doe.com>"},
+		{Key: "Ticket", Value: "DB-123"},
+	}
+	formatted := FormatTrailers(trailers)
+	assert.Equal(t, "Reviewed-by: Jane Doe <jane@doe.com>\nTicket: DB-123", formatted)
+
+	parsed, err := ParseTrailers(formatted)
+	require.NoError(t, err)
+	assert.Equal(t, trailers, parsed)
+
+	parsed, err = ParseTrailers("")
+	require.NoError(t, err)
+	assert.Empty(t, parsed)
+	assert.Equal(t, "", FormatTrailers(nil))
+}
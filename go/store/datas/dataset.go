@@ -212,6 +212,8 @@ type MergeState struct {
 	fromCommitSpec      string
 	unmergableTables    []string
 	isCherryPick        bool
+	cherryPickQueue     []hash.Hash
+	cherryPickOrigHead  hash.Hash
 
 	nomsMergeStateRef *types.Ref
 	nomsMergeState    *types.Struct
@@ -317,6 +319,23 @@ func (ms *MergeState) UnmergableTables(ctx context.Context, vr types.ValueReader
 	return nil, nil
 }
 
+// CherryPickQueue returns the addresses of the commits that remain to be cherry-picked after the commit being merged.
+func (ms *MergeState) CherryPickQueue(_ context.Context, vr types.ValueReader) ([]hash.Hash, error) {
+	if vr.Format().UsesFlatbuffers() {
+		return ms.cherryPickQueue, nil
+	}
+	return nil, nil
+}
+
+// CherryPickOrigHead returns the address of the commit that HEAD pointed to before a cherry-pick of a sequence of
+// commits started, or an empty hash if none was recorded.
+func (ms *MergeState) CherryPickOrigHead(_ context.Context, vr types.ValueReader) (hash.Hash, error) {
+	if vr.Format().UsesFlatbuffers() {
+		return ms.cherryPickOrigHead, nil
+	}
+	return hash.Hash{}, nil
+}
+
 type dsHead interface {
 	TypeName() string
 	Addr() hash.Hash
@@ -443,6 +462,15 @@ func (h serialWorkingSetHead) HeadWorkingSet() (*WorkingSetHead, error) {
 			ret.MergeState.unmergableTables[i] = string(mergeState.UnmergableTables(i))
 		}
 		ret.MergeState.isCherryPick = mergeState.IsCherryPick()
+		if addrs := mergeState.CherryPickQueueAddrsBytes(); len(addrs) > 0 {
+			ret.MergeState.cherryPickQueue = make([]hash.Hash, len(addrs)/hash.ByteLen)
+			for i := range ret.MergeState.cherryPickQueue {
+				ret.MergeState.cherryPickQueue[i] = hash.New(addrs[i*hash.ByteLen : (i+1)*hash.ByteLen])
+			}
+		}
+		if addr := mergeState.CherryPickOrigHeadAddrBytes(); len(addr) > 0 {
+			ret.MergeState.cherryPickOrigHead = hash.New(addr)
+		}
 	}
 
 	rebaseState, err := h.msg.TryRebaseState(nil)
@@ -269,3 +269,35 @@ func TestValidateDatasetId(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkingSetCherryPickQueue(t *testing.T) {
+	working := hash.Of([]byte("working"))
+	ms := &MergeState{
+		preMergeWorkingAddr: new(hash.Hash),
+		fromCommitAddr:      new(hash.Hash),
+		isCherryPick:        true,
+		cherryPickQueue:     []hash.Hash{hash.Of([]byte("commit1")), hash.Of([]byte("commit2"))},
+		cherryPickOrigHead:  hash.Of([]byte("original head")),
+	}
+	*ms.preMergeWorkingAddr = hash.Of([]byte("pre-merge working"))
+	*ms.fromCommitAddr = hash.Of([]byte("from commit"))
+
+	msg := workingset_flatbuffer(working, nil, ms, nil, &WorkingSetMeta{})
+	head, err := newSerialWorkingSetHead(msg, hash.Of(msg))
+	assert.NoError(t, err)
+	ws, err := head.HeadWorkingSet()
+	assert.NoError(t, err)
+	assert.Equal(t, ms.cherryPickQueue, ws.MergeState.cherryPickQueue)
+	assert.Equal(t, ms.cherryPickOrigHead, ws.MergeState.cherryPickOrigHead)
+
+	// The queued commits and the original head must be reachable from the working set, so that they are kept by
+	// garbage collection
+	var addrs []hash.Hash
+	err = types.SerialMessage(msg).WalkAddrs(types.Format_DOLT, func(addr hash.Hash) error {
+		addrs = append(addrs, addr)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Subset(t, addrs, ms.cherryPickQueue)
+	assert.Contains(t, addrs, ms.cherryPickOrigHead)
+}
@@ -89,6 +89,7 @@ func GetStashData(val types.Value) (hash.Hash, hash.Hash, *StashMeta, error) {
 	}
 
 	meta := NewStashMeta(string(msg.BranchName()), string(msg.Desc()), tblsToStage)
+	meta.Message = string(msg.Message())
 	stashRootAddr := hash.New(msg.StashRootAddrBytes())
 	headCommitAddr := hash.New(msg.HeadCommitAddrBytes())
 
@@ -103,10 +104,14 @@ func stash_flatbuffer(stash, head hash.Hash, meta *StashMeta) serial.Message {
 	descOff := builder.CreateString(meta.Description)
 	var (
 		addedTblsOff flatbuffers.UOffsetT
+		messageOff   flatbuffers.UOffsetT
 	)
 	if meta.TablesToStage != nil {
 		addedTblsOff = SerializeStringVector(builder, meta.TablesToStage)
 	}
+	if meta.Message != "" {
+		messageOff = builder.CreateString(meta.Message)
+	}
 
 	serial.StashStart(builder)
 	serial.StashAddStashRootAddr(builder, stashOff)
@@ -114,6 +119,9 @@ func stash_flatbuffer(stash, head hash.Hash, meta *StashMeta) serial.Message {
 	serial.StashAddBranchName(builder, branchNameOff)
 	serial.StashAddDesc(builder, descOff)
 	serial.StashAddTablesToStage(builder, addedTblsOff)
+	if meta.Message != "" {
+		serial.StashAddMessage(builder, messageOff)
+	}
 
 	return serial.FinishMessage(builder, serial.StashEnd(builder), []byte(serial.StashFileID))
 }
@@ -135,10 +143,12 @@ func SerializeStringVector(b *flatbuffers.Builder, s []string) flatbuffers.UOffs
 // The Description is the head commit description of the branch that the stash was made on.
 // The TablesToStage is array of table names that needs to be staged when popping the stash.
 // These tables were added tables that were staged when stashing.
+// The Message is the optional message given when the stash was made, and is empty if none was given.
 type StashMeta struct {
 	BranchName    string
 	Description   string
 	TablesToStage []string
+	Message       string
 }
 
 // NewStashMeta returns StashMeta that can be used to create a stash.
@@ -146,5 +156,5 @@ func NewStashMeta(name, desc string, tblsToStage []string) *StashMeta {
 	bn := strings.TrimSpace(name)
 	d := strings.TrimSpace(desc)
 
-	return &StashMeta{bn, d, tblsToStage}
+	return &StashMeta{bn, d, tblsToStage, ""}
 }
@@ -175,12 +175,32 @@ func workingset_flatbuffer(working hash.Hash, staged *hash.Hash, mergeState *Mer
 		fromaddroff := builder.CreateByteVector((*mergeState.fromCommitAddr)[:])
 		fromspecoff := builder.CreateString(mergeState.fromCommitSpec)
 		unmergableoff := SerializeStringVector(builder, mergeState.unmergableTables)
+		var queueoff flatbuffers.UOffsetT
+		if len(mergeState.cherryPickQueue) > 0 {
+			addrs := make([]byte, 0, len(mergeState.cherryPickQueue)*hash.ByteLen)
+			for _, h := range mergeState.cherryPickQueue {
+				addrs = append(addrs, h[:]...)
+			}
+			queueoff = builder.CreateByteVector(addrs)
+		}
+		var origheadoff flatbuffers.UOffsetT
+		if !mergeState.cherryPickOrigHead.IsEmpty() {
+			origheadoff = builder.CreateByteVector(mergeState.cherryPickOrigHead[:])
+		}
 		serial.MergeStateStart(builder)
 		serial.MergeStateAddPreWorkingRootAddr(builder, prerootaddroff)
 		serial.MergeStateAddFromCommitAddr(builder, fromaddroff)
 		serial.MergeStateAddFromCommitSpecStr(builder, fromspecoff)
 		serial.MergeStateAddUnmergableTables(builder, unmergableoff)
 		serial.MergeStateAddIsCherryPick(builder, mergeState.isCherryPick)
+		// The queue and original head are only written when present, so that working sets without them remain
+		// readable by older clients
+		if queueoff != 0 {
+			serial.MergeStateAddCherryPickQueueAddrs(builder, queueoff)
+		}
+		if origheadoff != 0 {
+			serial.MergeStateAddCherryPickOrigHeadAddr(builder, origheadoff)
+		}
 		mergeStateOff = serial.MergeStateEnd(builder)
 	}
 
@@ -235,6 +255,8 @@ func NewMergeState(
 	commitSpecStr string,
 	unmergableTables []string,
 	isCherryPick bool,
+	cherryPickQueue []hash.Hash,
+	cherryPickOrigHead hash.Hash,
 ) (*MergeState, error) {
 	if vrw.Format().UsesFlatbuffers() {
 		ms := &MergeState{
@@ -243,6 +265,8 @@ func NewMergeState(
 			fromCommitSpec:      commitSpecStr,
 			unmergableTables:    unmergableTables,
 			isCherryPick:        isCherryPick,
+			cherryPickQueue:     cherryPickQueue,
+			cherryPickOrigHead:  cherryPickOrigHead,
 		}
 		*ms.preMergeWorkingAddr = preMergeWorking.TargetHash()
 		*ms.fromCommitAddr = commit.Addr()
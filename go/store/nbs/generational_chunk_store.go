@@ -482,7 +482,30 @@ func (gcs *GenerationalNBS) EndGC() {
 }
 
 func (gcs *GenerationalNBS) MarkAndSweepChunks(ctx context.Context, getAddrs chunks.GetAddrsCurry, filter chunks.HasManyFunc, dest chunks.ChunkStore, mode chunks.GCMode) (chunks.MarkAndSweeper, error) {
-	return markAndSweepChunks(ctx, gcs.newGen, gcs, dest, getAddrs, filter, mode)
+	return markAndSweepChunks(ctx, gcs.newGen, gcs, dest, getAddrs, gcs.skipGhosts(filter), mode)
+}
+
+// skipGhosts wraps |filter| so that the GC walk never visits ghost chunks. Commits pruned from the history of the
+// database are recorded as ghosts while their chunks are still in the store, and GC must not keep those chunks.
+func (gcs *GenerationalNBS) skipGhosts(filter chunks.HasManyFunc) chunks.HasManyFunc {
+	if gcs.ghostGen == nil {
+		return filter
+	}
+	return func(ctx context.Context, hashes hash.HashSet) (hash.HashSet, error) {
+		absent, err := filter(ctx, hashes)
+		if err != nil {
+			return nil, err
+		}
+		ghosts := gcs.ghostGen.ghostsIn(absent)
+		if len(ghosts) == 0 {
+			return absent, nil
+		}
+		absent = absent.Copy()
+		for _, h := range ghosts {
+			absent.Remove(h)
+		}
+		return absent, nil
+	}
 }
 
 func (gcs *GenerationalNBS) IterateAllChunks(ctx context.Context, cb func(chunk chunks.Chunk)) error {
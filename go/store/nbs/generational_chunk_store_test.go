@@ -171,3 +171,39 @@ func TestGenerationalCS(t *testing.T) {
 	putChunks(t, ctx, chnks, cs, inNew, 15, 16, 17, 18, 19)
 	requireChunks(t, ctx, chnks, cs, inOld, inNew)
 }
+
+func TestGenerationalCSGhosts(t *testing.T) {
+	ctx := context.Background()
+	oldGen, _, _ := makeTestLocalStore(t, 64)
+	newGen, _, _ := makeTestLocalStore(t, 64)
+	ghostGen, err := NewGhostBlockStore(t.TempDir())
+	require.NoError(t, err)
+	chnks := genChunks(t, 3, 1000)
+	present, ghost, prunedGhost := chnks[0], chnks[1], chnks[2]
+
+	cs := NewGenerationalCS(oldGen, newGen, ghostGen)
+	require.NoError(t, cs.Put(ctx, present, noopGetAddrs))
+	require.NoError(t, cs.Put(ctx, prunedGhost, noopGetAddrs))
+	require.NoError(t, ghostGen.PersistGhostHashes(ctx, hash.NewHashSet(ghost.Hash(), prunedGhost.Hash())))
+
+	t.Run("Get", func(t *testing.T) {
+		c, err := cs.Get(ctx, ghost.Hash())
+		require.NoError(t, err)
+		require.True(t, c.IsGhost())
+
+		// Chunks which are still in the store are returned instead of their ghosts.
+		c, err = cs.Get(ctx, prunedGhost.Hash())
+		require.NoError(t, err)
+		require.False(t, c.IsGhost())
+		require.Equal(t, prunedGhost.Data(), c.Data())
+	})
+	t.Run("GCFilter", func(t *testing.T) {
+		all := hash.NewHashSet(present.Hash(), ghost.Hash(), prunedGhost.Hash())
+		toVisit, err := cs.skipGhosts(func(_ context.Context, hashes hash.HashSet) (hash.HashSet, error) {
+			return hashes, nil
+		})(ctx, all)
+		require.NoError(t, err)
+		require.True(t, toVisit.Equals(hash.NewHashSet(present.Hash())))
+		require.Equal(t, 3, all.Size())
+	})
+}
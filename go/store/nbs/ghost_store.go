@@ -22,6 +22,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/dolthub/dolt/go/store/chunks"
 	"github.com/dolthub/dolt/go/store/constants"
@@ -29,6 +30,7 @@ import (
 )
 
 type GhostBlockStore struct {
+	mu               sync.RWMutex
 	skippedRefs      *hash.HashSet
 	ghostObjectsFile string
 }
@@ -74,14 +76,18 @@ func NewGhostBlockStore(nomsPath string) (*GhostBlockStore, error) {
 
 // Get returns a ghost chunk if the hash is in the ghostObjectsFile. Otherwise, it returns an empty chunk. Chunks returned
 // by this code will always be ghost chunks, ie chunk.IsGhost() will always return true.
-func (g GhostBlockStore) Get(ctx context.Context, h hash.Hash) (chunks.Chunk, error) {
+func (g *GhostBlockStore) Get(ctx context.Context, h hash.Hash) (chunks.Chunk, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
 	if g.skippedRefs.Has(h) {
 		return *chunks.NewGhostChunk(h), nil
 	}
 	return chunks.EmptyChunk, nil
 }
 
-func (g GhostBlockStore) GetMany(ctx context.Context, hashes hash.HashSet, found func(context.Context, *chunks.Chunk)) error {
+func (g *GhostBlockStore) GetMany(ctx context.Context, hashes hash.HashSet, found func(context.Context, *chunks.Chunk)) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
 	for h := range hashes {
 		if g.skippedRefs.Has(h) {
 			found(ctx, chunks.NewGhostChunk(h))
@@ -90,7 +96,9 @@ func (g GhostBlockStore) GetMany(ctx context.Context, hashes hash.HashSet, found
 	return nil
 }
 
-func (g GhostBlockStore) GetManyCompressed(ctx context.Context, hashes hash.HashSet, found func(context.Context, CompressedChunk)) error {
+func (g *GhostBlockStore) GetManyCompressed(ctx context.Context, hashes hash.HashSet, found func(context.Context, CompressedChunk)) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
 	for h := range hashes {
 		if g.skippedRefs.Has(h) {
 			found(ctx, NewGhostCompressedChunk(h))
@@ -104,16 +112,36 @@ func (g *GhostBlockStore) PersistGhostHashes(ctx context.Context, hashes hash.Ha
 		return fmt.Errorf("runtime error. PersistGhostHashes called with empty hash set")
 	}
 
-	f, err := os.OpenFile(g.ghostObjectsFile, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
+	g.mu.Lock()
+	defer g.mu.Unlock()
 
-	for h := range hashes {
-		if _, err := f.WriteString(h.String() + "\n"); err != nil {
+	// The file is written next to the current one and then moved over it, so the ghosts already persisted are not lost
+	// if writing the new set fails part way. Once history has been pruned by a GC, losing them would leave dangling
+	// references to the pruned commits.
+	tmpPath := g.ghostObjectsFile + ".tmp"
+	err := func() error {
+		f, err := os.OpenFile(tmpPath, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
 			return err
 		}
+		defer f.Close()
+
+		wr := bufio.NewWriter(f)
+		for h := range hashes {
+			if _, err := wr.WriteString(h.String() + "\n"); err != nil {
+				return err
+			}
+		}
+		if err := wr.Flush(); err != nil {
+			return err
+		}
+		return f.Sync()
+	}()
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, g.ghostObjectsFile); err != nil {
+		return err
 	}
 
 	g.skippedRefs = &hash.HashSet{}
@@ -124,18 +152,22 @@ func (g *GhostBlockStore) PersistGhostHashes(ctx context.Context, hashes hash.Ha
 	return nil
 }
 
-func (g GhostBlockStore) Has(ctx context.Context, h hash.Hash) (bool, error) {
+func (g *GhostBlockStore) Has(ctx context.Context, h hash.Hash) (bool, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
 	if g.skippedRefs.Has(h) {
 		return true, nil
 	}
 	return false, nil
 }
 
-func (g GhostBlockStore) HasMany(ctx context.Context, hashes hash.HashSet) (absent hash.HashSet, err error) {
+func (g *GhostBlockStore) HasMany(ctx context.Context, hashes hash.HashSet) (absent hash.HashSet, err error) {
 	return g.hasMany(hashes)
 }
 
-func (g GhostBlockStore) hasMany(hashes hash.HashSet) (absent hash.HashSet, err error) {
+func (g *GhostBlockStore) hasMany(hashes hash.HashSet) (absent hash.HashSet, err error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
 	absent = hash.HashSet{}
 	for h := range hashes {
 		if !g.skippedRefs.Has(h) {
@@ -145,39 +177,62 @@ func (g GhostBlockStore) hasMany(hashes hash.HashSet) (absent hash.HashSet, err
 	return absent, nil
 }
 
-func (g GhostBlockStore) Put(ctx context.Context, c chunks.Chunk, getAddrs chunks.GetAddrsCurry) error {
+// ghostsIn returns the members of |hashes| which are ghost chunks.
+func (g *GhostBlockStore) ghostsIn(hashes hash.HashSet) []hash.Hash {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.skippedRefs.Size() == 0 {
+		return nil
+	}
+	var ghosts []hash.Hash
+	for h := range hashes {
+		if g.skippedRefs.Has(h) {
+			ghosts = append(ghosts, h)
+		}
+	}
+	return ghosts
+}
+
+// Hashes returns a copy of the addresses of every ghost chunk in the store.
+func (g *GhostBlockStore) Hashes() hash.HashSet {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.skippedRefs.Copy()
+}
+
+func (g *GhostBlockStore) Put(ctx context.Context, c chunks.Chunk, getAddrs chunks.GetAddrsCurry) error {
 	panic("GhostBlockStore does not support Put")
 }
 
-func (g GhostBlockStore) Version() string {
+func (g *GhostBlockStore) Version() string {
 	// This should never be used, but it makes testing a bit more ergonomic in a few places.
 	return constants.FormatDefaultString
 }
 
-func (g GhostBlockStore) AccessMode() chunks.ExclusiveAccessMode {
+func (g *GhostBlockStore) AccessMode() chunks.ExclusiveAccessMode {
 	panic("GhostBlockStore does not support AccessMode")
 }
 
-func (g GhostBlockStore) Rebase(ctx context.Context) error {
+func (g *GhostBlockStore) Rebase(ctx context.Context) error {
 	panic("GhostBlockStore does not support Rebase")
 }
 
-func (g GhostBlockStore) Root(ctx context.Context) (hash.Hash, error) {
+func (g *GhostBlockStore) Root(ctx context.Context) (hash.Hash, error) {
 	panic("GhostBlockStore does not support Root")
 }
 
-func (g GhostBlockStore) Commit(ctx context.Context, current, last hash.Hash) (bool, error) {
+func (g *GhostBlockStore) Commit(ctx context.Context, current, last hash.Hash) (bool, error) {
 	panic("GhostBlockStore does not support Commit")
 }
 
-func (g GhostBlockStore) Stats() interface{} {
+func (g *GhostBlockStore) Stats() interface{} {
 	panic("GhostBlockStore does not support Stats")
 }
 
-func (g GhostBlockStore) StatsSummary() string {
+func (g *GhostBlockStore) StatsSummary() string {
 	panic("GhostBlockStore does not support StatsSummary")
 }
 
-func (g GhostBlockStore) Close() error {
+func (g *GhostBlockStore) Close() error {
 	panic("GhostBlockStore does not support Close")
 }
@@ -84,3 +84,22 @@ func TestGhostBlockStore(t *testing.T) {
 		require.Equal(t, ghost, got[0].Hash())
 	})
 }
+
+func TestGhostBlockStorePersistReplacesGhosts(t *testing.T) {
+	ctx := context.Background()
+	path := t.TempDir()
+	bs, err := NewGhostBlockStore(path)
+	require.NoError(t, err)
+	first, second := hash.Parse("ifho8m890r9787lrpthif5ce6ru353fr"), hash.Parse("6af71afc2ea0hmp4olev0vp9q1q5gvb1")
+
+	require.NoError(t, bs.PersistGhostHashes(ctx, hash.NewHashSet(first)))
+	ghosts := bs.Hashes()
+	ghosts.Insert(second)
+	require.NoError(t, bs.PersistGhostHashes(ctx, ghosts))
+	require.True(t, bs.Hashes().Equals(hash.NewHashSet(first, second)))
+
+	// The ghosts are loaded again when the store is reopened.
+	reopened, err := NewGhostBlockStore(path)
+	require.NoError(t, err)
+	require.True(t, reopened.Hashes().Equals(hash.NewHashSet(first, second)))
+}
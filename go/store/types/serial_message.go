@@ -657,6 +657,17 @@ func (sm SerialMessage) WalkAddrs(nbf *NomsBinFormat, cb func(addr hash.Hash) er
 			if err = cb(hash.New(mergeState.FromCommitAddrBytes())); err != nil {
 				return err
 			}
+			addrs := mergeState.CherryPickQueueAddrsBytes()
+			for i := 0; i < len(addrs)/hash.ByteLen; i++ {
+				if err = cb(hash.New(addrs[i*hash.ByteLen : (i+1)*hash.ByteLen])); err != nil {
+					return err
+				}
+			}
+			if addr := mergeState.CherryPickOrigHeadAddrBytes(); len(addr) > 0 {
+				if err = cb(hash.New(addr)); err != nil {
+					return err
+				}
+			}
 		}
 	case serial.RootValueFileID:
 		var msg serial.RootValue
@@ -0,0 +1,133 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+
+    dolt sql -q "CREATE TABLE test(pk BIGINT PRIMARY KEY, v BIGINT)"
+    dolt commit -Am "Created table"
+
+    # the value inserted by the sixth commit is the first negative one
+    for i in 1 2 3 4 5 6 7 8; do
+        v=$i
+        if [ $i -ge 6 ]; then
+            v=-$i
+        fi
+        dolt sql -q "INSERT INTO test VALUES ($i, $v)"
+        dolt commit -am "insert $i"
+    done
+}
+
+teardown() {
+    assert_feature_version
+    teardown_common
+}
+
+@test "bisect: find the first bad commit by marking commits" {
+    run dolt bisect start HEAD HEAD~8
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Bisecting: 3 revisions left to test after this (roughly 2 steps)" ]] || false
+    [[ "$output" =~ "insert 4" ]] || false
+
+    run dolt branch --show-current
+    [ "$status" -eq 0 ]
+    [ "$output" = "dolt_bisect" ]
+
+    run dolt bisect good
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "insert 6" ]] || false
+
+    run dolt bisect bad
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "insert 5" ]] || false
+
+    run dolt sql -q "SELECT max(pk) FROM test" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "5" ]] || false
+
+    run dolt bisect good
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "is the first bad commit" ]] || false
+    [[ "$output" =~ "insert 6" ]] || false
+
+    run dolt bisect reset
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Switched to branch 'main'" ]] || false
+
+    run dolt branch --show-current
+    [ "$output" = "main" ]
+    run dolt branch
+    [[ ! "$output" =~ "dolt_bisect" ]] || false
+}
+
+@test "bisect: run a query to find the first bad commit" {
+    dolt bisect start HEAD HEAD~8
+
+    run dolt bisect run --query "SELECT * FROM test WHERE v < 0"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "running 'SELECT * FROM test WHERE v < 0'" ]] || false
+    [[ "$output" =~ "is the first bad commit" ]] || false
+    [[ "${lines[-1]}" =~ "insert 6" ]] || false
+
+    dolt bisect reset
+    run dolt log -n 1
+    [[ "$output" =~ "insert 8" ]] || false
+}
+
+@test "bisect: state is kept between commands" {
+    run dolt bisect start
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "waiting for both good and bad commits" ]] || false
+
+    run dolt bisect bad
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "waiting for good commit(s), bad commit known" ]] || false
+
+    run dolt bisect good HEAD~8
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "insert 4" ]] || false
+
+    run dolt bisect start
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "a bisect is already in progress" ]] || false
+
+    run dolt bisect run -q "SELECT * FROM test WHERE v < 0"
+    [ "$status" -eq 0 ]
+    [[ "${lines[-1]}" =~ "insert 6" ]] || false
+
+    dolt bisect reset
+    run dolt bisect good
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "no bisect in progress" ]] || false
+}
+
+@test "bisect: only skipped commits left" {
+    dolt bisect start main~2 main~5
+
+    run dolt bisect skip main~3 main~4
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "There are only 'skip'ped commits left to test." ]] || false
+    [[ "$output" =~ "We cannot bisect more!" ]] || false
+
+    dolt bisect reset
+}
+
+@test "bisect: errors" {
+    run dolt bisect start HEAD~8 HEAD
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "is an ancestor of a good commit" ]] || false
+    run dolt bisect reset
+    [ "$status" -eq 1 ]
+
+    dolt sql -q "INSERT INTO test VALUES (9, 9)"
+    run dolt bisect start HEAD HEAD~8
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "your local changes would be overwritten by bisect" ]] || false
+    dolt reset --hard
+
+    dolt bisect start HEAD
+    run dolt bisect run --query "SELECT * FROM test"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "a bad and a good commit are needed to run a bisect" ]] || false
+    dolt bisect reset
+}
@@ -0,0 +1,150 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+    TMPDIRS=$(pwd)/tmpdirs
+    mkdir -p $TMPDIRS/{repo1,repo2}
+
+    cd $TMPDIRS/repo1
+    dolt init
+    dolt sql -q "create table t1 (pk int primary key, v int)"
+    dolt sql -q "insert into t1 values (1, 1)"
+    dolt commit -Am "first commit"
+    dolt tag v1
+    dolt branch feature
+
+    cd $TMPDIRS/repo2
+    dolt init
+    cd $TMPDIRS
+}
+
+teardown() {
+    teardown_common
+    rm -rf $TMPDIRS
+    cd $BATS_TMPDIR
+}
+
+@test "bundle: create, verify and fetch a bundle" {
+    cd repo1
+    run dolt bundle create ../all.bundle main feature v1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Created bundle ../all.bundle" ]] || false
+    [[ "$output" =~ "refs/heads/main" ]] || false
+    [[ "$output" =~ "refs/heads/feature" ]] || false
+    [[ "$output" =~ "refs/tags/v1" ]] || false
+
+    cd ../repo2
+    run dolt bundle verify ../all.bundle
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "The bundle contains 3 ref(s)" ]] || false
+    [[ "$output" =~ "complete history" ]] || false
+    [[ "$output" =~ "../all.bundle is okay" ]] || false
+
+    run dolt fetch ../all.bundle
+    [ "$status" -eq 0 ]
+
+    run dolt branch -a
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "remotes/bundle/main" ]] || false
+    [[ "$output" =~ "remotes/bundle/feature" ]] || false
+
+    run dolt log bundle/main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "first commit" ]] || false
+
+    run dolt sql -q "select * from t1 as of 'bundle/main'" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,1" ]] || false
+}
+
+@test "bundle: fetch a bundle with refspecs" {
+    cd repo1
+    dolt bundle create ../all.bundle main feature
+
+    cd ../repo2
+    run dolt fetch ../all.bundle feature
+    [ "$status" -eq 0 ]
+
+    run dolt branch -a
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "remotes/bundle/feature" ]] || false
+    [[ ! "$output" =~ "remotes/bundle/main" ]] || false
+}
+
+@test "bundle: incremental bundle" {
+    cd repo1
+    dolt bundle create ../base.bundle main
+    dolt sql -q "insert into t1 values (2, 2)"
+    dolt commit -am "second commit"
+    base=$(dolt sql -q "select hashof('HEAD~1')" -r csv | tail -n 1)
+
+    run dolt bundle create --since HEAD~1 ../incremental.bundle main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "The bundle requires 1 commit(s)" ]] || false
+    [[ "$output" =~ "$base" ]] || false
+
+    # a database without the prerequisite commit can't use the bundle
+    cd ../repo2
+    run dolt bundle verify ../incremental.bundle
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "missing the prerequisite commits" ]] || false
+    [[ "$output" =~ "$base" ]] || false
+
+    run dolt fetch ../incremental.bundle
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "missing the prerequisite commits" ]] || false
+
+    run dolt branch -a
+    [[ ! "$output" =~ "remotes/bundle/main" ]] || false
+
+    # once it has the prerequisite commit, it can
+    dolt fetch ../base.bundle
+    run dolt bundle verify ../incremental.bundle
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "is okay" ]] || false
+
+    run dolt fetch ../incremental.bundle
+    [ "$status" -eq 0 ]
+
+    run dolt log bundle/main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "second commit" ]] || false
+
+    run dolt sql -q "select count(*) from t1 as of 'bundle/main'" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+
+    # the incremental bundle only includes the new data
+    [ $(wc -c < ../incremental.bundle) -lt $(wc -c < ../base.bundle) ]
+}
+
+@test "bundle: dolt_fetch() only reads bundles from dolt_bundle_dir" {
+    cd repo1
+    dolt bundle create ../all.bundle main
+
+    cd ../repo2
+    run dolt sql -q "call dolt_fetch('../all.bundle')"
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "unknown remote" ]] || false
+
+    run dolt branch -a
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "bundle/main" ]] || false
+}
+
+@test "bundle: errors" {
+    cd repo1
+    run dolt bundle create ../bad.bundle not_a_branch
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "'not_a_branch' is not a branch or a tag" ]] || false
+    [ ! -f ../bad.bundle ]
+
+    run dolt bundle create ../bad.bundle
+    [ "$status" -ne 0 ]
+
+    echo "not a bundle" > ../not.bundle
+    run dolt bundle verify ../not.bundle
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "not a dolt bundle" ]] || false
+}
@@ -42,6 +42,25 @@ teardown() {
     [[ "$output" =~ "3,c" ]] || false
 }
 
+@test "cherry-pick: keeps the author and trailers of the cherry-picked commit" {
+    dolt sql -q "INSERT INTO test VALUES (4, 'd')"
+    dolt commit -am "Inserted 4" --author "John Doe <john@doe.com>" --trailer "Ticket: DB-4"
+    dolt checkout main
+
+    run dolt cherry-pick branch1
+    [ "$status" -eq "0" ]
+
+    run dolt sql -r csv -q "SELECT committer, email, trailers FROM dolt_log LIMIT 1"
+    [ "$status" -eq "0" ]
+    [[ "$output" =~ "John Doe,john@doe.com,Ticket: DB-4" ]] || false
+
+    run dolt log -n 1
+    [ "$status" -eq "0" ]
+    [[ "$output" =~ "Author: John Doe <john@doe.com>" ]] || false
+    [[ "$output" =~ "Committer: " ]] || false
+    [[ "$output" =~ "Ticket: DB-4" ]] || false
+}
+
 @test "cherry-pick: multiple simple cherry-picks" {
     dolt sql -q "UPDATE test SET v = 'x' WHERE pk = 2"
     dolt sql -q "INSERT INTO test VALUES (5, 'g'), (8, 'u');"
@@ -413,6 +432,43 @@ teardown() {
     [[ $output =~ "cherry-picking a merge commit is not supported" ]] || false
 }
 
+@test "cherry-pick: cherry-pick a merge commit with a mainline parent" {
+    dolt checkout -b branch2
+    dolt sql -q "INSERT INTO test VALUES (4, 'd'), (5, 'e')"
+    dolt commit -am "add more rows in branch2"
+
+    dolt checkout branch1
+    dolt sql -q "INSERT INTO test VALUES (6, 'f'), (7, 'g')"
+    dolt commit -am "add more rows in branch1"
+    dolt merge branch2 -m "merge branch2"
+
+    dolt checkout main
+    run dolt cherry-pick -m 3 branch1
+    [ $status -eq 1 ]
+    [[ $output =~ "does not have parent 3" ]] || false
+
+    run dolt cherry-pick -m 1 branch1
+    [ $status -eq 0 ]
+    [[ $output =~ "merge branch2" ]] || false
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [[ "$output" =~ "4,d" ]] || false
+    [[ "$output" =~ "5,e" ]] || false
+    [[ ! "$output" =~ "1,a" ]] || false
+    [[ ! "$output" =~ "6,f" ]] || false
+
+    # -m is ignored for the commits in a range that aren't merge commits
+    dolt reset --hard HEAD~1
+    run dolt cherry-pick -m 1 branch2..branch1
+    [ $status -eq 0 ]
+    [[ $output =~ "merge branch2" ]] || false
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [[ "$output" =~ "4,d" ]] || false
+    [[ "$output" =~ "6,f" ]] || false
+    [[ ! "$output" =~ "1,a" ]] || false
+}
+
 @test "cherry-pick: cherry-pick commit is a cherry-picked commit" {
     dolt checkout -b branch2
     dolt sql -q "INSERT INTO test VALUES (4, 'd'), (5, 'e')"
@@ -618,3 +674,117 @@ teardown() {
     [ $status -eq 1 ]
     [[ $output =~ "error: cannot merge because table test has different primary keys" ]] || false
 }
+
+@test "cherry-pick: multiple commits and ranges" {
+    dolt checkout main
+    run dolt cherry-pick branch1~2 branch1
+    [ "$status" -eq "0" ]
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [[ "$output" =~ "1,a" ]] || false
+    [[ ! "$output" =~ "2,b" ]] || false
+    [[ "$output" =~ "3,c" ]] || false
+
+    dolt reset --hard HEAD~2
+    run dolt cherry-pick main..branch1
+    [ "$status" -eq "0" ]
+
+    run dolt log --oneline -n 3
+    [[ "${lines[0]}" =~ "Inserted 3" ]] || false
+    [[ "${lines[1]}" =~ "Inserted 2" ]] || false
+    [[ "${lines[2]}" =~ "Inserted 1" ]] || false
+}
+
+@test "cherry-pick: continue a sequence of commits after resolving conflicts" {
+    dolt checkout main
+    dolt sql -q "INSERT INTO test VALUES (2, 'z')"
+    dolt commit -am "Inserted 2z"
+
+    run dolt cherry-pick branch1~3..branch1
+    [ "$status" -eq "1" ]
+    [[ "$output" =~ "dolt cherry-pick --continue" ]] || false
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [[ "$output" =~ "1,a" ]] || false
+    [[ ! "$output" =~ "3,c" ]] || false
+
+    run dolt cherry-pick --continue
+    [ "$status" -eq "1" ]
+    [[ "$output" =~ "unresolved conflicts" ]] || false
+
+    dolt conflicts resolve --theirs test
+    dolt add test
+    run dolt cherry-pick --continue
+    [ "$status" -eq "0" ]
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [[ "$output" =~ "1,a" ]] || false
+    [[ "$output" =~ "2,b" ]] || false
+    [[ "$output" =~ "3,c" ]] || false
+
+    run dolt log --oneline -n 3
+    [[ "${lines[0]}" =~ "Inserted 3" ]] || false
+    [[ "${lines[1]}" =~ "Inserted 2" ]] || false
+    [[ "${lines[2]}" =~ "Inserted 1" ]] || false
+
+    run dolt cherry-pick --continue
+    [ "$status" -eq "1" ]
+    [[ "$output" =~ "There is no cherry-pick in progress" ]] || false
+}
+
+@test "cherry-pick: skip a conflicting commit in a sequence of commits" {
+    dolt checkout main
+    dolt sql -q "INSERT INTO test VALUES (2, 'z')"
+    dolt commit -am "Inserted 2z"
+
+    run dolt cherry-pick branch1~3..branch1
+    [ "$status" -eq "1" ]
+    [[ "$output" =~ "dolt cherry-pick --continue" ]] || false
+
+    run dolt cherry-pick --skip
+    [ "$status" -eq "0" ]
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [[ "$output" =~ "1,a" ]] || false
+    [[ "$output" =~ "2,z" ]] || false
+    [[ "$output" =~ "3,c" ]] || false
+
+    run dolt log --oneline -n 3
+    [[ "${lines[0]}" =~ "Inserted 3" ]] || false
+    [[ "${lines[1]}" =~ "Inserted 1" ]] || false
+    [[ "${lines[2]}" =~ "Inserted 2z" ]] || false
+
+    run dolt cherry-pick --skip
+    [ "$status" -eq "1" ]
+    [[ "$output" =~ "There is no cherry-pick in progress" ]] || false
+}
+
+@test "cherry-pick: abort a sequence of commits resets to the HEAD from before the sequence" {
+    dolt checkout main
+    dolt sql -q "INSERT INTO test VALUES (2, 'z')"
+    dolt commit -am "Inserted 2z"
+
+    run dolt cherry-pick branch1~3..branch1
+    [ "$status" -eq "1" ]
+    [[ "$output" =~ "dolt cherry-pick --continue" ]] || false
+
+    run dolt log --oneline -n 1
+    [[ "${lines[0]}" =~ "Inserted 1" ]] || false
+
+    dolt cherry-pick --abort
+
+    run dolt log --oneline -n 1
+    [[ "${lines[0]}" =~ "Inserted 2z" ]] || false
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [[ ! "$output" =~ "1,a" ]] || false
+    [[ "$output" =~ "2,z" ]] || false
+
+    # ignored tables in the working set are kept
+    run dolt sql -q "SHOW TABLES"
+    [[ "$output" =~ "generated_foo" ]] || false
+
+    run dolt cherry-pick --continue
+    [ "$status" -eq "1" ]
+    [[ "$output" =~ "There is no cherry-pick in progress" ]] || false
+}
@@ -373,3 +373,96 @@ EOF
     [ "$status" -eq 0 ]
     [[ "$output" =~ "workflow_2" ]] || false
 }
+
+@test "ci: run executes saved query steps and reports results" {
+    skip_remote_engine
+    dolt sql -q "create table t (pk int primary key, c int);"
+    dolt sql -q "insert into t values (1, 1), (2, 2);"
+    dolt sql -q "select * from t;" -s "all rows"
+    dolt add .
+    dolt commit -m "add table t"
+    cat > workflow.yaml <<EOF
+name: my_workflow
+on:
+  push:
+    branches:
+      - main
+jobs:
+  - name: validate t
+    steps:
+      - name: assert t has two rows
+        saved_query_name: all rows
+        expected_rows: "== 2"
+        expected_columns: "== 2"
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+
+    run dolt ci run "my_workflow"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "PASS  assert t has two rows" ]] || false
+    [[ "$output" =~ "1 passed, 0 failed" ]] || false
+
+    dolt sql -q "insert into t values (3, 3);"
+    run dolt ci run "my_workflow"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "FAIL  assert t has two rows" ]] || false
+    [[ "$output" =~ "expected row count == 2, got 3" ]] || false
+}
+
+@test "ci: run executes workflow against another branch" {
+    skip_remote_engine
+    dolt sql -q "create table t (pk int primary key);"
+    dolt sql -q "insert into t values (1);"
+    dolt sql -q "select * from t;" -s "all rows"
+    dolt add .
+    dolt commit -m "add table t"
+    cat > workflow.yaml <<EOF
+name: my_workflow
+on:
+  push:
+    branches:
+      - main
+jobs:
+  - name: validate t
+    steps:
+      - name: assert t has one row
+        saved_query_name: all rows
+        expected_rows: "== 1"
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+
+    dolt branch other
+    dolt sql -q "call dolt_checkout('other'); insert into t values (2); call dolt_commit('-am', 'add row');"
+
+    run dolt ci run "my_workflow"
+    [ "$status" -eq 0 ]
+
+    run dolt ci run "my_workflow" --branch other
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "on branch 'other'" ]] || false
+    [[ "$output" =~ "expected row count == 1, got 2" ]] || false
+}
+
+@test "ci: run errors on missing saved query" {
+    skip_remote_engine
+    cat > workflow.yaml <<EOF
+name: my_workflow
+on:
+  push:
+    branches:
+      - main
+jobs:
+  - name: validate tables
+    steps:
+      - name: assert expected tables exist
+        saved_query_name: missing query
+        expected_rows: "== 2"
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+    run dolt ci run "my_workflow"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "saved query not found: missing query" ]] || false
+}
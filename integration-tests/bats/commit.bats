@@ -95,6 +95,48 @@ teardown() {
     [[ "$output" =~ "john@doe.com" ]] || false
 }
 
+@test "commit: --author records the configured user as the committer" {
+    dolt sql -q "CREATE table t1 (pk int primary key);"
+    dolt add t1
+    dolt commit -m "add table t1" --author "John Doe <john@doe.com>"
+
+    name=$(current_dolt_user_name)
+    email=$(current_dolt_user_email)
+    run dolt sql -r csv -q "select committer, email, committer_name, committer_email from dolt_log limit 1"
+    [ $status -eq 0 ]
+    [[ "$output" =~ "John Doe,john@doe.com,$name,$email" ]] || false
+
+    run dolt log -n 1
+    [ $status -eq 0 ]
+    [[ "$output" =~ "Author: John Doe <john@doe.com>" ]] || false
+    [[ "$output" =~ "Committer: $name <$email>" ]] || false
+
+    # without --author, the author is the committer and no committer is shown
+    dolt commit --allow-empty -m "empty commit"
+    run dolt log -n 1
+    [ $status -eq 0 ]
+    [[ "$output" =~ "Author: $name <$email>" ]] || false
+    ! [[ "$output" =~ "Committer:" ]] || false
+}
+
+@test "commit: --trailer adds trailers to the commit" {
+    dolt sql -q "CREATE table t1 (pk int primary key);"
+    dolt add t1
+    run dolt commit --trailer "Reviewed-by: Jane Doe <jane@doe.com>" -m "add table t1" --trailer "Ticket=DB-123, DB-124"
+    [ $status -eq 0 ]
+    [[ "$output" =~ "Reviewed-by: Jane Doe <jane@doe.com>" ]] || false
+    [[ "$output" =~ "Ticket: DB-123, DB-124" ]] || false
+
+    run dolt show HEAD
+    [ $status -eq 0 ]
+    [[ "$output" =~ "Reviewed-by: Jane Doe <jane@doe.com>" ]] || false
+    [[ "$output" =~ "Ticket: DB-123, DB-124" ]] || false
+
+    run dolt commit --allow-empty -m "bad trailer" --trailer "not a trailer"
+    [ $status -eq 1 ]
+    [[ "$output" =~ "invalid trailer" ]] || false
+}
+
 @test "commit: failed to open commit editor." {
     export EDITOR="foo"
     export DOLT_TEST_FORCE_OPEN_EDITOR="1"
@@ -278,3 +320,22 @@ SQL
 
     [ "$head1" == "$head2" ]
 }
+
+@test "commit: dolt_commit_checks reject commits" {
+    dolt sql -q "create table accounts (id int primary key, balance int)"
+    dolt sql -q "insert into dolt_commit_checks values ('no negative balances', 'select id from accounts where balance < 0')"
+    dolt add .
+    dolt commit -m "add accounts"
+
+    dolt sql -q "insert into accounts values (1, 10), (2, -5)"
+    run dolt commit -am "add balances"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "commit check 'no negative balances' failed" ]] || false
+
+    dolt sql -q "update accounts set balance = 0 where id = 2"
+    dolt commit -am "add balances"
+
+    run dolt log -n 1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "add balances" ]] || false
+}
@@ -2124,3 +2124,75 @@ EOF
     # Count the line numbers to make sure there are no schema changes output
     [ "${#lines[@]}" -eq 11 ]
 }
+
+@test "diff: markdown output" {
+    dolt sql -q "insert into test values (0,0,0,0,0,0), (1,1,1,1,1,1)"
+    dolt add -A && dolt commit -m "added rows"
+    dolt sql -q "update test set c1 = 10 where pk = 0"
+    dolt sql -q "delete from test where pk = 1"
+    dolt sql -q "insert into test values (2,2,2,2,2,2)"
+
+    run dolt diff -r markdown
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '## `test`' ]] || false
+    [[ "$output" =~ "|   | pk | c1 | c2 | c3 | c4 | c5 |" ]] || false
+    [[ "$output" =~ "|---|---|---|---|---|---|---|" ]] || false
+    [[ "$output" =~ "| < | 0 | **0** | 0 | 0 | 0 | 0 |" ]] || false
+    [[ "$output" =~ "| > | 0 | **10** | 0 | 0 | 0 | 0 |" ]] || false
+    [[ "$output" =~ "| - | 1 | 1 | 1 | 1 | 1 | 1 |" ]] || false
+    [[ "$output" =~ "| + | 2 | 2 | 2 | 2 | 2 | 2 |" ]] || false
+
+    dolt sql -q "alter table test add column c6 varchar(20)"
+    dolt sql -q "update test set c6 = 'a|b' where pk = 2"
+
+    run dolt diff -r markdown --schema
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '```diff' ]] || false
+    [[ "$output" =~ '+  `c6` varchar(20),' ]] || false
+    [[ ! "$output" =~ "| pk |" ]] || false
+
+    run dolt diff -r markdown --data
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ '```diff' ]] || false
+    [[ "$output" =~ '| + | 2 | 2 | 2 | 2 | 2 | 2 | a\|b |' ]] || false
+
+    dolt sql -q "update test set c6 = '*a_b* ~~c~~ \`d\`' where pk = 2"
+    run dolt diff -r markdown --data
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '| + | 2 | 2 | 2 | 2 | 2 | 2 | \*a\_b\* \~\~c\~\~ \`d\` |' ]] || false
+
+    dolt add -A && dolt commit -m "more changes"
+    run dolt show -r markdown HEAD
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '## `test`' ]] || false
+    [[ "$output" =~ '```diff' ]] || false
+}
+
+@test "diff: html output" {
+    dolt sql -q "insert into test values (0,0,0,0,0,0), (1,1,1,1,1,1)"
+    dolt add -A && dolt commit -m "added rows"
+    dolt sql -q "update test set c1 = 10 where pk = 0"
+    dolt sql -q "delete from test where pk = 1"
+    dolt sql -q "alter table test add column c6 varchar(20)"
+    dolt sql -q "insert into test values (2,2,2,2,2,2,'<b>')"
+
+    run dolt diff -r html
+    [ "$status" -eq 0 ]
+    [[ "${lines[0]}" = "<!DOCTYPE html>" ]] || false
+    [[ "$output" =~ "<h2>test</h2>" ]] || false
+    [[ "$output" =~ '<span class="added">+  `c6` varchar(20),</span>' ]] || false
+    [[ "$output" =~ "<thead><tr><th></th><th>pk</th><th>c1</th><th>c2</th><th>c3</th><th>c4</th><th>c5</th><th>c6</th></tr></thead>" ]] || false
+    [[ "$output" =~ '<tr class="modified-old"><td class="marker">&lt;</td><td>0</td><td class="changed">0</td>' ]] || false
+    [[ "$output" =~ '<tr class="modified-new"><td class="marker">&gt;</td><td>0</td><td class="changed">10</td>' ]] || false
+    [[ "$output" =~ '<tr class="removed"><td class="marker">-</td><td>1</td>' ]] || false
+    [[ "$output" =~ '<tr class="added"><td class="marker">+</td><td>2</td><td>2</td><td>2</td><td>2</td><td>2</td><td>2</td><td>&lt;b&gt;</td></tr>' ]] || false
+    [[ "${lines[-1]}" = "</html>" ]] || false
+
+    run dolt diff -r HTML
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "<h2>test</h2>" ]] || false
+
+    run dolt diff -r pdf
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "invalid output format: pdf" ]] || false
+}
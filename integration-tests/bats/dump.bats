@@ -660,6 +660,32 @@ SQL
     [ -f doltdump/warehouse.json ]
 }
 
+@test "dump: JSONL type - one file per table with one row per line" {
+    dolt sql -q "CREATE TABLE new_table(pk int primary key);"
+    dolt sql -q "INSERT INTO new_table VALUES (1);"
+    dolt sql -q "CREATE TABLE warehouse(warehouse_id int primary key, warehouse_name varchar(100));"
+    dolt sql -q "INSERT into warehouse VALUES (1, 'UPS'), (2, 'TV'), (3, 'Table');"
+
+    run dolt dump -r jsonl
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully exported data." ]] || false
+    [ -f doltdump/new_table.jsonl ]
+    [ -f doltdump/warehouse.jsonl ]
+
+    run cat doltdump/warehouse.jsonl
+    [ "${#lines[@]}" -eq 3 ]
+    [[ "${lines[2]}" =~ '"warehouse_name":"Table"' ]] || false
+
+    run dolt dump -r jsonl
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "already exists" ]] || false
+
+    dolt sql -q "DELETE FROM warehouse;"
+    dolt table import -u warehouse doltdump/warehouse.jsonl
+    run dolt sql -q "SELECT count(*) FROM warehouse" -r csv
+    [[ "$output" =~ "3" ]] || false
+}
+
 @test "dump: JSON type - compare tables in database with tables imported from corresponding files" {
     create_tables
 
@@ -761,6 +787,45 @@ SQL
     [ ! -f dumps/warehouse.json ]
 }
 
+@test "dump: XLSX type - one workbook with a sheet per table" {
+    dolt sql -q "CREATE TABLE new_table(pk int primary key);"
+    dolt sql -q "INSERT INTO new_table VALUES (1);"
+    dolt sql -q "CREATE TABLE warehouse(warehouse_id int primary key, warehouse_name longtext);"
+    dolt sql -q "INSERT into warehouse VALUES (1, 'UPS'), (2, 'TV'), (3, 'Table');"
+
+    run dolt dump -r xlsx
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully exported data." ]] || false
+    [ -f doltdump.xlsx ]
+
+    run dolt dump -r xlsx
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "doltdump.xlsx already exists" ]] || false
+
+    dolt sql -q "DELETE FROM new_table; DELETE FROM warehouse;"
+    dolt table import -u new_table doltdump.xlsx
+    dolt table import -u warehouse doltdump.xlsx
+
+    run dolt sql -q "SELECT * FROM warehouse ORDER BY warehouse_id" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,UPS" ]] || false
+    [[ "$output" =~ "3,Table" ]] || false
+    run dolt sql -q "SELECT * FROM new_table" -r csv
+    [[ "$output" =~ "1" ]] || false
+
+    run dolt dump -f -r xlsx --file-name tables
+    [ "$status" -eq 0 ]
+    [ -f tables.xlsx ]
+}
+
+@test "dump: XLSX type - with directory name given" {
+    dolt sql -q "CREATE TABLE new_table(pk int primary key);"
+    run dolt dump -r xlsx --directory dumps
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "directory is not supported for xlsx exports" ]] || false
+    [ ! -d dumps ]
+}
+
 @test "dump: dump with schema-only flag" {
     dolt sql -q "CREATE TABLE new_table(pk int primary key);"
     dolt sql -q "INSERT INTO new_table VALUES (1), (2);"
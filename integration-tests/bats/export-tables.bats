@@ -82,6 +82,42 @@ if rows[2] != "9,8,7,6,5,4".split(","):
 '
 }
 
+@test "export-tables: jsonl export and import through files and stdin/stdout" {
+    dolt sql -q "INSERT INTO test_int VALUES (0, 1, 2, 3, 4, 5), (9, 8, 7, 6, 5, 4);"
+
+    run dolt table export test_int export.jsonl
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully exported data." ]] || false
+    run cat export.jsonl
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "${lines[0]}" =~ '"pk":0' ]] || false
+    [[ "${lines[1]}" =~ '"c5":4' ]] || false
+
+    run dolt table export --file-type=jsonl test_int
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ '"pk":9' ]] || false
+
+    dolt sql -q "DELETE FROM test_int;"
+    cp export.jsonl export.ndjson
+    run dolt table import -u test_int export.ndjson
+    [ "$status" -eq 0 ]
+    run dolt sql -q "SELECT * FROM test_int ORDER BY pk" -r csv
+    [[ "$output" =~ "0,1,2,3,4,5" ]] || false
+    [[ "$output" =~ "9,8,7,6,5,4" ]] || false
+
+    dolt sql -q "DELETE FROM test_int;"
+    cat export.jsonl | dolt table import -u --file-type=jsonl test_int
+    run dolt sql -q "SELECT count(*) FROM test_int" -r csv
+    [[ "$output" =~ "2" ]] || false
+
+    # a table without rows is exported as an empty file
+    dolt sql -q "DELETE FROM test_int;"
+    run dolt table export test_int empty.jsonl
+    [ "$status" -eq 0 ]
+    [ -f empty.jsonl ]
+    [ ! -s empty.jsonl ]
+}
+
 @test "export-tables: dolt table export" {
     dolt sql -q "insert into test_int values (0, 1, 2, 3, 4, 5)"
     run dolt table export test_int export.csv
@@ -322,6 +358,25 @@ SQL
    [[ "$output" =~ "1,2021-06-02 15:37:24" ]] ||  false
 }
 
+@test "export-tables: xlsx export can be reimported" {
+    dolt sql -q "CREATE TABLE test_table (pk int primary key, col1 text, col2 double);"
+    dolt sql -q "INSERT INTO test_table VALUES (1, 'row1', 22.5), (2, 'row2', 33);"
+
+    run dolt table export -f test_table result.xlsx
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully exported data." ]] || false
+    [ -f result.xlsx ]
+
+    dolt sql -q "DELETE FROM test_table;"
+    run dolt table import -u test_table result.xlsx
+    [ "$status" -eq 0 ]
+
+    run dolt sql -q "SELECT * FROM test_table ORDER BY pk" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,row1,22.5" ]] || false
+    [[ "$output" =~ "2,row2,33" ]] || false
+}
+
 @test "export-tables: parquet file export check with parquet cli" {
     skiponwindows "Missing dependencies"
     dolt sql -q "CREATE TABLE test_table (pk int primary key, col1 text, col2 int);"
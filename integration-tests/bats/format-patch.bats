@@ -0,0 +1,185 @@
+#!/usr/bin/env bats
+load $BATS_TEST_DIRNAME/helper/common.bash
+
+setup() {
+    setup_common
+    TMPDIRS=$(pwd)/tmpdirs
+    mkdir -p $TMPDIRS/{rem1,repo1}
+
+    cd $TMPDIRS/repo1
+    dolt init
+    dolt sql -q "create table t (pk int primary key, v int)"
+    dolt sql -q "insert into t values (1, 1), (2, 2)"
+    dolt commit -Am "init rows"
+    dolt remote add origin file://../rem1
+    dolt push origin main
+
+    cd $TMPDIRS
+    dolt clone file://./rem1 repo2
+
+    cd $TMPDIRS/repo1
+    dolt sql -q "update t set v = 10 where pk = 1"
+    dolt commit -am "update first row" --author "Alice <alice@example.com>"
+    dolt sql -q "insert into t values (3, 3)"
+    dolt sql -q "update t set v = 20 where pk = 2"
+    dolt commit -am "update second row" --author "Bob <bob@example.com>"
+    cd $TMPDIRS
+}
+
+teardown() {
+    teardown_common
+    rm -rf $TMPDIRS
+    cd $BATS_TMPDIR
+}
+
+@test "format-patch: writes one patch file per commit" {
+    cd repo1
+    run dolt format-patch -o ../patches HEAD~2
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "0001-update-first-row.patch" ]] || false
+    [[ "$output" =~ "0002-update-second-row.patch" ]] || false
+
+    run cat ../patches/0001-update-first-row.patch
+    [[ "$output" =~ "Author: Alice <alice@example.com>" ]] || false
+    [[ "$output" =~ "    update first row" ]] || false
+    [[ "$output" =~ 'UPDATE `t` SET `v`=10 WHERE `pk`=1;' ]] || false
+
+    run cat ../patches/0002-update-second-row.patch
+    [[ "$output" =~ "Author: Bob <bob@example.com>" ]] || false
+    [[ "$output" =~ "Statement-Lines: 1" ]] || false
+    [[ "$output" =~ "Revert-Lines: 1" ]] || false
+    [[ "$output" =~ "-- revert" ]] || false
+
+    run dolt format-patch -o ../range HEAD~1..HEAD
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ "0001-update-second-row.patch" ]] || false
+
+    run dolt format-patch HEAD..HEAD
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "no commits found" ]] || false
+}
+
+@test "format-patch: am applies patches with authorship intact" {
+    cd repo1
+    dolt format-patch -o ../patches HEAD~2
+
+    cd ../repo2
+    run dolt am ../patches/*.patch
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Applying: update first row" ]] || false
+    [[ "$output" =~ "Applying: update second row" ]] || false
+
+    run dolt log -n 2
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Author: Alice <alice@example.com>" ]] || false
+    [[ "$output" =~ "Author: Bob <bob@example.com>" ]] || false
+    [[ "$output" =~ "update first row" ]] || false
+    [[ "$output" =~ "update second row" ]] || false
+
+    run dolt sql -q "select * from t order by pk" -r csv
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1,10" ]
+    [ "${lines[2]}" = "2,20" ]
+    [ "${lines[3]}" = "3,3" ]
+
+    run dolt branch
+    [[ ! "$output" =~ "dolt_am" ]] || false
+    [ ! -f .dolt/am_state.json ]
+}
+
+@test "format-patch: am stops on drifted rows and continues after resolving" {
+    cd repo1
+    dolt format-patch -o ../patches HEAD~2
+
+    cd ../repo2
+    dolt sql -q "update t set v = 200 where pk = 2"
+    dolt commit -am "local change"
+
+    run dolt am ../patches/*.patch
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "Applying: update first row" ]] || false
+    [[ "$output" =~ "0002-update-second-row.patch has conflicts" ]] || false
+
+    run dolt sql -q "select our_v, their_v, base_v from dolt_conflicts_t" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "200,20,2" ]] || false
+
+    run dolt am ../patches/*.patch
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "already in progress" ]] || false
+
+    dolt conflicts resolve --theirs t
+    dolt add t
+    run dolt am --continue
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Applying: update second row" ]] || false
+
+    run dolt log -n 1
+    [[ "$output" =~ "Author: Bob <bob@example.com>" ]] || false
+    [[ "$output" =~ "update second row" ]] || false
+
+    run dolt sql -q "select * from t order by pk" -r csv
+    [ "${lines[2]}" = "2,20" ]
+    [ "${lines[3]}" = "3,3" ]
+}
+
+@test "format-patch: am --abort restores the branch" {
+    cd repo1
+    dolt format-patch -o ../patches HEAD~2
+
+    cd ../repo2
+    dolt sql -q "update t set v = 200 where pk = 2"
+    dolt commit -am "local change"
+    head=$(dolt sql -q "select hashof('HEAD')" -r csv | tail -n 1)
+
+    run dolt am ../patches/*.patch
+    [ "$status" -ne 0 ]
+
+    run dolt am --abort
+    [ "$status" -eq 0 ]
+
+    run dolt sql -q "select hashof('HEAD')" -r csv
+    [[ "$output" =~ "$head" ]] || false
+    run dolt status
+    [[ "$output" =~ "nothing to commit, working tree clean" ]] || false
+
+    run dolt am --continue
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "no patch application in progress" ]] || false
+}
+
+@test "format-patch: am --skip skips the current patch" {
+    cd repo1
+    dolt format-patch -o ../patches HEAD~2
+
+    cd ../repo2
+    dolt sql -q "update t set v = 200 where pk = 2"
+    dolt commit -am "local change"
+
+    run dolt am ../patches/*.patch
+    [ "$status" -ne 0 ]
+
+    run dolt am --skip
+    [ "$status" -eq 0 ]
+
+    run dolt sql -q "select * from t order by pk" -r csv
+    [ "${lines[1]}" = "1,10" ]
+    [ "${lines[2]}" = "2,200" ]
+    [ "${#lines[@]}" -eq 3 ]
+}
+
+@test "format-patch: am requires a clean working set" {
+    cd repo1
+    dolt format-patch -o ../patches HEAD~2
+
+    cd ../repo2
+    dolt sql -q "update t set v = 100 where pk = 1"
+    run dolt am ../patches/*.patch
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "local changes would be overwritten" ]] || false
+    [ ! -f .dolt/am_state.json ]
+
+    run dolt am
+    [ "$status" -ne 0 ]
+}
@@ -578,3 +578,137 @@ SQL
         false
     fi
 }
+
+@test "garbage_collection: dolt gc --prune-history-before a commit" {
+    dolt sql -q "CREATE TABLE vals (i int PRIMARY KEY, val LONGTEXT);"
+    dolt commit -Am "create vals"
+    for i in 1 2 3 4 5; do
+        dolt sql -q "INSERT INTO vals VALUES ($i, hex(random_bytes(65536)));"
+        dolt commit -am "insert $i"
+    done
+    dolt branch other HEAD~4
+    dolt sql -q "DELETE FROM vals WHERE i < 5;"
+    dolt commit -am "delete old values"
+
+    dolt gc --full
+    BEFORE=$(du -c .dolt/noms/ | grep total | sed 's/[^0-9]*//g')
+
+    boundary=$(dolt log --oneline --decorate=no -n 1 HEAD~1 | cut -d ' ' -f 1)
+    dolt gc --prune-history-before "$boundary"
+    AFTER=$(du -c .dolt/noms/ | grep total | sed 's/[^0-9]*//g')
+    [ "$AFTER" -lt "$BEFORE" ]
+
+    # The boundary commit and its descendants are kept with the same hashes.
+    run dolt log --oneline --decorate=no
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "$output" =~ "$boundary" ]] || false
+
+    run dolt sql -q "select count(*) from dolt_log()" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+
+    run dolt sql -q "select count(distinct commit_hash) from dolt_history_vals" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+
+    run dolt sql -q "select count(*) from vals" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1" ]] || false
+
+    # Branch heads are always kept.
+    run dolt sql -q "select i from \`dolt-repo-$$/other\`.vals" -r csv
+    [ "$status" -eq 0 ]
+    [ "${lines[1]}" = "1" ]
+    run dolt log --oneline --decorate=no other
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+
+    # New commits and merges on top of the retained history keep working.
+    dolt checkout -b feature
+    dolt sql -q "INSERT INTO vals VALUES (6, 'six');"
+    dolt commit -am "insert 6"
+    dolt checkout main
+    dolt sql -q "INSERT INTO vals VALUES (7, 'seven');"
+    dolt commit -am "insert 7"
+    dolt merge feature -m "merge feature"
+    run dolt sql -q "select count(*) from vals" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3" ]] || false
+}
+
+@test "garbage_collection: dolt gc --prune-history-before a date" {
+    dolt sql -q "CREATE TABLE vals (i int PRIMARY KEY);"
+    DOLT_COMMITTER_DATE="2020-01-01T00:00:00" dolt commit -Am "create vals"
+    dolt sql -q "INSERT INTO vals VALUES (1);"
+    DOLT_COMMITTER_DATE="2021-01-01T00:00:00" dolt commit -am "insert 1"
+    dolt sql -q "INSERT INTO vals VALUES (2);"
+    dolt commit -am "insert 2"
+
+    dolt sql -q "call dolt_gc('--prune-history-before', '2022-01-01')"
+
+    run dolt log --oneline --decorate=no
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ "insert 2" ]] || false
+
+    run dolt sql -q "select sum(i) from vals" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "3" ]] || false
+}
+
+@test "garbage_collection: gc.history_retention_days prunes history on a full gc" {
+    dolt sql -q "CREATE TABLE vals (i int PRIMARY KEY);"
+    DOLT_COMMITTER_DATE="2020-01-01T00:00:00" dolt commit -Am "create vals"
+    dolt sql -q "INSERT INTO vals VALUES (1);"
+    dolt commit -am "insert 1"
+
+    dolt config --local --add gc.history_retention_days 30
+
+    # A default gc does not prune history.
+    dolt gc
+    run dolt log --oneline --decorate=no
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+
+    dolt gc --full
+    run dolt log --oneline --decorate=no
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ "insert 1" ]] || false
+
+    dolt config --local --add gc.history_retention_days zero
+    run dolt gc --full
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "gc.history_retention_days" ]] || false
+}
+
+@test "garbage_collection: --prune-history-before is not compatible with --shallow" {
+    run dolt gc --shallow --prune-history-before HEAD
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "not compatible" ]] || false
+
+    run dolt sql -q "call dolt_gc('--shallow', '--prune-history-before', 'HEAD')"
+    [ "$status" -ne 0 ]
+}
+
+@test "garbage_collection: pruned history can only be pushed to remotes which have it" {
+    dolt sql -q "CREATE TABLE vals (i int PRIMARY KEY);"
+    dolt commit -Am "create vals"
+    dolt sql -q "INSERT INTO vals VALUES (1);"
+    dolt commit -am "insert 1"
+    dolt remote add full file://../full-remote
+    dolt push full main
+
+    dolt sql -q "INSERT INTO vals VALUES (2);"
+    dolt commit -am "insert 2"
+    dolt gc --prune-history-before HEAD
+
+    # A remote with the full history still accepts the kept commits.
+    dolt push full main
+
+    dolt remote add empty file://../empty-remote
+    run dolt push empty main
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "pruned history" ]] || false
+}
@@ -1043,4 +1043,64 @@ export NO_COLOR=1
     [[  "${lines[18]}" =~ "|/" ]] || false                               # |/
     [[  "${lines[19]}" =~ "* commit" ]] || false                         # *  commit Initialize data repository
 
-}
\ No newline at end of file
+}
+@test "log: --author, --grep, --since and --until filter commits" {
+    dolt commit --allow-empty -m "fix: first" --author "Alice <alice@example.com>"
+    dolt commit --allow-empty -m "feature" --author "Bob <bob@example.com>"
+    dolt commit --allow-empty -m "fix: second" --author "Bob <bob@example.com>"
+
+    run dolt log --oneline --author Alice
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ "fix: first" ]] || false
+
+    run dolt log --oneline --author "bob@example" --grep "^fix:"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ "fix: second" ]] || false
+
+    run dolt log --oneline --since 2000-01-01 --grep "^fix:"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+
+    run dolt log --oneline --until 2000-01-01
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 0 ]
+
+    run dolt log --since yesterday
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "invalid --since date" ]] || false
+}
+
+@test "log: --follow and --where restrict the log to a table's rows across renames" {
+    dolt sql -q "create table t (pk int primary key, c1 int)"
+    dolt commit -Am "created t"
+    dolt sql -q "insert into t values (1, 10), (42, 420)"
+    dolt commit -am "inserted rows"
+    dolt sql -q "update t set c1 = 11 where pk = 1"
+    dolt commit -am "updated row 1"
+    dolt sql -q "rename table t to u"
+    dolt commit -Am "renamed t to u"
+    dolt sql -q "update u set c1 = 421 where pk = 42"
+    dolt commit -am "updated row 42"
+
+    run dolt log --oneline u
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+
+    run dolt log --oneline --follow --tables u
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 5 ]
+    [[ "${lines[4]}" =~ "created t" ]] || false
+
+    run dolt log --oneline --follow --tables u --where "pk = 42"
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 3 ]
+    [[ "${lines[0]}" =~ "updated row 42" ]] || false
+    [[ "${lines[1]}" =~ "renamed t to u" ]] || false
+    [[ "${lines[2]}" =~ "inserted rows" ]] || false
+
+    run dolt log --where "pk = 42"
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "--where requires exactly one table" ]] || false
+}
@@ -131,6 +131,22 @@ setupCustomEditorScript() {
     [[ "$output" =~ "main commit 2" ]] || false
 }
 
+@test "rebase: rebased commits keep their author and trailers" {
+    setupCustomEditorScript
+
+    dolt checkout b1
+    dolt sql -q "CREATE table t3 (pk int primary key);"
+    dolt commit -Am "b1 commit 2" --author "John Doe <john@doe.com>" --trailer "Ticket: DB-2"
+
+    run dolt rebase -i main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b1" ]] || false
+
+    run dolt sql -r csv -q "select committer, email, trailers from dolt_log where message = 'b1 commit 2'"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "John Doe,john@doe.com,Ticket: DB-2" ]] || false
+}
+
 @test "rebase: failed rebase will abort and clean up" {
     setupCustomEditorScript "invalidRebasePlan.txt"
     dolt checkout b1
@@ -498,3 +514,100 @@ setupCustomEditorScript() {
     run dolt log
     [[ $output =~ "repeating change from main on b1" ]] || false
 }
+
+@test "rebase: edit stops the rebase to amend a commit" {
+    setupCustomEditorScript "editPlan.txt"
+
+    dolt checkout b1
+    run dolt show head
+    [ "$status" -eq 0 ]
+    COMMIT1=${lines[0]:12:32}
+
+    dolt sql -q "insert into t2 values (1);"
+    dolt commit -am "b1 commit 2"
+    run dolt show head
+    [ "$status" -eq 0 ]
+    COMMIT2=${lines[0]:12:32}
+
+    touch editPlan.txt
+    echo "edit $COMMIT1 b1 commit 1" >> editPlan.txt
+    echo "pick $COMMIT2 b1 commit 2" >> editPlan.txt
+
+    run dolt rebase -i main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Rebase stopped at $COMMIT1 (b1 commit 1)" ]] || false
+
+    run dolt branch --show-current
+    [ "$status" -eq 0 ]
+    [ "$output" = "dolt_rebase_b1" ]
+
+    dolt sql -q "insert into t2 values (100);"
+    dolt add t2
+
+    run dolt rebase --continue
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b1" ]] || false
+
+    run dolt branch --show-current
+    [ "$status" -eq 0 ]
+    [ "$output" = "b1" ]
+
+    run dolt sql -q "select * from t2 as of 'HEAD~1'" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "100" ]] || false
+
+    run dolt show head~1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "b1 commit 1" ]] || false
+
+    run dolt show head~2
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "main commit 2" ]] || false
+}
+
+@test "rebase: break stops the rebase between steps" {
+    setupCustomEditorScript "breakPlan.txt"
+
+    dolt checkout b1
+    run dolt show head
+    [ "$status" -eq 0 ]
+    COMMIT1=${lines[0]:12:32}
+
+    dolt sql -q "insert into t2 values (1);"
+    dolt commit -am "b1 commit 2"
+    run dolt show head
+    [ "$status" -eq 0 ]
+    COMMIT2=${lines[0]:12:32}
+
+    touch breakPlan.txt
+    echo "pick $COMMIT1 b1 commit 1" >> breakPlan.txt
+    echo "break" >> breakPlan.txt
+    echo "pick $COMMIT2 b1 commit 2" >> breakPlan.txt
+
+    run dolt rebase -i main
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Rebase stopped at break" ]] || false
+
+    run dolt show head
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "b1 commit 1" ]] || false
+
+    dolt sql -q "insert into t2 values (100);"
+    dolt commit -am "added during break"
+
+    run dolt rebase --continue
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Successfully rebased and updated refs/heads/b1" ]] || false
+
+    run dolt show head
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "b1 commit 2" ]] || false
+
+    run dolt show head~1
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "added during break" ]] || false
+
+    run dolt show head~2
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "b1 commit 1" ]] || false
+}
@@ -246,3 +246,25 @@ SQL
     run dolt log -n 1
     [[ "$output" =~ "Author: john doe <johndoe@gmail.com>" ]] || false
 }
+
+@test "revert: merge commit with a mainline parent" {
+    dolt checkout -b other
+    dolt sql -q "INSERT INTO test VALUES (4, 4)"
+    dolt commit -am "Inserted 4"
+    dolt checkout main
+    dolt sql -q "INSERT INTO test VALUES (5, 5)"
+    dolt commit -am "Inserted 5"
+    dolt merge other -m "Merged other"
+
+    run dolt revert -m 1 HEAD~1
+    [ "$status" -eq "1" ]
+    [[ "$output" =~ "is not a merge" ]] || false
+
+    run dolt revert -m 1 HEAD
+    [ "$status" -eq "0" ]
+    run dolt sql -q "SELECT * FROM test" -r=csv
+    [ "$status" -eq "0" ]
+    [[ "$output" =~ "5,5" ]] || false
+    [[ ! "$output" =~ "4,4" ]] || false
+    [[ "${#lines[@]}" = "5" ]] || false
+}
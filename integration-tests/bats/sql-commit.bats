@@ -278,3 +278,45 @@ SQL
   dolt sql -q "CALL DOLT_COMMIT('--skip-empty', '-m', 'commit message');"
   [ $new_head = $(get_head_commit) ]
 }
+
+@test "sql-commit: DOLT_COMMIT records the sql user as committer when --author is given" {
+    dolt sql -q "call dolt_commit('-m', 'Commit1', '--author', 'John Doe <john@doe.com>')"
+
+    run dolt sql -r csv -q "select committer, email, committer_name from dolt_log limit 1"
+    [ $status -eq 0 ]
+    [[ "$output" =~ "John Doe,john@doe.com,root" ]] || false
+
+    run dolt sql -r csv -q "select committer_name from dolt_commits where message = 'Commit1'"
+    [ $status -eq 0 ]
+    [[ "$output" =~ "root" ]] || false
+
+    dolt sql -q "call dolt_commit('--allow-empty', '-m', 'Commit2', '--author', 'John Doe <john@doe.com>', '--committer', 'Jane Doe <jane@doe.com>')"
+    run dolt sql -r csv -q "select committer, committer_name, committer_email from dolt_log limit 1"
+    [ $status -eq 0 ]
+    [[ "$output" =~ "John Doe,Jane Doe,jane@doe.com" ]] || false
+}
+
+@test "sql-commit: DOLT_COMMIT with --trailer records trailers" {
+    dolt sql -q "call dolt_commit('-m', 'Commit1', '--trailer', 'Reviewed-by: Jane Doe <jane@doe.com>', '--trailer', 'Ticket=DB-123, DB-124')"
+
+    run dolt sql -r csv -q "select trailers from dolt_log('--parents') limit 1"
+    [ $status -eq 0 ]
+    [[ "$output" =~ "Reviewed-by: Jane Doe <jane@doe.com>" ]] || false
+    [[ "$output" =~ "Ticket: DB-123, DB-124" ]] || false
+
+    # amending keeps the trailers unless new ones are given
+    dolt sql -q "call dolt_commit('--amend', '-m', 'Amended')"
+    run dolt sql -r csv -q "select message, trailers from dolt_commits where message = 'Amended'"
+    [ $status -eq 0 ]
+    [[ "$output" =~ "Ticket: DB-123" ]] || false
+
+    dolt sql -q "call dolt_commit('--amend', '--trailer', 'Ticket: DB-456')"
+    run dolt sql -r csv -q "select trailers from dolt_log limit 1"
+    [ $status -eq 0 ]
+    [[ "$output" =~ "Ticket: DB-456" ]] || false
+    ! [[ "$output" =~ "Reviewed-by" ]] || false
+
+    run dolt sql -q "call dolt_commit('--allow-empty', '-m', 'bad', '--trailer', 'Ticket:')"
+    [ $status -eq 1 ]
+    [[ "$output" =~ "invalid trailer" ]] || false
+}
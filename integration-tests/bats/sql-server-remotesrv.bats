@@ -314,6 +314,41 @@ call dolt_commit('-am', 'add one val');"
     [[ "$output" =~ "11" ]] || false
 }
 
+@test "sql-server-remotesrv: clone from remotesapi port requires read access to every branch when enforced" {
+    mkdir -p db/remote
+    cd db/remote
+    dolt init
+    dolt sql -q 'create table vals (i int);'
+    dolt sql -q 'insert into vals (i) values (1), (2), (3);'
+    dolt commit -Am 'initial vals.'
+    dolt branch secret
+
+    dolt sql-server --port 3307 -u user0 -p pass0 --remotesapi-port 50051 &
+    srv_pid=$!
+    sleep 2
+    dolt --port 3307 --host localhost -u user0 -p pass0 --no-tls --use-db remote sql -q "
+CREATE USER clone_admin_user@'localhost' IDENTIFIED BY 'pass1';
+GRANT CLONE_ADMIN ON *.* TO clone_admin_user@'localhost';
+DELETE FROM dolt_branch_control WHERE user = '%';
+INSERT INTO dolt_branch_control VALUES ('remote', 'main', 'clone_admin_user', '%', 'read');
+SET @@GLOBAL.dolt_enforce_branch_control_reads = 1;"
+
+    export DOLT_REMOTE_PASSWORD="pass1"
+    cd ../../
+    run dolt clone http://localhost:50051/remote repo1 -u clone_admin_user
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "cannot read the branch" ]] || false
+
+    dolt --port 3307 --host localhost -u user0 -p pass0 --no-tls --use-db remote sql -q "
+INSERT INTO dolt_branch_control VALUES ('remote', 'secret', 'clone_admin_user', '%', 'read');"
+
+    dolt clone http://localhost:50051/remote repo1 -u clone_admin_user
+    cd repo1
+    run dolt branch -a
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "remotes/origin/secret" ]] || false
+}
+
 @test "sql-server-remotesrv: dolt clone without authentication returns error" {
     mkdir -p db/remote
     cd db/remote
@@ -498,6 +533,88 @@ call dolt_commit('-am', 'add one val');"
     ! [[ "$output" =~ "zeek" ]] || false
 }
 
+@test "sql-server-remotesrv: push to protected branch only allows fast-forwards" {
+    mkdir remote
+    cd remote
+    dolt init
+    dolt sql -q 'create table names (name varchar(10) primary key);'
+    dolt sql -q 'insert into names (name) values ("abe"), ("betsy"), ("calvin");'
+    dolt add names
+    dolt commit -m 'initial names.'
+
+    APIPORT=$( definePORT )
+    export DOLT_REMOTE_PASSWORD="rootpass"
+    export SQL_USER="root"
+    start_sql_server_with_args -u "$SQL_USER" -p "$DOLT_REMOTE_PASSWORD" --remotesapi-port $APIPORT
+
+    cd ../
+    dolt clone http://localhost:$APIPORT/remote cloned_db -u root
+
+    cd remote
+    dolt sql -q "insert into dolt_branch_control values ('remote', 'main', '%', '%', 'write,protected');"
+    run dolt sql -q "select permissions from dolt_branch_control where branch = 'main';"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "write,protected" ]] || false
+
+    cd ../cloned_db
+    dolt sql -q 'insert into names values ("dave");'
+    dolt commit -am 'add dave'
+    run dolt push origin --user $SQL_USER main:main
+    [ "$status" -eq 0 ]
+
+    dolt reset --hard HEAD~1
+    dolt sql -q 'insert into names values ("eve");'
+    dolt commit -am 'add eve'
+    run dolt push origin --force --user $SQL_USER main:main
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "protected branch \`main\`" ]] || false
+
+    cd ../remote
+    run dolt sql -q 'select * from names;'
+    [[ "$output" =~ "dave" ]] || false
+    ! [[ "$output" =~ "eve" ]] || false
+
+    cd ../cloned_db
+    dolt checkout -b other
+    run dolt push origin --force --user $SQL_USER other:other
+    [ "$status" -eq 0 ]
+}
+
+@test "sql-server-remotesrv: push may only update branches" {
+    mkdir remote
+    cd remote
+    dolt init
+    dolt sql -q 'create table names (name varchar(10) primary key);'
+    dolt sql -q 'insert into names (name) values ("abe"), ("betsy"), ("calvin");'
+    dolt add names
+    dolt commit -m 'initial names.'
+
+    APIPORT=$( definePORT )
+    export DOLT_REMOTE_PASSWORD="rootpass"
+    export SQL_USER="root"
+    start_sql_server_with_args -u "$SQL_USER" -p "$DOLT_REMOTE_PASSWORD" --remotesapi-port $APIPORT
+
+    cd ../
+    dolt clone http://localhost:$APIPORT/remote cloned_db -u root
+
+    cd cloned_db
+    dolt tag v1
+    run dolt push origin --user $SQL_USER v1
+    [ "$status" -ne 0 ]
+    [[ "$output" =~ "a push may not update refs/tags/v1" ]] || false
+
+    dolt sql -q 'insert into names values ("dave");'
+    dolt commit -am 'add dave'
+    run dolt push origin --user $SQL_USER main:main
+    [ "$status" -eq 0 ]
+
+    cd ../remote
+    run dolt sql -q 'select * from names;'
+    [[ "$output" =~ "dave" ]] || false
+    run dolt tag
+    ! [[ "$output" =~ "v1" ]] || false
+}
+
 @test "sql-server-remotesrv: push to remoteapi port as non-super user rejected" {
     mkdir remote
     cd remote
@@ -1996,4 +1996,59 @@ EOF
     run dolt --data-dir datadir1 sql-server --data-dir datadir2
     [ $status -eq 1 ]
     [[ "$output" =~ "cannot specify both global --data-dir argument and --data-dir in sql-server config" ]] || false
-}
\ No newline at end of file
+}
+
+@test "sql-server: ci workflows with push triggers run when a branch head is updated" {
+    skiponwindows "Missing dependencies"
+
+    cd repo1
+    dolt sql -q "create table t (pk int primary key);"
+    dolt sql -q "insert into t values (1);"
+    dolt sql -q "select * from t;" -s "all rows"
+    dolt add -A && dolt commit -m "add table t"
+    cat > workflow.yaml <<EOF
+name: my_workflow
+on:
+  push:
+    branches:
+      - main
+jobs:
+  - name: validate t
+    steps:
+      - name: assert t has one row
+        saved_query_name: all rows
+        expected_rows: "== 1"
+EOF
+    dolt ci init
+    dolt ci import ./workflow.yaml
+
+    start_sql_server repo1
+
+    dolt sql -q "call dolt_commit('--allow-empty', '-m', 'empty commit');"
+    dolt sql -q "insert into t values (2); call dolt_commit('-am', 'add row');"
+    dolt sql -q "call dolt_checkout('-b', 'other'); call dolt_commit('--allow-empty', '-m', 'not on main');"
+    sleep 1
+
+    run dolt sql -r csv -q "select workflow_name, event, branch, status from dolt_ci_workflow_runs order by started_at;"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "my_workflow,push,main,passed" ]] || false
+    [[ "$output" =~ "my_workflow,push,main,failed" ]] || false
+    ! [[ "$output" =~ "other" ]] || false
+
+    run dolt sql -r csv -q "select json_extract(step_results, '$[0].failures[0]') from dolt_ci_workflow_runs where status = 'failed';"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "expected row count == 1, got 2" ]] || false
+
+    # workflows run against the commit the branch was updated to
+    head=$(dolt sql -r csv -q "select hashof('main');" | tail -n 1)
+    run dolt sql -r csv -q "select count(*) from dolt_ci_workflow_runs where status = 'failed' and commit_hash = '$head';"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1" ]] || false
+
+    # the run history is kept when the server restarts
+    stop_sql_server 1
+    start_sql_server repo1
+    run dolt sql -r csv -q "select count(*) from dolt_ci_workflow_runs;"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "2" ]] || false
+}
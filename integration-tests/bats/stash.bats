@@ -684,3 +684,199 @@ teardown() {
     [[ "$output" =~ "nothing to commit, working tree clean" ]] || false
     [[ "$output" =~ "Dropped refs/stash@{0}" ]] || false
 }
+
+@test "stash: dolt_stash procedure pushes and pops changes made in sql" {
+    dolt sql -q "INSERT INTO test VALUES (1, 'a')"
+
+    run dolt sql -q "CALL dolt_stash('push')"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Saved working directory and index state WIP on refs/heads/main" ]] || false
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+
+    run dolt stash list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ "stash@{0}: WIP on refs/heads/main:" ]] || false
+
+    run dolt sql -q "SELECT name, branch, commit_message FROM dolt_stashes" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "stash@{0},main,Created table" ]] || false
+
+    run dolt sql -q "CALL dolt_stash('pop')"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Dropped refs/stash@{0}" ]] || false
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,a" ]] || false
+
+    run dolt sql -q "SELECT count(*) FROM dolt_stashes" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "0" ]] || false
+}
+
+@test "stash: stashes made with the cli are visible to dolt_stash" {
+    dolt sql -q "INSERT INTO test VALUES (1, 'a')"
+    dolt stash
+    dolt checkout -b other
+    dolt sql -q "INSERT INTO test VALUES (2, 'b')"
+    dolt stash
+
+    run dolt sql -q "SELECT name, branch FROM dolt_stashes ORDER BY stash_index" -r csv
+    [ "$status" -eq 0 ]
+    [[ "${lines[1]}" = "stash@{0},other" ]] || false
+    [[ "${lines[2]}" = "stash@{1},main" ]] || false
+
+    run dolt sql -q "CALL dolt_stash('list')" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "stash@{0}: WIP on refs/heads/other:" ]] || false
+    [[ "$output" =~ "stash@{1}: WIP on refs/heads/main:" ]] || false
+
+    dolt sql -q "CALL dolt_stash('drop', 'stash@{1}')"
+    run dolt stash list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ "stash@{0}: WIP on refs/heads/other:" ]] || false
+
+    dolt sql -q "CALL dolt_stash('clear')"
+    run dolt stash list
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+}
+
+@test "stash: apply keeps the stash entry" {
+    dolt sql -q "INSERT INTO test VALUES (1, 'a')"
+    dolt stash
+
+    run dolt stash apply
+    [ "$status" -eq 0 ]
+    [[ ! "$output" =~ "Dropped refs/stash@{0}" ]] || false
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,a" ]] || false
+
+    run dolt stash list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 1 ]
+    [[ "$output" =~ "stash@{0}" ]] || false
+}
+
+@test "stash: push with a message" {
+    dolt sql -q "INSERT INTO test VALUES (1, 'a')"
+
+    run dolt stash push -m "my stashed changes"
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Saved working directory and index state On refs/heads/main: my stashed changes" ]] || false
+
+    dolt sql -q "INSERT INTO test VALUES (2, 'b')"
+    dolt stash -m "another message"
+
+    run dolt stash list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+    [[ "${lines[0]}" = "stash@{0}: On refs/heads/main: another message" ]] || false
+    [[ "${lines[1]}" = "stash@{1}: On refs/heads/main: my stashed changes" ]] || false
+}
+
+@test "stash: push only the given tables" {
+    dolt sql -q "CREATE TABLE other(pk BIGINT PRIMARY KEY)"
+    dolt add .
+    dolt commit -m "Created other table"
+    dolt sql -q "INSERT INTO test VALUES (1, 'a')"
+    dolt sql -q "INSERT INTO other VALUES (1)"
+    dolt sql -q "CREATE TABLE new_table(pk BIGINT PRIMARY KEY)"
+    dolt add new_table
+
+    run dolt stash push test new_table
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Saved working directory and index state" ]] || false
+
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "modified:         other" ]] || false
+    [[ ! "$output" =~ "test" ]] || false
+    [[ ! "$output" =~ "new_table" ]] || false
+
+    run dolt stash push test
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "No local changes to save" ]] || false
+
+    run dolt stash push missing_table
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "Unknown tables or docs: [missing_table]" ]] || false
+
+    run dolt stash push -u test
+    [ "$status" -eq 1 ]
+    [[ "$output" =~ "cannot be used when stashing specific tables" ]] || false
+
+    run dolt stash pop
+    [ "$status" -eq 0 ]
+
+    run dolt status
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "new table:        new_table" ]] || false
+    [[ "$output" =~ "modified:         test" ]] || false
+    [[ "$output" =~ "modified:         other" ]] || false
+}
+
+@test "stash: show the changes in a stash entry" {
+    dolt sql -q "INSERT INTO test VALUES (1, 'a')"
+    dolt stash
+    dolt sql -q "INSERT INTO test VALUES (2, 'b')"
+    dolt stash
+
+    run dolt stash show
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1 Row Added" ]] || false
+
+    run dolt stash show -p stash@{1}
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "diff --dolt a/test b/test" ]] || false
+    [[ "$output" =~ "| + | 1  | a |" ]] || false
+    [[ ! "$output" =~ "| + | 2  | b |" ]] || false
+
+    run dolt stash show -p
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "| + | 2  | b |" ]] || false
+
+    run dolt stash list
+    [ "$status" -eq 0 ]
+    [ "${#lines[@]}" -eq 2 ]
+
+    run dolt stash show stash@{2}
+    [ "$status" -eq 1 ]
+}
+
+@test "stash: branch creates a branch at the stashed commit and applies the stash" {
+    dolt sql -q "INSERT INTO test VALUES (1, 'a')"
+    dolt stash
+    dolt sql -q "INSERT INTO test VALUES (1, 'b')"
+    dolt commit -am "conflicting change"
+
+    run dolt stash pop
+    [ "$status" -eq 1 ]
+
+    run dolt stash branch stashed
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "Switched to branch 'stashed'" ]] || false
+    [[ "$output" =~ "Dropped refs/stash@{0}" ]] || false
+
+    run dolt branch --show-current
+    [ "$status" -eq 0 ]
+    [ "$output" = "stashed" ]
+
+    run dolt sql -q "SELECT * FROM test" -r csv
+    [ "$status" -eq 0 ]
+    [[ "$output" =~ "1,a" ]] || false
+
+    run dolt stash list
+    [ "$status" -eq 0 ]
+    [ "$output" = "" ]
+
+    run dolt stash branch another
+    [ "$status" -eq 1 ]
+}